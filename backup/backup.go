@@ -0,0 +1,272 @@
+// Package backup copies volumes to and from S3-compatible object storage with
+// Element bulk volume jobs. The storage nodes move the data themselves:
+// StartBulkVolumeRead runs the bv_internal.py script to upload a volume or
+// snapshot, and StartBulkVolumeWrite downloads it again.
+//
+// Jobs are scheduled on the node that is primary for each volume, found through
+// the slices.json report, and throttled per node below BulkVolumeJobsPerNodeMax.
+// Progress comes from ListBulkVolumeJobs and completion from one ListAsyncResults
+// call per poll. Every run returns a Report with one entry per volume.
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+// Script is the bulk volume script that copies data between nodes and S3.
+const Script = "bv_internal.py"
+
+// Consistency selects the snapshots backups are read from.
+type Consistency string
+
+const (
+	// PerVolume snapshots each volume right before its backup starts. It is the default.
+	PerVolume Consistency = "volume"
+	// Group takes one group snapshot of all volumes before the first backup starts,
+	// so the backups of a run are crash consistent with each other.
+	Group Consistency = "group"
+	// NoSnapshot reads the volumes without a snapshot of our own; the cluster
+	// snapshots the active volume image when each job starts.
+	NoSnapshot Consistency = "none"
+)
+
+// S3 is the object store the nodes upload to and download from.
+type S3 struct {
+	// Hostname is the host[:port] of the S3 endpoint, as reached from the storage nodes.
+	Hostname        string `yaml:"hostname"`
+	Bucket          string `yaml:"bucket"`
+	AccessKeyID     string `yaml:"accessKeyID"`
+	SecretAccessKey string `yaml:"secretAccessKey"`
+}
+
+// Options control a Runner.
+type Options struct {
+	Target S3 `yaml:"target"`
+	// Prefix starts every object name. Backups are stored as
+	// <Prefix>/<volume name>-<volume ID>/<UTC start time>.
+	Prefix string `yaml:"prefix"`
+	// Format is "native" (default), which only a bulk volume write can read, or "uncompressed".
+	Format string `yaml:"format"`
+	// Consistency defaults to PerVolume.
+	Consistency Consistency `yaml:"consistency"`
+	// KeepSnapshots keeps the snapshots taken for backups instead of deleting them
+	// once the backups are done.
+	KeepSnapshots bool `yaml:"keepSnapshots"`
+	// SnapshotRetention expires the snapshots on the cluster in case a run does not
+	// get to delete them. Default 24 hours.
+	SnapshotRetention time.Duration `yaml:"snapshotRetention"`
+	// MaxJobsPerNode caps the running jobs on each node. The default leaves two of
+	// BulkVolumeJobsPerNodeMax free for restores and other ad-hoc jobs.
+	MaxJobsPerNode int64 `yaml:"maxJobsPerNode"`
+	// PollInterval is the wait between progress checks. Default 5 seconds.
+	PollInterval time.Duration `yaml:"pollInterval"`
+	// OnProgress, if set, is called when a job starts, progresses or finishes.
+	OnProgress func(Job) `yaml:"-"`
+}
+
+// Restore is a volume to overwrite with a backup.
+type Restore struct {
+	VolumeID int64
+	// Object is the backup's object name, as in Job.Object of the backup report.
+	Object string
+}
+
+// Runner runs backups and restores for one cluster.
+type Runner struct {
+	Options
+	sf *sdk.SFClient
+}
+
+// NewRunner returns a runner for sf.
+func NewRunner(sf *sdk.SFClient, opts Options) *Runner {
+	return &Runner{Options: opts, sf: sf}
+}
+
+func (r *Runner) validate() error {
+	if r.Target.Hostname == "" || r.Target.Bucket == "" {
+		return errors.New("backup: S3 hostname and bucket are required")
+	}
+	switch r.Consistency {
+	case "", PerVolume, Group, NoSnapshot:
+	default:
+		return fmt.Errorf("backup: unknown consistency %q", r.Consistency)
+	}
+	return nil
+}
+
+func (r *Runner) format() string {
+	if r.Format == "" {
+		return "native"
+	}
+	return r.Format
+}
+
+func (r *Runner) retention() string {
+	d := r.SnapshotRetention
+	if d <= 0 {
+		d = 24 * time.Hour
+	}
+	s := int64((d + time.Second - 1) / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", s/3600, s/60%60, s%60)
+}
+
+// scriptParameters are the bv_internal.py parameters for object. A bulk volume read
+// writes to S3 and a bulk volume write reads from it.
+func (r *Runner) scriptParameters(direction, object string) map[string]interface{} {
+	return map[string]interface{}{
+		direction: map[string]interface{}{
+			"endpoint":           "s3",
+			"hostname":           r.Target.Hostname,
+			"bucket":             r.Target.Bucket,
+			"prefix":             object,
+			"format":             r.format(),
+			"awsAccessKeyID":     r.Target.AccessKeyID,
+			"awsSecretAccessKey": r.Target.SecretAccessKey,
+		},
+	}
+}
+
+// volumes returns the named volumes, failing if any does not exist.
+func (r *Runner) volumes(ctx context.Context, ids []int64) (map[int64]sdk.Volume, error) {
+	res, sdkErr := r.sf.ListVolumes(ctx, &sdk.ListVolumesRequest{VolumeIDs: ids})
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	vols := map[int64]sdk.Volume{}
+	for _, v := range res.Volumes {
+		vols[v.VolumeID] = v
+	}
+	for _, id := range ids {
+		if _, ok := vols[id]; !ok {
+			return nil, fmt.Errorf("backup: volume %d does not exist", id)
+		}
+	}
+	return vols, nil
+}
+
+// Backup uploads the volumes to S3. Per-volume failures are recorded in the report
+// (see Report.Err); the error is for runs that could not be set up or were
+// cancelled. Jobs still running when ctx is cancelled are left to finish on the
+// cluster, with their snapshots expiring after SnapshotRetention.
+func (r *Runner) Backup(ctx context.Context, volumeIDs ...int64) (*Report, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	vols, err := r.volumes(ctx, volumeIDs)
+	if err != nil {
+		return nil, err
+	}
+	rep := &Report{Type: "backup", Started: time.Now().UTC()}
+	stamp := rep.Started.Format("20060102T150405Z")
+	for _, id := range volumeIDs {
+		v := vols[id]
+		rep.Jobs = append(rep.Jobs, Job{
+			Type:       "read",
+			VolumeID:   id,
+			VolumeName: v.Name,
+			Object:     path.Join(r.Prefix, fmt.Sprintf("%s-%d", v.Name, id), stamp),
+			Status:     Pending,
+		})
+	}
+
+	snapshotName := "backup-" + stamp
+	var groupSnapshotID int64
+	if r.Consistency == Group {
+		res, sdkErr := r.sf.CreateGroupSnapshot(ctx, &sdk.CreateGroupSnapshotRequest{Volumes: volumeIDs, Name: snapshotName, Retention: r.retention()})
+		if sdkErr != nil {
+			return nil, fmt.Errorf("backup: group snapshot: %w", sdkErr)
+		}
+		for i := range rep.Jobs {
+			for _, m := range res.Members {
+				if m.VolumeID == rep.Jobs[i].VolumeID {
+					rep.Jobs[i].SnapshotID = m.SnapshotID
+				}
+			}
+		}
+		groupSnapshotID = res.GroupSnapshotID
+	}
+
+	start := func(ctx context.Context, job *Job) (int64, string, error) {
+		created := false
+		if r.Consistency == "" || r.Consistency == PerVolume {
+			snap, sdkErr := r.sf.CreateSnapshot(ctx, &sdk.CreateSnapshotRequest{VolumeID: job.VolumeID, Name: snapshotName, Retention: r.retention()})
+			if sdkErr != nil {
+				return 0, "", fmt.Errorf("snapshot: %w", sdkErr)
+			}
+			job.SnapshotID, created = snap.SnapshotID, true
+		}
+		res, sdkErr := r.sf.StartBulkVolumeRead(ctx, &sdk.StartBulkVolumeReadRequest{
+			VolumeID:         job.VolumeID,
+			Format:           r.format(),
+			SnapshotID:       job.SnapshotID,
+			Script:           Script,
+			ScriptParameters: r.scriptParameters("write", job.Object),
+		})
+		if sdkErr != nil {
+			if created {
+				r.deleteSnapshot(ctx, job)
+			}
+			return 0, "", sdkErr
+		}
+		return res.AsyncHandle, res.Key, nil
+	}
+	finish := func(ctx context.Context, job *Job) {
+		if (r.Consistency == "" || r.Consistency == PerVolume) && !r.KeepSnapshots {
+			r.deleteSnapshot(ctx, job)
+		}
+	}
+	if err := r.run(ctx, rep, start, finish); err != nil {
+		return rep, err
+	}
+	if groupSnapshotID != 0 && !r.KeepSnapshots {
+		r.deleteGroupSnapshot(ctx, groupSnapshotID)
+	}
+	return rep, nil
+}
+
+// Restore overwrites volumes with backups. Errors are reported as for Backup.
+func (r *Runner) Restore(ctx context.Context, restores ...Restore) (*Report, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	var ids []int64
+	for _, rs := range restores {
+		if rs.Object == "" {
+			return nil, fmt.Errorf("backup: no object to restore to volume %d", rs.VolumeID)
+		}
+		ids = append(ids, rs.VolumeID)
+	}
+	vols, err := r.volumes(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	rep := &Report{Type: "restore", Started: time.Now().UTC()}
+	for _, rs := range restores {
+		rep.Jobs = append(rep.Jobs, Job{
+			Type:       "write",
+			VolumeID:   rs.VolumeID,
+			VolumeName: vols[rs.VolumeID].Name,
+			Object:     rs.Object,
+			Status:     Pending,
+		})
+	}
+	start := func(ctx context.Context, job *Job) (int64, string, error) {
+		res, sdkErr := r.sf.StartBulkVolumeWrite(ctx, &sdk.StartBulkVolumeWriteRequest{
+			VolumeID:         job.VolumeID,
+			Format:           r.format(),
+			Script:           Script,
+			ScriptParameters: r.scriptParameters("read", job.Object),
+		})
+		if sdkErr != nil {
+			return 0, "", sdkErr
+		}
+		return res.AsyncHandle, res.Key, nil
+	}
+	return rep, r.run(ctx, rep, start, func(context.Context, *Job) {})
+}
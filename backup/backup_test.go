@@ -0,0 +1,209 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+const bucket = "backups"
+
+// newTestRunner returns a cluster with three nodes, an object store and a runner
+// that backs up to it. Every clock reading advances the cluster by a minute, so
+// jobs run over several polls.
+func newTestRunner(t *testing.T) (*sdktest.Server, *sdk.SFClient, *sdktest.S3Server, *Runner) {
+	t.Helper()
+	srv := sdktest.NewServer()
+	t.Cleanup(srv.Close)
+	s3 := sdktest.NewS3Server()
+	t.Cleanup(s3.Close)
+	for i := 1; i <= 3; i++ {
+		srv.AddNode(fmt.Sprintf("node%d", i))
+	}
+	var ticks atomic.Int64
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv.SetClock(func() time.Time { return start.Add(time.Duration(ticks.Add(1)) * time.Minute) })
+	srv.AsyncDelay = 10 * time.Minute
+	sf, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewRunner(sf, Options{
+		Target:       S3{Hostname: s3.Hostname(), Bucket: bucket, AccessKeyID: "key", SecretAccessKey: "s3cr3t"},
+		Prefix:       "nightly",
+		PollInterval: time.Millisecond,
+	})
+	return srv, sf, s3, r
+}
+
+func createVolumes(t *testing.T, srv *sdktest.Server, sf *sdk.SFClient, n int) []int64 {
+	t.Helper()
+	ctx := context.Background()
+	acct, sdkErr := sf.AddAccount(ctx, &sdk.AddAccountRequest{Username: "tenant"})
+	if sdkErr != nil {
+		t.Fatal(sdkErr)
+	}
+	var ids []int64
+	for i := range n {
+		v, sdkErr := sf.CreateVolume(ctx, &sdk.CreateVolumeRequest{Name: fmt.Sprintf("vol%d", i), AccountID: acct.AccountID, TotalSize: 1 << 30})
+		if sdkErr != nil {
+			t.Fatal(sdkErr)
+		}
+		srv.SetVolumeData(v.VolumeID, []byte(fmt.Sprintf("data of %d", v.VolumeID)))
+		ids = append(ids, v.VolumeID)
+	}
+	return ids
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	srv, sf, s3, r := newTestRunner(t)
+	vols := createVolumes(t, srv, sf, 2)
+	ctx := context.Background()
+
+	rep, err := r.Backup(ctx, vols...)
+	if err != nil || rep.Err() != nil {
+		t.Fatalf("Backup: %v, %v", err, rep.Err())
+	}
+	if rep.Succeeded != 2 || rep.Failed != 0 || len(s3.Keys(bucket)) != 2 {
+		t.Fatalf("unexpected report %+v, objects %v", rep, s3.Keys(bucket))
+	}
+	for _, job := range rep.Jobs {
+		if !strings.HasPrefix(job.Object, fmt.Sprintf("nightly/%s-%d/", job.VolumeName, job.VolumeID)) || job.SnapshotID == 0 || job.PercentComplete != 100 {
+			t.Fatalf("unexpected job %+v", job)
+		}
+		if data, _ := s3.Object(bucket, job.Object); string(data) != fmt.Sprintf("data of %d", job.VolumeID) {
+			t.Fatalf("object %s holds %q", job.Object, data)
+		}
+	}
+	// The snapshots taken for the backups are gone.
+	if snaps, sdkErr := sf.ListSnapshots(ctx, &sdk.ListSnapshotsRequest{}); sdkErr != nil || len(snaps.Snapshots) != 0 {
+		t.Fatalf("snapshots left: %v %+v", sdkErr, snaps)
+	}
+
+	for _, id := range vols {
+		srv.SetVolumeData(id, []byte("overwritten"))
+	}
+	var restores []Restore
+	for _, job := range rep.Jobs {
+		restores = append(restores, Restore{VolumeID: job.VolumeID, Object: job.Object})
+	}
+	rep, err = r.Restore(ctx, restores...)
+	if err != nil || rep.Err() != nil || rep.Succeeded != 2 {
+		t.Fatalf("Restore: %v, %v", err, rep.Err())
+	}
+	for _, id := range vols {
+		if got := string(srv.VolumeData(id)); got != fmt.Sprintf("data of %d", id) {
+			t.Fatalf("volume %d holds %q after restore", id, got)
+		}
+	}
+}
+
+func TestBackupThrottlesPerNode(t *testing.T) {
+	srv, sf, _, r := newTestRunner(t)
+	srv.Limits.BulkVolumeJobsPerNodeMax = 3 // one job per node after the two kept free
+	vols := createVolumes(t, srv, sf, 7)
+
+	running := map[int64]int{}
+	r.OnProgress = func(job Job) {
+		switch {
+		case job.Status == Running && job.PercentComplete == 0:
+			running[job.NodeID]++
+			if running[job.NodeID] > 1 {
+				t.Errorf("node %d runs %d jobs", job.NodeID, running[job.NodeID])
+			}
+		case job.Status == Done || job.Status == Failed:
+			running[job.NodeID]--
+		}
+	}
+	rep, err := r.Backup(context.Background(), vols...)
+	if err != nil || rep.Err() != nil {
+		t.Fatalf("Backup: %v, %v", err, rep.Err())
+	}
+	for _, job := range rep.Jobs {
+		if want := srv.PrimaryNode(job.VolumeID); job.NodeID != want || want == 0 {
+			t.Fatalf("volume %d scheduled on node %d, want %d", job.VolumeID, job.NodeID, want)
+		}
+	}
+	if n := srv.CallCount("StartBulkVolumeRead"); n != len(vols) {
+		t.Fatalf("%d jobs started, want %d", n, len(vols))
+	}
+	// Node mapping comes from one report, not per-volume calls.
+	if srv.CallCount("GetReport") != 1 || srv.CallCount("GetVolumeStats") != 0 {
+		t.Fatalf("GetReport called %d times, GetVolumeStats %d times", srv.CallCount("GetReport"), srv.CallCount("GetVolumeStats"))
+	}
+}
+
+func TestBackupGroupSnapshot(t *testing.T) {
+	srv, sf, s3, r := newTestRunner(t)
+	r.Consistency = Group
+	r.MaxJobsPerNode = 1
+	// Both volumes on the first of three nodes, so the second backup waits for the first.
+	vols := createVolumes(t, srv, sf, 4)
+	vols = []int64{vols[0], vols[3]}
+
+	var progress []int64
+	r.OnProgress = func(job Job) {
+		if job.Status == Running && job.VolumeID == vols[0] {
+			progress = append(progress, job.PercentComplete)
+			// Writes after the group snapshot do not reach the backups.
+			srv.SetVolumeData(vols[1], []byte("changed"))
+		}
+	}
+	rep, err := r.Backup(context.Background(), vols...)
+	if err != nil || rep.Err() != nil {
+		t.Fatalf("Backup: %v, %v", err, rep.Err())
+	}
+	if srv.CallCount("CreateGroupSnapshot") != 1 || srv.CallCount("CreateSnapshot") != 0 || srv.CallCount("DeleteGroupSnapshot") != 1 {
+		t.Fatal("expected one group snapshot, deleted at the end")
+	}
+	if data, _ := s3.Object(bucket, rep.Jobs[1].Object); string(data) != fmt.Sprintf("data of %d", vols[1]) {
+		t.Fatalf("backup of volume %d holds %q", vols[1], data)
+	}
+	if !rep.Jobs[1].Started.After(*rep.Jobs[0].Finished) && !rep.Jobs[1].Started.Equal(*rep.Jobs[0].Finished) {
+		t.Fatal("jobs on one node overlapped")
+	}
+	var partial bool
+	for _, p := range progress {
+		partial = partial || (p > 0 && p < 100)
+	}
+	if !partial {
+		t.Fatalf("no partial progress reported: %v", progress)
+	}
+}
+
+func TestRestoreFailureReport(t *testing.T) {
+	srv, sf, _, r := newTestRunner(t)
+	vols := createVolumes(t, srv, sf, 2)
+
+	rep, err := r.Restore(context.Background(), Restore{VolumeID: vols[0], Object: "missing"}, Restore{VolumeID: vols[1], Object: "also/missing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep.Failed != 2 || rep.Err() == nil || !strings.Contains(rep.Jobs[0].Error, "xBulkVolumeScriptFailure") {
+		t.Fatalf("unexpected report %+v: %v", rep, rep.Err())
+	}
+	if got := string(srv.VolumeData(vols[0])); got != fmt.Sprintf("data of %d", vols[0]) {
+		t.Fatalf("failed restore changed the volume to %q", got)
+	}
+
+	var js, text bytes.Buffer
+	if err := rep.WriteJSON(&js); err != nil {
+		t.Fatal(err)
+	}
+	if err := rep.WriteText(&text); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(js.String(), "s3cr3t") || !strings.Contains(js.String(), `"status": "failed"`) {
+		t.Fatalf("unexpected JSON report:\n%s", js.String())
+	}
+	if !strings.Contains(text.String(), "0 succeeded, 2 failed") || !strings.Contains(text.String(), "error: ") {
+		t.Fatalf("unexpected text report:\n%s", text.String())
+	}
+}
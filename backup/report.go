@@ -0,0 +1,110 @@
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Status is the state of a Job.
+type Status string
+
+const (
+	Pending Status = "pending"
+	Running Status = "running"
+	Done    Status = "done"
+	Failed  Status = "failed"
+)
+
+// Job is the bulk volume job of one volume.
+type Job struct {
+	// Type is "read" for backups and "write" for restores, as in ListBulkVolumeJobs.
+	Type       string `json:"type"`
+	VolumeID   int64  `json:"volumeID"`
+	VolumeName string `json:"volumeName"`
+	// NodeID is the node the volume's primary slice service runs on, or 0 if unknown.
+	NodeID int64 `json:"nodeID"`
+	// SnapshotID is the snapshot a backup reads, or 0.
+	SnapshotID      int64      `json:"snapshotID,omitempty"`
+	Object          string     `json:"object"`
+	AsyncHandle     int64      `json:"asyncHandle,omitempty"`
+	Status          Status     `json:"status"`
+	PercentComplete int64      `json:"percentComplete"`
+	Started         *time.Time `json:"started,omitempty"`
+	Finished        *time.Time `json:"finished,omitempty"`
+	Error           string     `json:"error,omitempty"`
+
+	key string
+}
+
+// Report is the outcome of a backup or restore run. It holds no credentials, so it
+// can be stored next to the backups.
+type Report struct {
+	// Type is "backup" or "restore".
+	Type      string    `json:"type"`
+	Started   time.Time `json:"started"`
+	Finished  time.Time `json:"finished"`
+	Succeeded int       `json:"succeeded"`
+	Failed    int       `json:"failed"`
+	Jobs      []Job     `json:"jobs"`
+}
+
+func (r *Report) count(s Status) int {
+	n := 0
+	for _, job := range r.Jobs {
+		if job.Status == s {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *Report) finish() {
+	r.Finished = time.Now().UTC()
+	r.Succeeded, r.Failed = r.count(Done), r.count(Failed)
+}
+
+// Err returns an error listing the failed jobs, or nil if there are none.
+func (r *Report) Err() error {
+	var failed []string
+	for _, job := range r.Jobs {
+		if job.Status == Failed {
+			failed = append(failed, fmt.Sprintf("volume %d: %s", job.VolumeID, job.Error))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s failed for %d of %d volumes: %s", r.Type, len(failed), len(r.Jobs), strings.Join(failed, "; "))
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteText writes the report as a plain text table for people, such as:
+//
+//	42  db-data  node 3  done  100%  3m12s  backups/db-data-42/20240101T000000Z
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s of %d volumes at %s: %d succeeded, %d failed\n", r.Type, len(r.Jobs), r.Started.Format(time.RFC3339), r.Succeeded, r.Failed)
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "volume\tname\tnode\tstatus\tprogress\ttime\tobject\t")
+	for _, job := range r.Jobs {
+		var took string
+		if job.Started != nil && job.Finished != nil {
+			took = job.Finished.Sub(*job.Started).Round(time.Second).String()
+		}
+		fmt.Fprintf(tw, "%d\t%s\tnode %d\t%s\t%d%%\t%s\t%s\t\n", job.VolumeID, job.VolumeName, job.NodeID, job.Status, job.PercentComplete, took, job.Object)
+		if job.Error != "" {
+			fmt.Fprintf(tw, "\t\t\terror: %s\t\t\t\t\n", job.Error)
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,231 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	log "github.com/sirupsen/logrus"
+)
+
+// startFunc starts the bulk volume job of job and returns its async handle and key.
+type startFunc func(ctx context.Context, job *Job) (int64, string, error)
+
+// perNode returns the number of jobs to run at once on each node.
+func (r *Runner) perNode(ctx context.Context) (int64, error) {
+	if r.MaxJobsPerNode > 0 {
+		return r.MaxJobsPerNode, nil
+	}
+	limits, sdkErr := r.sf.GetLimits(ctx)
+	if sdkErr != nil {
+		return 0, sdkErr
+	}
+	n := limits.BulkVolumeJobsPerNodeMax
+	if n <= 0 {
+		n = 8
+	}
+	if n > 2 {
+		n -= 2
+	}
+	return n, nil
+}
+
+// locate maps volumes to the node of their primary slice service. It reads the
+// slices.json report and falls back to GetVolumeStats and ListServices for volumes
+// the report does not list. Volumes it cannot place are mapped to node 0, which is
+// throttled like any other node.
+func (r *Runner) locate(ctx context.Context, ids []int64) map[int64]int64 {
+	serviceNode := map[int64]int64{}
+	volumeNode := map[int64]int64{}
+	report, sdkErr := r.sf.GetReport(ctx, &sdk.GetReportRequest{ReportName: "slices.json"})
+	if sdkErr != nil {
+		log.WithContext(ctx).Warnf("slices.json report unavailable, using GetVolumeStats: %v", sdkErr)
+		report = &sdk.GetReportResult{}
+	}
+	for _, svc := range report.Services {
+		serviceNode[svc.ServiceID] = svc.NodeID
+	}
+	for _, slice := range report.Slices {
+		if node, ok := serviceNode[slice.Primary]; ok {
+			volumeNode[slice.VolumeID] = node
+		}
+	}
+
+	for _, id := range ids {
+		if _, ok := volumeNode[id]; ok {
+			continue
+		}
+		if len(serviceNode) == 0 {
+			if res, sdkErr := r.sf.ListServices(ctx); sdkErr == nil {
+				for _, s := range res.Services {
+					serviceNode[s.Service.ServiceID] = s.Service.NodeID
+				}
+			}
+		}
+		stats, sdkErr := r.sf.GetVolumeStats(ctx, &sdk.GetVolumeStatsRequest{VolumeID: id})
+		if sdkErr == nil {
+			if node, ok := serviceNode[stats.VolumeStats.MetadataHosts.Primary]; ok {
+				volumeNode[id] = node
+				continue
+			}
+		}
+		log.WithContext(ctx).Warnf("cannot find the primary node of volume %d", id)
+		volumeNode[id] = 0
+	}
+	return volumeNode
+}
+
+// run starts the pending jobs of rep as node slots allow and waits for all of them.
+func (r *Runner) run(ctx context.Context, rep *Report, start startFunc, finish func(context.Context, *Job)) error {
+	defer rep.finish()
+	perNode, err := r.perNode(ctx)
+	if err != nil {
+		return err
+	}
+	var ids []int64
+	for _, job := range rep.Jobs {
+		ids = append(ids, job.VolumeID)
+	}
+	nodes := r.locate(ctx, ids)
+	for i := range rep.Jobs {
+		rep.Jobs[i].NodeID = nodes[rep.Jobs[i].VolumeID]
+	}
+
+	poll := r.PollInterval
+	if poll <= 0 {
+		poll = 5 * time.Second
+	}
+	done := map[int64]sdk.AsyncJob{}
+	tracker := sdk.NewJobTracker(r.sf, nil)
+	tracker.OnDone = func(job sdk.AsyncJob) { done[job.Handle] = job }
+	load := map[int64]int64{}
+
+	for {
+		// Start what fits. A node the cluster reports as full, because of jobs started
+		// elsewhere, is skipped until the next round.
+		full := map[int64]bool{}
+		for i := range rep.Jobs {
+			job := &rep.Jobs[i]
+			if job.Status != Pending || full[job.NodeID] || load[job.NodeID] >= perNode {
+				continue
+			}
+			handle, key, err := start(ctx, job)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				var sdkErr *sdk.SdkError
+				if errors.As(err, &sdkErr) && sdkErr.Name == "xExceededLimit" {
+					log.WithContext(ctx).Debugf("node %d is busy, volume %d waits: %v", job.NodeID, job.VolumeID, err)
+					full[job.NodeID] = true
+					continue
+				}
+				r.fail(job, err)
+				continue
+			}
+			now := time.Now().UTC()
+			job.AsyncHandle, job.key, job.Status, job.Started = handle, key, Running, &now
+			load[job.NodeID]++
+			if err := tracker.Track(ctx, handle, job.Object); err != nil {
+				return err
+			}
+			r.progress(*job)
+		}
+		if rep.count(Running) == 0 && (rep.count(Pending) == 0 || len(full) == 0) {
+			// Nothing runs and nothing more can start.
+			for i := range rep.Jobs {
+				if rep.Jobs[i].Status == Pending {
+					r.fail(&rep.Jobs[i], fmt.Errorf("not started"))
+				}
+			}
+			return nil
+		}
+
+		if err := sleepCtx(ctx, poll); err != nil {
+			return err
+		}
+		r.updateProgress(ctx, rep)
+		if err := tracker.Poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.WithContext(ctx).Warnf("ListAsyncResults failed, retrying in %s: %v", poll, err)
+			continue
+		}
+		for i := range rep.Jobs {
+			job := &rep.Jobs[i]
+			result, ok := done[job.AsyncHandle]
+			if job.Status != Running || !ok {
+				continue
+			}
+			load[job.NodeID]--
+			finish(ctx, job)
+			if result.Err != nil {
+				r.fail(job, result.Err)
+				continue
+			}
+			now := time.Now().UTC()
+			job.Status, job.PercentComplete, job.Finished = Done, 100, &now
+			r.progress(*job)
+		}
+	}
+}
+
+// updateProgress copies percentComplete from ListBulkVolumeJobs to running jobs.
+func (r *Runner) updateProgress(ctx context.Context, rep *Report) {
+	res, sdkErr := r.sf.ListBulkVolumeJobs(ctx)
+	if sdkErr != nil {
+		log.WithContext(ctx).Debugf("ListBulkVolumeJobs failed: %v", sdkErr)
+		return
+	}
+	percent := map[string]int64{}
+	for _, bv := range res.BulkVolumeJobs {
+		percent[bv.Key] = bv.PercentComplete
+	}
+	for i := range rep.Jobs {
+		job := &rep.Jobs[i]
+		p, ok := percent[job.key]
+		if job.Status != Running || !ok || p == job.PercentComplete {
+			continue
+		}
+		job.PercentComplete = p
+		r.progress(*job)
+	}
+}
+
+func (r *Runner) fail(job *Job, err error) {
+	now := time.Now().UTC()
+	job.Status, job.Finished, job.Error = Failed, &now, err.Error()
+	r.progress(*job)
+}
+
+func (r *Runner) progress(job Job) {
+	if r.OnProgress != nil {
+		r.OnProgress(job)
+	}
+}
+
+func (r *Runner) deleteSnapshot(ctx context.Context, job *Job) {
+	if _, sdkErr := r.sf.DeleteSnapshot(ctx, &sdk.DeleteSnapshotRequest{SnapshotID: job.SnapshotID}); sdkErr != nil {
+		log.WithContext(ctx).Warnf("deleting snapshot %d of volume %d: %v", job.SnapshotID, job.VolumeID, sdkErr)
+	}
+}
+
+func (r *Runner) deleteGroupSnapshot(ctx context.Context, id int64) {
+	if _, sdkErr := r.sf.DeleteGroupSnapshot(ctx, &sdk.DeleteGroupSnapshotRequest{GroupSnapshotID: id}); sdkErr != nil {
+		log.WithContext(ctx).Warnf("deleting group snapshot %d: %v", id, sdkErr)
+	}
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
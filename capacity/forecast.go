@@ -0,0 +1,281 @@
+// Package capacity forecasts cluster fullness from ListClusterCapacityHistory. It
+// fits a linear trend to used block space, provisioned space and used metadata
+// space, and projects when the cluster crosses the stage 3, 4 and 5 fullness
+// thresholds reported by GetClusterFullThreshold, so node purchases can be planned
+// months ahead.
+package capacity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+// ErrInsufficientHistory is returned when fewer than two samples at different times
+// are available to fit a trend.
+var ErrInsufficientHistory = errors.New("capacity: not enough capacity history")
+
+// Resource is the space a projection is about.
+type Resource string
+
+const (
+	// Block is used block space (usedSpace) against the block fullness thresholds.
+	Block Resource = "block"
+	// Metadata is used metadata space (usedMetadataSpace) against the metadata thresholds.
+	Metadata Resource = "metadata"
+	// Provisioned is provisioned space against maxOverProvisionableSpace, beyond
+	// which no volumes can be created.
+	Provisioned Resource = "provisioned"
+)
+
+// Stage names, as GetClusterFullThreshold reports them.
+const (
+	Stage3Low                = "stage3Low"
+	Stage4Critical           = "stage4Critical"
+	Stage5CompletelyConsumed = "stage5CompletelyConsumed"
+	// MaxOverProvisionable is the stage name used for Provisioned projections.
+	MaxOverProvisionable = "maxOverProvisionableSpace"
+)
+
+// Config controls the forecast.
+type Config struct {
+	// Cluster names the cluster in the report.
+	Cluster string `yaml:"cluster"`
+	// Window limits the trend to samples taken within this long before the latest
+	// one. Zero uses the whole history.
+	Window time.Duration `yaml:"window"`
+}
+
+// Growth is the current value and trend of one resource.
+type Growth struct {
+	CurrentBytes int64 `json:"currentBytes"`
+	// LimitBytes is the highest threshold for the resource: stage 5 for block and
+	// metadata space, maxOverProvisionableSpace for provisioned space.
+	LimitBytes int64 `json:"limitBytes"`
+	// BytesPerDay is the slope of a least squares fit over the samples. It may be negative.
+	BytesPerDay float64 `json:"bytesPerDay"`
+}
+
+// Projection is when one resource crosses one threshold at the current growth rate.
+type Projection struct {
+	Resource       Resource `json:"resource"`
+	Stage          string   `json:"stage"`
+	ThresholdBytes int64    `json:"thresholdBytes"`
+	// RemainingBytes is the space left before the threshold, zero once it is reached.
+	RemainingBytes int64 `json:"remainingBytes"`
+	// Reached is set when the current value is already at or beyond the threshold.
+	Reached bool `json:"reached"`
+	// Date is when the threshold is crossed. It is nil when the resource is not
+	// growing, and equal to the report time when Reached is set.
+	Date *time.Time `json:"date,omitempty"`
+	// Days is the number of days from the report time until Date.
+	Days float64 `json:"days,omitempty"`
+}
+
+// Report is the result of a forecast.
+type Report struct {
+	Cluster string `json:"cluster,omitempty"`
+	// Time is the timestamp of the current capacity sample, which projections start from.
+	Time time.Time `json:"time"`
+	// Samples is the number of history samples used; From and To are the first and last of them.
+	Samples int       `json:"samples"`
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+
+	Fullness         string `json:"fullness,omitempty"`
+	BlockFullness    string `json:"blockFullness,omitempty"`
+	MetadataFullness string `json:"metadataFullness,omitempty"`
+
+	Used        Growth `json:"used"`
+	Provisioned Growth `json:"provisioned"`
+	Metadata    Growth `json:"metadata"`
+
+	// Projections are ordered by resource (block, metadata, provisioned) and stage.
+	Projections []Projection `json:"projections"`
+}
+
+// Next returns the earliest upcoming crossing that has not been reached yet, or nil.
+func (r *Report) Next() *Projection {
+	var next *Projection
+	for i := range r.Projections {
+		p := &r.Projections[i]
+		if p.Reached || p.Date == nil {
+			continue
+		}
+		if next == nil || p.Date.Before(*next.Date) {
+			next = p
+		}
+	}
+	return next
+}
+
+// Forecast reads the capacity history, the current capacity and the fullness
+// thresholds from the cluster and returns the report.
+func Forecast(ctx context.Context, sf *sdk.SFClient, cfg Config) (*Report, error) {
+	history, sdkErr := sf.ListClusterCapacityHistory(ctx)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	current, sdkErr := sf.GetClusterCapacity(ctx)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	threshold, sdkErr := sf.GetClusterFullThreshold(ctx)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	return Analyze(history.ClusterCapacityHistory, current.ClusterCapacity, *threshold, cfg)
+}
+
+// Analyze builds the report from data that has already been fetched, for example
+// from a cassette or a stored history. The current sample is added to the history
+// if it is newer than the last history sample.
+func Analyze(history []sdk.ClusterCapacity, current sdk.ClusterCapacity, threshold sdk.GetClusterFullThresholdResult, cfg Config) (*Report, error) {
+	samples, err := parseSamples(history)
+	if err != nil {
+		return nil, err
+	}
+	now, err := parseTimestamp(current.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if len(samples) == 0 || now.After(samples[len(samples)-1].t) {
+		samples = append(samples, sample{t: now, c: current})
+	}
+	if cfg.Window > 0 {
+		cutoff := samples[len(samples)-1].t.Add(-cfg.Window)
+		i := sort.Search(len(samples), func(i int) bool { return !samples[i].t.Before(cutoff) })
+		samples = samples[i:]
+	}
+	if len(samples) < 2 || !samples[len(samples)-1].t.After(samples[0].t) {
+		return nil, fmt.Errorf("%w: %d usable samples", ErrInsufficientHistory, len(samples))
+	}
+
+	r := &Report{
+		Cluster:          cfg.Cluster,
+		Time:             now,
+		Samples:          len(samples),
+		From:             samples[0].t,
+		To:               samples[len(samples)-1].t,
+		Fullness:         threshold.Fullness,
+		BlockFullness:    threshold.BlockFullness,
+		MetadataFullness: threshold.MetadataFullness,
+	}
+
+	blockLimit := nonZero(threshold.Stage5BlockThresholdBytes, threshold.SumTotalClusterBytes)
+	metadataLimit := nonZero(threshold.Stage5MetadataThresholdBytes, threshold.SumTotalMetadataClusterBytes)
+	r.Used = Growth{
+		CurrentBytes: nonZero(threshold.SumUsedClusterBytes, current.UsedSpace),
+		LimitBytes:   blockLimit,
+		BytesPerDay:  slope(samples, func(c *sdk.ClusterCapacity) int64 { return c.UsedSpace }),
+	}
+	r.Metadata = Growth{
+		CurrentBytes: nonZero(threshold.SumUsedMetadataClusterBytes, current.UsedMetadataSpace),
+		LimitBytes:   metadataLimit,
+		BytesPerDay:  slope(samples, func(c *sdk.ClusterCapacity) int64 { return c.UsedMetadataSpace }),
+	}
+	r.Provisioned = Growth{
+		CurrentBytes: current.ProvisionedSpace,
+		LimitBytes:   current.MaxOverProvisionableSpace,
+		BytesPerDay:  slope(samples, func(c *sdk.ClusterCapacity) int64 { return c.ProvisionedSpace }),
+	}
+
+	r.project(Block, &r.Used, map[string]int64{
+		Stage3Low:                threshold.Stage3BlockThresholdBytes,
+		Stage4Critical:           threshold.Stage4BlockThresholdBytes,
+		Stage5CompletelyConsumed: blockLimit,
+	})
+	r.project(Metadata, &r.Metadata, map[string]int64{
+		Stage3Low:                threshold.Stage3MetadataThresholdBytes,
+		Stage4Critical:           threshold.Stage4MetadataThresholdBytes,
+		Stage5CompletelyConsumed: metadataLimit,
+	})
+	r.project(Provisioned, &r.Provisioned, map[string]int64{MaxOverProvisionable: current.MaxOverProvisionableSpace})
+	return r, nil
+}
+
+// stageOrder lists the stages in the order projections are reported.
+var stageOrder = []string{Stage3Low, Stage4Critical, Stage5CompletelyConsumed, MaxOverProvisionable}
+
+// project adds a projection for each stage with a known threshold.
+func (r *Report) project(resource Resource, g *Growth, thresholds map[string]int64) {
+	for _, stage := range stageOrder {
+		limit, ok := thresholds[stage]
+		if !ok || limit <= 0 {
+			continue
+		}
+		p := Projection{Resource: resource, Stage: stage, ThresholdBytes: limit}
+		switch remaining := limit - g.CurrentBytes; {
+		case remaining <= 0:
+			at := r.Time
+			p.Reached, p.Date = true, &at
+		case g.BytesPerDay > 0:
+			p.RemainingBytes = remaining
+			p.Days = float64(remaining) / g.BytesPerDay
+			at := r.Time.Add(time.Duration(p.Days * float64(24*time.Hour)))
+			p.Date = &at
+		default:
+			p.RemainingBytes = remaining
+		}
+		r.Projections = append(r.Projections, p)
+	}
+}
+
+type sample struct {
+	t time.Time
+	c sdk.ClusterCapacity
+}
+
+// parseSamples returns the history sorted by time.
+func parseSamples(history []sdk.ClusterCapacity) ([]sample, error) {
+	samples := make([]sample, 0, len(history)+1)
+	for _, c := range history {
+		t, err := parseTimestamp(c.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, sample{t: t, c: c})
+	}
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].t.Before(samples[j].t) })
+	return samples, nil
+}
+
+func parseTimestamp(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("capacity: bad sample timestamp %q: %w", s, err)
+	}
+	return t, nil
+}
+
+// slope fits value over time by least squares and returns bytes per day.
+func slope(samples []sample, value func(*sdk.ClusterCapacity) int64) float64 {
+	n := float64(len(samples))
+	var sumX, sumY float64
+	for i := range samples {
+		sumX += samples[i].t.Sub(samples[0].t).Hours() / 24
+		sumY += float64(value(&samples[i].c))
+	}
+	meanX, meanY := sumX/n, sumY/n
+	var num, den float64
+	for i := range samples {
+		dx := samples[i].t.Sub(samples[0].t).Hours()/24 - meanX
+		num += dx * (float64(value(&samples[i].c)) - meanY)
+		den += dx * dx
+	}
+	if den == 0 {
+		return 0
+	}
+	return num / den
+}
+
+func nonZero(v, fallback int64) int64 {
+	if v != 0 {
+		return v
+	}
+	return fallback
+}
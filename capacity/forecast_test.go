@@ -0,0 +1,176 @@
+package capacity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+const (
+	gb = 1000 * 1000 * 1000
+	tb = 1000 * gb
+)
+
+var start = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// daily returns one sample per day for days days. Used space grows by 100 GB and
+// metadata by 10 GB a day; provisioned space stays flat.
+func daily(days int) []sdk.ClusterCapacity {
+	var out []sdk.ClusterCapacity
+	for d := 0; d < days; d++ {
+		out = append(out, sdk.ClusterCapacity{
+			Timestamp:                 start.AddDate(0, 0, d).Format(time.RFC3339),
+			UsedSpace:                 20*tb + int64(d)*100*gb,
+			UsedMetadataSpace:         1*tb + int64(d)*10*gb,
+			ProvisionedSpace:          50 * tb,
+			MaxOverProvisionableSpace: 200 * tb,
+		})
+	}
+	return out
+}
+
+func approx(t *testing.T, name string, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-6*math.Max(1, math.Abs(want)) {
+		t.Errorf("%s = %v, want %v", name, got, want)
+	}
+}
+
+func TestForecast(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+	for _, c := range daily(31) {
+		srv.AddCapacitySample(c)
+	}
+	sf, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := Forecast(context.Background(), sf, Config{Cluster: "lab"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Samples != 31 || !r.From.Equal(start) || !r.Time.Equal(start.AddDate(0, 0, 30)) {
+		t.Errorf("samples %d from %s at %s", r.Samples, r.From, r.Time)
+	}
+	if r.BlockFullness != "stage1Happy" {
+		t.Errorf("BlockFullness = %q", r.BlockFullness)
+	}
+	approx(t, "used growth", r.Used.BytesPerDay, 100*gb)
+	approx(t, "metadata growth", r.Metadata.BytesPerDay, 10*gb)
+	approx(t, "provisioned growth", r.Provisioned.BytesPerDay, 0)
+	if r.Used.CurrentBytes != 23*tb || r.Used.LimitBytes != 40*tb {
+		t.Errorf("used = %+v", r.Used)
+	}
+
+	want := []struct {
+		resource Resource
+		stage    string
+		days     float64
+	}{
+		{Block, Stage3Low, 70},
+		{Block, Stage4Critical, 120},
+		{Block, Stage5CompletelyConsumed, 170},
+		{Metadata, Stage3Low, 170},
+		{Metadata, Stage4Critical, 220},
+		{Metadata, Stage5CompletelyConsumed, 270},
+		{Provisioned, MaxOverProvisionable, -1},
+	}
+	if len(r.Projections) != len(want) {
+		t.Fatalf("projections = %+v", r.Projections)
+	}
+	for i, w := range want {
+		p := r.Projections[i]
+		if p.Resource != w.resource || p.Stage != w.stage {
+			t.Errorf("projection %d = %s %s, want %s %s", i, p.Resource, p.Stage, w.resource, w.stage)
+			continue
+		}
+		if w.days < 0 {
+			if p.Date != nil || p.RemainingBytes != 150*tb {
+				t.Errorf("%s %s = %+v, want no date", p.Resource, p.Stage, p)
+			}
+			continue
+		}
+		approx(t, string(p.Resource)+" "+p.Stage, p.Days, w.days)
+		if wantDate := r.Time.AddDate(0, 0, int(w.days)); p.Date == nil || !p.Date.Equal(wantDate) {
+			t.Errorf("%s %s date = %v, want %s", p.Resource, p.Stage, p.Date, wantDate)
+		}
+	}
+	if next := r.Next(); next == nil || next.Resource != Block || next.Stage != Stage3Low {
+		t.Errorf("Next() = %+v", next)
+	}
+
+	var text bytes.Buffer
+	if err := r.WriteText(&text); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"forecast for lab", "+100.0 GB", "in 70 days (2026-04-11)", "not growing"} {
+		if !strings.Contains(text.String(), s) {
+			t.Errorf("text report lacks %q:\n%s", s, text.String())
+		}
+	}
+
+	var js bytes.Buffer
+	if err := r.WriteJSON(&js); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(js.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Projections) != len(want) || decoded.Used != r.Used {
+		t.Errorf("JSON round trip = %+v", decoded)
+	}
+}
+
+func TestAnalyzeWindowAndReached(t *testing.T) {
+	history := daily(20)
+	// Growth tripled over the last five days.
+	for d := 15; d < 20; d++ {
+		history[d].UsedSpace = history[14].UsedSpace + int64(d-14)*300*gb
+	}
+	current := history[19]
+	threshold := sdk.GetClusterFullThresholdResult{
+		Stage3BlockThresholdBytes: 20 * tb,
+		Stage4BlockThresholdBytes: 30 * tb,
+		Stage5BlockThresholdBytes: 40 * tb,
+	}
+
+	r, err := Analyze(history, current, threshold, Config{Window: 4 * 24 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Samples != 5 {
+		t.Errorf("samples = %d, want 5", r.Samples)
+	}
+	approx(t, "used growth", r.Used.BytesPerDay, 300*gb)
+	stage3 := r.Projections[0]
+	if !stage3.Reached || stage3.RemainingBytes != 0 || stage3.Date == nil || !stage3.Date.Equal(r.Time) {
+		t.Errorf("stage3 = %+v, want reached", stage3)
+	}
+	// Without metadata thresholds only the block projections are made.
+	if len(r.Projections) != 4 || r.Projections[3].Resource != Provisioned {
+		t.Errorf("projections = %+v", r.Projections)
+	}
+}
+
+func TestAnalyzeInsufficientHistory(t *testing.T) {
+	history := daily(1)
+	_, err := Analyze(history, history[0], sdk.GetClusterFullThresholdResult{}, Config{})
+	if !errors.Is(err, ErrInsufficientHistory) {
+		t.Errorf("err = %v, want ErrInsufficientHistory", err)
+	}
+	_, err = Analyze(nil, sdk.ClusterCapacity{Timestamp: "yesterday"}, sdk.GetClusterFullThresholdResult{}, Config{})
+	if err == nil || !strings.Contains(err.Error(), "bad sample timestamp") {
+		t.Errorf("err = %v", err)
+	}
+}
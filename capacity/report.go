@@ -0,0 +1,79 @@
+package capacity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"text/tabwriter"
+	"time"
+)
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteText writes the report as a plain text summary for people, such as:
+//
+//	block     stage3Low                  30.0 TB   5.2 TB left   in 87 days (2027-01-11)
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	title := "Cluster capacity forecast"
+	if r.Cluster != "" {
+		title += " for " + r.Cluster
+	}
+	fmt.Fprintf(tw, "%s at %s\n", title, r.Time.Format(time.RFC3339))
+	fmt.Fprintf(tw, "Trend over %d samples from %s to %s\n", r.Samples, r.From.Format(dateLayout), r.To.Format(dateLayout))
+	if r.Fullness != "" {
+		fmt.Fprintf(tw, "Fullness: %s (block %s, metadata %s)\n", r.Fullness, r.BlockFullness, r.MetadataFullness)
+	}
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "\tcurrent\tlimit\tgrowth per day\t")
+	for _, g := range []struct {
+		name string
+		g    *Growth
+	}{{"used", &r.Used}, {"metadata", &r.Metadata}, {"provisioned", &r.Provisioned}} {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t\n", g.name, FormatBytes(float64(g.g.CurrentBytes)), FormatBytes(float64(g.g.LimitBytes)), signed(g.g.BytesPerDay))
+	}
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "Projections:")
+	for _, p := range r.Projections {
+		var when string
+		switch {
+		case p.Reached:
+			when = "reached"
+		case p.Date == nil:
+			when = "not growing"
+		default:
+			when = fmt.Sprintf("in %.0f days (%s)", math.Ceil(p.Days), p.Date.Format(dateLayout))
+		}
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s left\t%s\t\n", p.Resource, p.Stage, FormatBytes(float64(p.ThresholdBytes)), FormatBytes(float64(p.RemainingBytes)), when)
+	}
+	return tw.Flush()
+}
+
+const dateLayout = "2006-01-02"
+
+// FormatBytes formats b with decimal units, as drive and node capacities are sold.
+func FormatBytes(b float64) string {
+	units := []string{"B", "KB", "MB", "GB", "TB", "PB"}
+	i := 0
+	for math.Abs(b) >= 1000 && i < len(units)-1 {
+		b /= 1000
+		i++
+	}
+	if i == 0 {
+		return fmt.Sprintf("%.0f B", b)
+	}
+	return fmt.Sprintf("%.1f %s", b, units[i])
+}
+
+func signed(b float64) string {
+	if b > 0 {
+		return "+" + FormatBytes(b)
+	}
+	return FormatBytes(b)
+}
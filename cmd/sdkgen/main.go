@@ -0,0 +1,370 @@
+// Command sdkgen generates the sdk package's generated_*.go files from the API
+// descriptor in sdk/api/element.json. The descriptor lists every method with its
+// request and result types, every type with its members, their documentation and
+// the API version they first appeared in.
+//
+// Run it from the repository root, or through go generate in the sdk directory:
+//
+//	go run ./cmd/sdkgen
+//	go run ./cmd/sdkgen -check   # fail if the checked-in files are stale
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// API is the descriptor.
+type API struct {
+	// Version is the Element version the descriptor was taken from.
+	Version string   `json:"version"`
+	Types   []Type   `json:"types"`
+	Methods []Method `json:"methods"`
+}
+
+// Type is a named model type.
+type Type struct {
+	Name string   `json:"name"`
+	Doc  []string `json:"doc,omitempty"`
+	// Kind is "struct", or the underlying type of an enumeration such as "string".
+	Kind    string   `json:"kind"`
+	Members []Member `json:"members,omitempty"`
+}
+
+// Member is a field of a struct type.
+type Member struct {
+	// Name is the Go field name. It is empty for embedded types.
+	Name string `json:"name,omitempty"`
+	// JSON is the member name on the wire.
+	JSON string `json:"json,omitempty"`
+	// Type is the Go type, such as "int64", "[]Volume" or "map[string]interface{}".
+	Type string `json:"type"`
+	// Optional members are omitted from requests when empty.
+	Optional bool     `json:"optional,omitempty"`
+	Doc      []string `json:"doc,omitempty"`
+	// Since is the first API version that accepts or returns the member.
+	Since string `json:"since,omitempty"`
+}
+
+// Method is a JSON-RPC method.
+type Method struct {
+	Name string   `json:"name"`
+	Doc  []string `json:"doc,omitempty"`
+	// Params is the request type; empty for methods without parameters.
+	Params string `json:"params,omitempty"`
+	// Result is the result type, or "interface{}" for untyped results.
+	Result string `json:"result"`
+	// Since is the first API version that has the method.
+	Since string `json:"since,omitempty"`
+	// Endpoint is "node" for methods served only by the per-node API (port 442),
+	// "both" for methods served by the per-node and the cluster API, and empty for
+	// cluster methods. Methods with an endpoint are also generated for NodeClient.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+const header = "// Code generated by sdkgen from api/element.json. DO NOT EDIT.\n\npackage sdk\n\n"
+
+func main() {
+	apiPath := flag.String("api", "sdk/api/element.json", "API descriptor")
+	out := flag.String("out", "sdk", "directory of the generated files")
+	check := flag.Bool("check", false, "report stale files instead of writing them")
+	flag.Parse()
+
+	api, err := load(*apiPath)
+	if err != nil {
+		fail(err)
+	}
+	files, err := generate(api)
+	if err != nil {
+		fail(err)
+	}
+	if *check {
+		stale, err := compare(*out, files)
+		if err != nil {
+			fail(err)
+		}
+		if len(stale) > 0 {
+			fail(fmt.Errorf("%s out of date with %s; run go run ./cmd/sdkgen", strings.Join(stale, ", "), *apiPath))
+		}
+		return
+	}
+	for _, name := range sortedNames(files) {
+		if err := os.WriteFile(filepath.Join(*out, name), files[name], 0o644); err != nil {
+			fail(err)
+		}
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "sdkgen:", err)
+	os.Exit(1)
+}
+
+// load reads and validates the descriptor.
+func load(path string) (*API, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var api API
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&api); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := api.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &api, nil
+}
+
+func (api *API) validate() error {
+	types := map[string]bool{}
+	for _, t := range api.Types {
+		if types[t.Name] {
+			return fmt.Errorf("duplicate type %s", t.Name)
+		}
+		types[t.Name] = true
+		if t.Kind != "struct" && len(t.Members) > 0 {
+			return fmt.Errorf("type %s of kind %s has members", t.Name, t.Kind)
+		}
+		fields := map[string]bool{}
+		for _, m := range t.Members {
+			if m.Type == "" {
+				return fmt.Errorf("%s: member %q has no type", t.Name, m.Name)
+			}
+			if m.Name != "" && m.JSON == "" {
+				return fmt.Errorf("%s.%s has no JSON name", t.Name, m.Name)
+			}
+			name := m.Name
+			if name == "" {
+				name = m.Type
+			}
+			if fields[name] {
+				return fmt.Errorf("%s: duplicate member %s", t.Name, name)
+			}
+			fields[name] = true
+		}
+	}
+	methods := map[string]bool{}
+	for _, m := range api.Methods {
+		if methods[m.Name] {
+			return fmt.Errorf("duplicate method %s", m.Name)
+		}
+		methods[m.Name] = true
+		if m.Params != "" && !types[m.Params] {
+			return fmt.Errorf("method %s: unknown params type %s", m.Name, m.Params)
+		}
+		if m.Result != "interface{}" && !types[m.Result] {
+			return fmt.Errorf("method %s: unknown result type %s", m.Name, m.Result)
+		}
+		if m.Endpoint != "" && m.Endpoint != "node" && m.Endpoint != "both" {
+			return fmt.Errorf("method %s: unknown endpoint %q", m.Name, m.Endpoint)
+		}
+	}
+	return nil
+}
+
+// generate renders the generated files, keyed by file name. Types and methods
+// are written in name order so that output only changes with the descriptor.
+func generate(api *API) (map[string][]byte, error) {
+	types := append([]Type(nil), api.Types...)
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	methods := append([]Method(nil), api.Methods...)
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Name < methods[j].Name })
+
+	var model, impl, iface, stubs, versions, node bytes.Buffer
+	model.WriteString(header)
+	for _, t := range types {
+		writeDoc(&model, "", t.Doc, "")
+		if t.Kind != "struct" {
+			fmt.Fprintf(&model, "type %s %s\n\n", t.Name, t.Kind)
+			continue
+		}
+		fmt.Fprintf(&model, "type %s struct {\n", t.Name)
+		for _, m := range t.Members {
+			writeDoc(&model, "\t", m.Doc, m.Since)
+			if m.Name == "" {
+				fmt.Fprintf(&model, "\t%s\n", m.Type)
+				continue
+			}
+			tag := m.JSON
+			if m.Optional {
+				tag += ",omitempty"
+			}
+			fmt.Fprintf(&model, "\t%s %s `json:%q`\n", m.Name, m.Type, tag)
+		}
+		model.WriteString("}\n\n")
+	}
+
+	impl.WriteString(header + "import \"context\"\n\n")
+	stubs.WriteString(header + "import \"context\"\n\n")
+	iface.WriteString(header + "import \"context\"\n\n")
+	iface.WriteString("// SFApi is implemented by SFClient, and by SFStubClient for tests.\ntype SFApi interface {\n")
+	var nodeMethods []Method
+	for i, m := range methods {
+		signature, req := methodSignature(m)
+		doc := m.Doc
+		if m.Endpoint != "" {
+			nodeMethods = append(nodeMethods, m)
+		}
+		if m.Endpoint == "node" {
+			if len(doc) > 0 {
+				doc = append(doc[:len(doc):len(doc)], "")
+			}
+			doc = append(doc, nodeNote)
+		}
+
+		writeDoc(&impl, "", doc, m.Since)
+		fmt.Fprintf(&impl, "func (sfClient *SFClient) %s {\n\tvar res %s\n\t_, err := sfClient.MakeSFCall(ctx, %q, 1, %s, &res)\n\treturn &res, err\n}\n\n",
+			signature, m.Result, m.Name, req)
+
+		writeDoc(&stubs, "", doc, m.Since)
+		fmt.Fprintf(&stubs, "func (sfClient *SFStubClient) %s {\n\tsdkerror := SdkError{Code: NetworkError, Detail: \"not implemented\"}\n\treturn nil, &sdkerror\n}\n\n", signature)
+
+		if i > 0 {
+			iface.WriteString("\n")
+		}
+		writeDoc(&iface, "\t", doc, m.Since)
+		fmt.Fprintf(&iface, "\t%s\n", signature)
+	}
+	iface.WriteString("}\n\nvar (\n\t_ SFApi = (*SFClient)(nil)\n\t_ SFApi = (*SFStubClient)(nil)\n)\n")
+
+	node.WriteString(header + "import \"context\"\n\n")
+	node.WriteString("// NodeApi holds the methods served by the per-node API. It is implemented by NodeClient.\ntype NodeApi interface {\n")
+	for i, m := range nodeMethods {
+		if i > 0 {
+			node.WriteString("\n")
+		}
+		signature, _ := methodSignature(m)
+		writeDoc(&node, "\t", m.Doc, m.Since)
+		fmt.Fprintf(&node, "\t%s\n", signature)
+	}
+	node.WriteString("}\n\nvar _ NodeApi = (*NodeClient)(nil)\n\n")
+	for _, m := range nodeMethods {
+		signature, req := methodSignature(m)
+		writeDoc(&node, "", m.Doc, m.Since)
+		fmt.Fprintf(&node, "func (nodeClient *NodeClient) %s {\n\tvar res %s\n\t_, err := nodeClient.sfClient.MakeSFCall(ctx, %q, 1, %s, &res)\n\treturn &res, err\n}\n\n",
+			signature, m.Result, m.Name, req)
+	}
+
+	versions.WriteString(header)
+	versions.WriteString("// methodSince maps methods to the first API version that has them.\nvar methodSince = map[string]string{\n")
+	for _, m := range methods {
+		if m.Since != "" {
+			fmt.Fprintf(&versions, "\t%q: %q,\n", m.Name, m.Since)
+		}
+	}
+	versions.WriteString("}\n\n// requestSince maps methods to the request members that need a newer API version.\nvar requestSince = map[string][]versionedMember{\n")
+	byName := map[string]Type{}
+	for _, t := range types {
+		byName[t.Name] = t
+	}
+	for _, m := range methods {
+		var members []Member
+		newer := func(f Member) bool { return f.Since != "" && f.Name != "" && (m.Since == "" || f.Since != m.Since) }
+		for _, f := range byName[m.Params].Members {
+			if newer(f) {
+				members = append(members, f)
+				continue
+			}
+			// Members of request elements, such as the initiators of CreateInitiators,
+			// are listed with a dotted path.
+			if f.Name == "" {
+				continue
+			}
+			for _, g := range byName[strings.TrimLeft(f.Type, "[]*")].Members {
+				if newer(g) {
+					members = append(members, Member{Name: f.Name + "." + g.Name, JSON: f.JSON + "." + g.JSON, Since: g.Since})
+				}
+			}
+		}
+		if len(members) == 0 {
+			continue
+		}
+		fmt.Fprintf(&versions, "\t%q: {\n", m.Name)
+		for _, f := range members {
+			fmt.Fprintf(&versions, "\t\t{Field: %q, JSON: %q, Since: %q},\n", f.Name, f.JSON, f.Since)
+		}
+		versions.WriteString("\t},\n")
+	}
+	versions.WriteString("}\n")
+
+	files := map[string][]byte{}
+	for name, buf := range map[string]*bytes.Buffer{
+		"generated_model.go":     &model,
+		"generated_methods.go":   &impl,
+		"generated_interface.go": &iface,
+		"generated_stubs.go":     &stubs,
+		"generated_versions.go":  &versions,
+		"generated_node.go":      &node,
+	} {
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("formatting %s: %w", name, err)
+		}
+		files[name] = src
+	}
+	return files, nil
+}
+
+// nodeNote is appended to the SFClient documentation of node methods.
+const nodeNote = "Only the per-node API serves this method; call it through NodeClient."
+
+// methodSignature returns the Go signature of m and the expression passed as its params.
+func methodSignature(m Method) (string, string) {
+	params, req := "ctx context.Context", "nil"
+	if m.Params != "" {
+		params, req = "ctx context.Context, req *"+m.Params, "req"
+	}
+	return fmt.Sprintf("%s(%s) (*%s, *SdkError)", m.Name, params, m.Result), req
+}
+
+// writeDoc writes doc as line comments, followed by a note on the minimum API
+// version when since is set.
+func writeDoc(b *bytes.Buffer, indent string, doc []string, since string) {
+	for _, line := range doc {
+		if line == "" {
+			fmt.Fprintf(b, "%s//\n", indent)
+			continue
+		}
+		fmt.Fprintf(b, "%s// %s\n", indent, line)
+	}
+	if since != "" {
+		if len(doc) > 0 {
+			fmt.Fprintf(b, "%s//\n", indent)
+		}
+		fmt.Fprintf(b, "%s// Requires API version %s or later.\n", indent, since)
+	}
+}
+
+// compare returns the names of the files in dir that differ from files.
+func compare(dir string, files map[string][]byte) ([]string, error) {
+	var stale []string
+	for _, name := range sortedNames(files) {
+		current, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if !bytes.Equal(current, files[name]) {
+			stale = append(stale, name)
+		}
+	}
+	return stale, nil
+}
+
+func sortedNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
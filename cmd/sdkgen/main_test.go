@@ -0,0 +1,96 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckedInOutputIsCurrent(t *testing.T) {
+	api, err := load("../../sdk/api/element.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := generate(api)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale, err := compare("../../sdk", files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stale) > 0 {
+		t.Errorf("%v out of date; run go run ./cmd/sdkgen", stale)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	api := &API{
+		Types: []Type{
+			{Name: "Mode", Kind: "string", Doc: []string{"Mode of a pair."}},
+			{Name: "PairRequest", Kind: "struct", Members: []Member{
+				{Name: "VolumeID", JSON: "volumeID", Type: "int64", Doc: []string{"The volume."}},
+				{Name: "Mode", JSON: "mode", Type: "Mode", Optional: true, Since: "12.7"},
+				{Name: "Targets", JSON: "targets", Type: "[]PairTarget", Optional: true, Doc: []string{"Targets to pair with."}},
+			}},
+			{Name: "PairTarget", Kind: "struct", Members: []Member{
+				{Name: "Label", JSON: "label", Type: "string", Since: "12.8"},
+			}},
+			{Name: "PairResult", Kind: "struct", Members: []Member{{Type: "RawResult"}}},
+		},
+		Methods: []Method{
+			{Name: "Pair", Doc: []string{"Pair pairs a volume.", "", "Second paragraph."}, Params: "PairRequest", Result: "PairResult", Since: "12.5"},
+			{Name: "Ping", Doc: []string{"Ping tests the network."}, Result: "PairResult", Endpoint: "node"},
+		},
+	}
+	if err := api.validate(); err != nil {
+		t.Fatal(err)
+	}
+	files, err := generate(api)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for file, want := range map[string][]string{
+		"generated_model.go": {
+			"// Mode of a pair.\ntype Mode string",
+			"\t// The volume.\n\tVolumeID int64 `json:\"volumeID\"`",
+			"\t// Requires API version 12.7 or later.\n\tMode Mode `json:\"mode,omitempty\"`",
+			"type PairResult struct {\n\tRawResult\n}",
+		},
+		"generated_methods.go": {
+			"// Pair pairs a volume.\n//\n// Second paragraph.\n//\n// Requires API version 12.5 or later.\nfunc (sfClient *SFClient) Pair(ctx context.Context, req *PairRequest) (*PairResult, *SdkError) {",
+			`sfClient.MakeSFCall(ctx, "Pair", 1, req, &res)`,
+			"// Ping tests the network.\n//\n// Only the per-node API serves this method; call it through NodeClient.\nfunc (sfClient *SFClient) Ping(",
+		},
+		"generated_interface.go": {"\tPair(ctx context.Context, req *PairRequest) (*PairResult, *SdkError)\n\n"},
+		"generated_stubs.go":     {"func (sfClient *SFStubClient) Pair(ctx context.Context, req *PairRequest) (*PairResult, *SdkError) {"},
+		"generated_node.go": {
+			"type NodeApi interface {\n\t// Ping tests the network.\n\tPing(ctx context.Context) (*PairResult, *SdkError)\n}",
+			"// Ping tests the network.\nfunc (nodeClient *NodeClient) Ping(ctx context.Context) (*PairResult, *SdkError) {",
+		},
+		"generated_versions.go": {
+			"var methodSince = map[string]string{\n\t\"Pair\": \"12.5\",\n}",
+			"\t\"Pair\": {\n\t\t{Field: \"Mode\", JSON: \"mode\", Since: \"12.7\"},\n\t\t{Field: \"Targets.Label\", JSON: \"targets.label\", Since: \"12.8\"},\n\t},",
+		},
+	} {
+		for _, w := range want {
+			if !strings.Contains(string(files[file]), w) {
+				t.Errorf("%s lacks %q:\n%s", file, w, files[file])
+			}
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for name, api := range map[string]*API{
+		"unknown result": {Methods: []Method{{Name: "Get", Result: "GetResult"}}},
+		"unknown params": {Types: []Type{{Name: "R", Kind: "struct"}}, Methods: []Method{{Name: "Get", Params: "Req", Result: "R"}}},
+		"duplicate type": {Types: []Type{{Name: "R", Kind: "struct"}, {Name: "R", Kind: "string"}}},
+		"missing json":   {Types: []Type{{Name: "R", Kind: "struct", Members: []Member{{Name: "ID", Type: "int64"}}}}},
+		"enum members":   {Types: []Type{{Name: "E", Kind: "string", Members: []Member{{Name: "ID", JSON: "id", Type: "int64"}}}}},
+		"bad endpoint":   {Types: []Type{{Name: "R", Kind: "struct"}}, Methods: []Method{{Name: "Get", Result: "R", Endpoint: "mvip"}}},
+	} {
+		if err := api.validate(); err == nil {
+			t.Errorf("%s: validate succeeded", name)
+		}
+	}
+}
@@ -41,7 +41,7 @@ func main() {
 	// Note on API usage:
 	/*
 		ctx := context.Background()
-		var sf sdk.SFClient
+		sf, _ := sdk.NewSFClient(sdk.WithCACertFile("/etc/ssl/solidfire-ca.pem"))
 		sf.Connect(ctx, "192.168.1.1", "12.5", "admin", "password")
 
 		res, err := sf.GetAccountByName(ctx, &sdk.GetAccountByNameRequest{Username: "example-user"})
@@ -2,268 +2,126 @@ package main
 
 import (
 	"context"
-	"fmt"
-	"log"
-	"time"
-
+	"encoding/json"
+	"flag"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/scaleoutsean/solidfire-go/backup"
+	cloudops "github.com/scaleoutsean/solidfire-go/methods"
 	"github.com/scaleoutsean/solidfire-go/sdk"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
 )
 
-// Internal structures for parsing slices.json report
-type GetReportRequest struct {
-	ReportName string `json:"reportName"`
-}
-
-type SlicesReport struct {
-	Services []ReportService `json:"services"`
-	Slices   []ReportSlice   `json:"slices"`
-}
-
-type ReportService struct {
-	ServiceID int64 `json:"serviceID"`
-	NodeID    int64 `json:"nodeID"`
-}
-
-type ReportSlice struct {
-	VolumeID int64 `json:"volumeID"`
-	Primary  int64 `json:"primary"` // ServiceID
-}
-
-// ActiveJob tracks a running backup job
-type ActiveJob struct {
-	VolumeID    int64
-	NodeID      int64
-	AsyncHandle int64
-	StartTime   time.Time
+// Config is the YAML configuration of s3-backup.
+type Config struct {
+	MVIP               string                      `yaml:"mvip"`
+	Version            string                      `yaml:"version"`
+	CACertFile         string                      `yaml:"cacertfile"`
+	InsecureSkipVerify bool                        `yaml:"insecureskipverify"`
+	Credentials        *cloudops.CredentialsConfig `yaml:"credentials"`
+	Backup             backup.Options              `yaml:",inline"`
 }
 
 func main() {
-	// This main function is a stub. In a real scenario, you'd initialize the client
-	// and pass a list of volume IDs to RunSmartBackup.
-	fmt.Println("This is a library example. Initialize client and call RunSmartBackup.")
-}
-
-// RunSmartBackup orchestrates S3 backups respecting per-node limits
-func RunSmartBackup(client *sdk.SFClient, volumeIDs []int64, s3Url string) {
-	ctx := context.Background()
-
-	// 1. Get Cluster Limits
-	limits, err := client.GetLimits(ctx)
+	configPath := flag.String("config", os.Getenv("S3_BACKUP_CONFIG"), "Path to YAML configuration file")
+	reportPath := flag.String("report", "", "Write the JSON report of the run to this file")
+	restorePath := flag.String("restore", "", "Restore the volumes of this backup report instead of backing up")
+	flag.Parse()
+	if *configPath == "" {
+		log.Fatal("Configuration path must be provided via -config flag or S3_BACKUP_CONFIG env var")
+	}
+	yamlFile, err := os.ReadFile(*configPath)
 	if err != nil {
-		log.Fatalf("Failed to get cluster limits: %v", err)
+		log.Fatalf("Error reading config file: %v", err)
 	}
-
-	// Default to 8 if not returned
-	maxJobsPerNode := int64(8)
-	if limits.BulkVolumeJobsPerNodeMax > 0 {
-		maxJobsPerNode = limits.BulkVolumeJobsPerNodeMax
+	conf := Config{Version: "12.5", Credentials: &cloudops.CredentialsConfig{Source: "env"}}
+	if err := yaml.Unmarshal(yamlFile, &conf); err != nil {
+		log.Fatalf("Error parsing config file: %v", err)
 	}
 
-	// Reduce by 1 or 2 to leave room for restores
-	if maxJobsPerNode > 2 {
-		maxJobsPerNode -= 2
+	provider, err := conf.Credentials.Provider(conf.MVIP)
+	if err != nil {
+		log.Fatalf("Error in credentials config: %v", err)
+	}
+	opts := []sdk.ClientOption{sdk.WithCredentialProvider(provider), sdk.WithRetryPolicy(sdk.DefaultRetryPolicy())}
+	if conf.CACertFile != "" {
+		opts = append(opts, sdk.WithCACertFile(conf.CACertFile))
+	}
+	if conf.InsecureSkipVerify {
+		opts = append(opts, sdk.WithInsecureSkipVerify())
+	}
+	sf, err := sdk.NewSFClient(opts...)
+	if err != nil {
+		log.Fatalf("Error creating client: %v", err)
 	}
 
-	fmt.Printf("Max concurrent backup jobs per node: %d\n", maxJobsPerNode)
-
-	// Local state tracking
-	nodeActiveJobs := make(map[int64]int64)
-	activeJobs := make([]*ActiveJob, 0)
-
-	pendingVolumes := make([]int64, len(volumeIDs))
-	copy(pendingVolumes, volumeIDs)
-
-	// 2. Get Slice Report to map Volumes -> Nodes
-	// This avoids calling GetVolumeStats for every volume in the loop
-	volToNode := make(map[int64]int64)
-	serviceToNode := make(map[int64]int64)
-
-	log.Println("Fetching slices.json report to map volumes to nodes...")
-	var reportRes SlicesReport
-	// Use MakeSFCall directly because GetReport generated wrapper might be incomplete
-	_, reportErr := client.MakeSFCall(ctx, "GetReport", 1, GetReportRequest{ReportName: "slices.json"}, &reportRes)
-	if reportErr != nil {
-		log.Printf("Warning: Failed to get slices report, will fall back to GetVolumeStats: %v", reportErr)
-	} else {
-		// Populate Service -> Node map
-		for _, svc := range reportRes.Services {
-			serviceToNode[svc.ServiceID] = svc.NodeID
-		}
-		// Populate Volume -> Node map
-		for _, slice := range reportRes.Slices {
-			if nodeID, ok := serviceToNode[slice.Primary]; ok {
-				volToNode[slice.VolumeID] = nodeID
-			}
-		}
-		log.Printf("Mapped %d volumes to nodes via report.", len(volToNode))
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if sdkErr := sf.Connect(ctx, conf.MVIP, conf.Version, "", ""); sdkErr != nil {
+		log.Fatalf("Error connecting to %s: %v", conf.MVIP, sdkErr)
 	}
 
-	// Main Loop
-	for len(pendingVolumes) > 0 || len(activeJobs) > 0 {
+	conf.Backup.OnProgress = func(j backup.Job) {
+		log.Infof("volume %d (%s) on node %d: %s %d%%", j.VolumeID, j.VolumeName, j.NodeID, j.Status, j.PercentComplete)
+	}
+	r := backup.NewRunner(sf, conf.Backup)
 
-		// A. Check for completed jobs
-		finishedIndices := make(map[int]bool)
-		for i, job := range activeJobs {
-			statusReq := &sdk.GetAsyncResultRequest{
-				AsyncHandle: job.AsyncHandle,
-			}
-			res, err := client.GetAsyncResult(ctx, statusReq)
+	var rep *backup.Report
+	if *restorePath != "" {
+		rep, err = r.Restore(ctx, restoresFrom(*restorePath)...)
+	} else {
+		var ids []int64
+		for _, arg := range flag.Args() {
+			id, err := strconv.ParseInt(arg, 10, 64)
 			if err != nil {
-				log.Printf("Error checking job for volume %d: %v", job.VolumeID, err)
-				continue
-			}
-
-			if res.Status == "complete" {
-				fmt.Printf("Backup for Volume %d (Node %d) COMPLETED in %v\n",
-					job.VolumeID, job.NodeID, time.Since(job.StartTime))
-				finishedIndices[i] = true
-				nodeActiveJobs[job.NodeID]--
-			} else if res.Status == "error" {
-				fmt.Printf("Backup for Volume %d (Node %d) FAILED: %v\n",
-					job.VolumeID, job.NodeID, res.Result)
-				finishedIndices[i] = true
-				nodeActiveJobs[job.NodeID]--
-			}
-			// If "running", do nothing
-		}
-
-		// Remove finished jobs from list
-		if len(finishedIndices) > 0 {
-			newActive := make([]*ActiveJob, 0)
-			for i, job := range activeJobs {
-				if !finishedIndices[i] {
-					newActive = append(newActive, job)
-				}
+				log.Fatalf("Invalid volume ID %q", arg)
 			}
-			activeJobs = newActive
+			ids = append(ids, id)
 		}
-
-		// B. Try to schedule pending volumes
-		remainingPending := make([]int64, 0)
-		for _, volID := range pendingVolumes {
-			// Find Primary Node for this volume from our map
-			primaryNodeID, found := volToNode[volID]
-			if !found {
-				// Fallback: If not in report (new volume?), try GetVolumeStats
-				statsReq := &sdk.GetVolumeStatsRequest{VolumeID: volID}
-				stats, err := client.GetVolumeStats(ctx, statsReq)
-				if err == nil {
-					svcID := stats.VolumeStats.MetadataHosts.Primary
-					// Try to resolve service to node again
-					if nid, ok := serviceToNode[svcID]; ok {
-						primaryNodeID = nid
-						volToNode[volID] = nid // cache it
-						found = true
-					}
-				}
-
-				if !found {
-					log.Printf("Could not determine node for volume %d, skipping scheduling for now.", volID)
-					// Maybe retry getting report later? For now, add back to pending to retry stats later
-					remainingPending = append(remainingPending, volID)
-					continue
-				}
-			}
-
-			currentLoad := nodeActiveJobs[primaryNodeID]
-
-			if currentLoad < maxJobsPerNode {
-				// START JOB
-				fmt.Printf("Starting backup for Volume %d on Node %d (Load: %d/%d)\n",
-					volID, primaryNodeID, currentLoad+1, maxJobsPerNode)
-
-				job := startBackupJob(client, volID, s3Url)
-				if job != nil {
-					job.NodeID = primaryNodeID
-					activeJobs = append(activeJobs, job)
-					nodeActiveJobs[primaryNodeID]++
-				} else {
-					// Failed to start, keep in pending? Or drop? Let's keep in pending for retry
-					remainingPending = append(remainingPending, volID)
-				}
-			} else {
-				// Node full, keep in pending
-				remainingPending = append(remainingPending, volID)
-			}
+		if len(ids) == 0 {
+			log.Fatal("Volume IDs to back up must be given as arguments")
 		}
-		pendingVolumes = remainingPending
-
-		// Sleep briefly to avoid busy loop
-		time.Sleep(5 * time.Second)
+		rep, err = r.Backup(ctx, ids...)
 	}
-
-	fmt.Println("All backups finished.")
-}
-
-func startBackupJob(client *sdk.SFClient, volumeID int64, s3Url string) *ActiveJob {
-	req := &sdk.StartBulkVolumeReadRequest{
-		VolumeID: volumeID,
-		Format:   "native",
-		Script:   "backup_to_s3.py",
-		ScriptParameters: map[string]interface{}{
-			"s3_url": s3Url,
-		},
+	if err != nil && rep == nil {
+		log.Fatal(err)
+	}
+	rep.WriteText(os.Stdout)
+	if *reportPath != "" {
+		f, ferr := os.Create(*reportPath)
+		if ferr != nil {
+			log.Fatalf("Error writing report: %v", ferr)
+		}
+		rep.WriteJSON(f)
+		f.Close()
 	}
-
-	ctx := context.Background()
-	res, err := client.StartBulkVolumeRead(ctx, req)
 	if err != nil {
-		log.Printf("Failed to start bulk read for volume %d: %v", volumeID, err)
-		return nil
+		log.Fatal(err)
 	}
-
-	return &ActiveJob{
-		VolumeID:    volumeID,
-		AsyncHandle: res.AsyncHandle,
-		StartTime:   time.Now(),
+	if err := rep.Err(); err != nil {
+		log.Fatal(err)
 	}
 }
 
-// BackupToS3Simple is the original simple example
-func BackupToS3Simple(client *sdk.SFClient, volumeID int64, s3Url string) {
-	// 1. Start Bulk Volume Read
-	// Script "backup_to_s3.py" is a standard internal script on older Element OS versions.
-	// For newer versions or native support, parameters might differ.
-	// This illustrates the API call availability.
-
-	req := &sdk.StartBulkVolumeReadRequest{
-		VolumeID: volumeID,
-		Format:   "native",
-		Script:   "backup_to_s3.py", // Or equivalent internal handler
-		ScriptParameters: map[string]interface{}{
-			"s3_url": s3Url,
-		},
-	}
-
-	ctx := context.Background()
-	res, err := client.StartBulkVolumeRead(ctx, req)
+// restoresFrom returns the restores of the successful backups in a backup report.
+func restoresFrom(path string) []backup.Restore {
+	data, err := os.ReadFile(path)
 	if err != nil {
-		log.Fatalf("Failed to start bulk read: %v", err)
+		log.Fatalf("Error reading report: %v", err)
 	}
-
-	fmt.Printf("Bulk Volume Read started. Key: %s, URL: %s, AsyncHandle: %d\n", res.Key, res.Url, res.AsyncHandle)
-
-	// 2. Poll for Completion using GetAsyncResult
-	asyncHandle := res.AsyncHandle
-	for {
-		statusReq := &sdk.GetAsyncResultRequest{
-			AsyncHandle: asyncHandle,
-		}
-		statusRes, err := client.GetAsyncResult(ctx, statusReq)
-		if err != nil {
-			log.Fatalf("Failed to get async result: %v", err)
-		}
-
-		fmt.Printf("Job Status: %s\n", statusRes.Status)
-
-		if statusRes.Status == "complete" {
-			fmt.Println("Backup complete!")
-			break
-		}
-		if statusRes.Status == "error" {
-			log.Fatalf("Backup failed: %v", statusRes.Result)
+	var rep backup.Report
+	if err := json.Unmarshal(data, &rep); err != nil || rep.Type != "backup" {
+		log.Fatalf("%s is not a backup report: %v", path, err)
+	}
+	var restores []backup.Restore
+	for _, j := range rep.Jobs {
+		if j.Status == backup.Done {
+			restores = append(restores, backup.Restore{VolumeID: j.VolumeID, Object: j.Object})
 		}
-
-		time.Sleep(5 * time.Second)
 	}
+	return restores
 }
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/exporter"
+	cloudops "github.com/scaleoutsean/solidfire-go/methods"
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// Config is the exporter's YAML configuration.
+type Config struct {
+	MVIP               string                      `yaml:"mvip"`
+	Version            string                      `yaml:"version"`
+	Listen             string                      `yaml:"listen"`
+	CACertFile         string                      `yaml:"cacertfile"`
+	InsecureSkipVerify bool                        `yaml:"insecureskipverify"`
+	Credentials        *cloudops.CredentialsConfig `yaml:"credentials"`
+	// InventoryInterval is how often volume, account and access group names are reloaded.
+	InventoryInterval time.Duration   `yaml:"inventoryinterval"`
+	Exporter          exporter.Config `yaml:",inline"`
+}
+
+func main() {
+	configPath := flag.String("config", os.Getenv("EXPORTER_CONFIG"), "Path to YAML configuration file")
+	flag.Parse()
+	if *configPath == "" {
+		log.Fatal("Configuration path must be provided via -config flag or EXPORTER_CONFIG env var")
+	}
+	yamlFile, err := os.ReadFile(*configPath)
+	if err != nil {
+		log.Fatalf("Error reading config file: %v", err)
+	}
+	conf := Config{Version: "12.5", Listen: ":9987", Credentials: &cloudops.CredentialsConfig{Source: "env"}}
+	if err := yaml.Unmarshal(yamlFile, &conf); err != nil {
+		log.Fatalf("Error parsing config file: %v", err)
+	}
+
+	provider, err := conf.Credentials.Provider(conf.MVIP)
+	if err != nil {
+		log.Fatalf("Error in credentials config: %v", err)
+	}
+	opts := []sdk.ClientOption{sdk.WithCredentialProvider(provider), sdk.WithRetryPolicy(sdk.DefaultRetryPolicy())}
+	if conf.CACertFile != "" {
+		opts = append(opts, sdk.WithCACertFile(conf.CACertFile))
+	}
+	if conf.InsecureSkipVerify {
+		opts = append(opts, sdk.WithInsecureSkipVerify())
+	}
+	sf, err := sdk.NewSFClient(opts...)
+	if err != nil {
+		log.Fatalf("Error creating client: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if sdkErr := sf.Connect(ctx, conf.MVIP, conf.Version, "", ""); sdkErr != nil {
+		log.Fatalf("Error connecting to %s: %v", conf.MVIP, sdkErr)
+	}
+
+	e, err := exporter.New(sf, conf.Exporter, exporter.DefaultCollectors(conf.InventoryInterval)...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	go e.Run(ctx)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", e)
+	server := &http.Server{Addr: conf.Listen, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		server.Shutdown(context.Background())
+	}()
+	log.Infof("Serving SolidFire metrics for %s on %s/metrics", conf.MVIP, conf.Listen)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+}
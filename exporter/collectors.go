@@ -0,0 +1,408 @@
+package exporter
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+// DefaultCollectors returns the cluster, capacity, node, volume, drive and qos
+// collectors. The volume and qos collectors share an Inventory refreshed every
+// inventoryInterval (default 5 minutes).
+func DefaultCollectors(inventoryInterval time.Duration) []Collector {
+	inv := &Inventory{Interval: inventoryInterval}
+	return []Collector{
+		ClusterStatsCollector{},
+		ClusterCapacityCollector{},
+		NodeStatsCollector{},
+		&VolumeStatsCollector{Inventory: inv},
+		DriveStatsCollector{},
+		&QoSHistogramCollector{Inventory: inv},
+	}
+}
+
+// metric describes one family taken from a field of T.
+type metric[T any] struct {
+	name, typ, help string
+	value           func(*T) float64
+}
+
+// collect builds one family per metric with a sample for each item.
+func collect[T any](metrics []metric[T], items []T, labels func(*T) []Label) []*Family {
+	families := make([]*Family, 0, len(metrics))
+	for _, m := range metrics {
+		f := &Family{Name: m.name, Type: m.typ, Help: m.help}
+		for i := range items {
+			var l []Label
+			if labels != nil {
+				l = labels(&items[i])
+			}
+			f.Add(m.value(&items[i]), l...)
+		}
+		families = append(families, f)
+	}
+	return families
+}
+
+func id(v int64) string { return strconv.FormatInt(v, 10) }
+
+// ClusterStatsCollector ("cluster") exports GetClusterStats.
+type ClusterStatsCollector struct{}
+
+func (ClusterStatsCollector) Name() string { return "cluster" }
+
+var clusterMetrics = []metric[sdk.ClusterStats]{
+	{"solidfire_cluster_read_bytes", Counter, "Bytes read by clients.", func(s *sdk.ClusterStats) float64 { return float64(s.ReadBytes) }},
+	{"solidfire_cluster_write_bytes", Counter, "Bytes written by clients.", func(s *sdk.ClusterStats) float64 { return float64(s.WriteBytes) }},
+	{"solidfire_cluster_read_ops", Counter, "Read operations.", func(s *sdk.ClusterStats) float64 { return float64(s.ReadOps) }},
+	{"solidfire_cluster_write_ops", Counter, "Write operations.", func(s *sdk.ClusterStats) float64 { return float64(s.WriteOps) }},
+	{"solidfire_cluster_read_latency_usec", Counter, "Cumulative read latency in microseconds.", func(s *sdk.ClusterStats) float64 { return float64(s.ReadLatencyUSecTotal) }},
+	{"solidfire_cluster_write_latency_usec", Counter, "Cumulative write latency in microseconds.", func(s *sdk.ClusterStats) float64 { return float64(s.WriteLatencyUSecTotal) }},
+	{"solidfire_cluster_unaligned_reads", Counter, "Reads not on a 4k boundary.", func(s *sdk.ClusterStats) float64 { return float64(s.UnalignedReads) }},
+	{"solidfire_cluster_unaligned_writes", Counter, "Writes not on a 4k boundary.", func(s *sdk.ClusterStats) float64 { return float64(s.UnalignedWrites) }},
+	{"solidfire_cluster_utilization_ratio", Gauge, "Cluster capacity being utilized, from 0 to 1.", func(s *sdk.ClusterStats) float64 { return s.ClusterUtilization }},
+	{"solidfire_cluster_client_queue_depth", Gauge, "Outstanding client operations.", func(s *sdk.ClusterStats) float64 { return float64(s.ClientQueueDepth) }},
+	{"solidfire_cluster_actual_iops", Gauge, "IOPS in the last sample period.", func(s *sdk.ClusterStats) float64 { return float64(s.ActualIOPS) }},
+	{"solidfire_cluster_average_io_size_bytes", Gauge, "Average I/O size in the last sample period.", func(s *sdk.ClusterStats) float64 { return float64(s.AverageIOPSize) }},
+	{"solidfire_cluster_latency_usec", Gauge, "Average latency in the last sample period.", func(s *sdk.ClusterStats) float64 { return float64(s.LatencyUSec) }},
+	{"solidfire_cluster_services_running", Gauge, "Running services.", func(s *sdk.ClusterStats) float64 { return float64(s.ServicesCount) }},
+	{"solidfire_cluster_services_configured", Gauge, "Configured services.", func(s *sdk.ClusterStats) float64 { return float64(s.ServicesTotal) }},
+}
+
+func (ClusterStatsCollector) Collect(ctx context.Context, sf *sdk.SFClient) ([]*Family, error) {
+	res, err := sf.GetClusterStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return collect(clusterMetrics, []sdk.ClusterStats{res.ClusterStats}, nil), nil
+}
+
+// ClusterCapacityCollector ("capacity") exports GetClusterCapacity and the efficiency
+// ratios the Element UI derives from it.
+type ClusterCapacityCollector struct{}
+
+func (ClusterCapacityCollector) Name() string { return "capacity" }
+
+var capacityMetrics = []metric[sdk.ClusterCapacity]{
+	{"solidfire_capacity_provisioned_space_bytes", Gauge, "Space provisioned in all volumes.", func(c *sdk.ClusterCapacity) float64 { return float64(c.ProvisionedSpace) }},
+	{"solidfire_capacity_max_provisioned_space_bytes", Gauge, "Provisionable space if all volumes were full.", func(c *sdk.ClusterCapacity) float64 { return float64(c.MaxProvisionedSpace) }},
+	{"solidfire_capacity_max_over_provisionable_space_bytes", Gauge, "Maximum provisionable space.", func(c *sdk.ClusterCapacity) float64 { return float64(c.MaxOverProvisionableSpace) }},
+	{"solidfire_capacity_used_space_bytes", Gauge, "Space used on block drives.", func(c *sdk.ClusterCapacity) float64 { return float64(c.UsedSpace) }},
+	{"solidfire_capacity_max_used_space_bytes", Gauge, "Space on active block drives.", func(c *sdk.ClusterCapacity) float64 { return float64(c.MaxUsedSpace) }},
+	{"solidfire_capacity_used_metadata_space_bytes", Gauge, "Space used on metadata drives.", func(c *sdk.ClusterCapacity) float64 { return float64(c.UsedMetadataSpace) }},
+	{"solidfire_capacity_max_used_metadata_space_bytes", Gauge, "Space on metadata drives.", func(c *sdk.ClusterCapacity) float64 { return float64(c.MaxUsedMetadataSpace) }},
+	{"solidfire_capacity_used_metadata_space_in_snapshots_bytes", Gauge, "Metadata space used by snapshots.", func(c *sdk.ClusterCapacity) float64 { return float64(c.UsedMetadataSpaceInSnapshots) }},
+	{"solidfire_capacity_active_block_space_bytes", Gauge, "Space on block drives including metadata and space to be cleaned up.", func(c *sdk.ClusterCapacity) float64 { return float64(c.ActiveBlockSpace) }},
+	{"solidfire_capacity_unique_blocks", Gauge, "Blocks stored on block drives, including replicas.", func(c *sdk.ClusterCapacity) float64 { return float64(c.UniqueBlocks) }},
+	{"solidfire_capacity_unique_blocks_used_space_bytes", Gauge, "Space used by unique blocks.", func(c *sdk.ClusterCapacity) float64 { return float64(c.UniqueBlocksUsedSpace) }},
+	{"solidfire_capacity_non_zero_blocks", Gauge, "4KiB blocks with data.", func(c *sdk.ClusterCapacity) float64 { return float64(c.NonZeroBlocks) }},
+	{"solidfire_capacity_zero_blocks", Gauge, "4KiB blocks without data.", func(c *sdk.ClusterCapacity) float64 { return float64(c.ZeroBlocks) }},
+	{"solidfire_capacity_snapshot_non_zero_blocks", Gauge, "4KiB blocks with data in snapshots.", func(c *sdk.ClusterCapacity) float64 { return float64(c.SnapshotNonZeroBlocks) }},
+	{"solidfire_capacity_active_sessions", Gauge, "Active iSCSI sessions.", func(c *sdk.ClusterCapacity) float64 { return float64(c.ActiveSessions) }},
+	{"solidfire_capacity_peak_active_sessions", Gauge, "Peak iSCSI sessions since midnight UTC.", func(c *sdk.ClusterCapacity) float64 { return float64(c.PeakActiveSessions) }},
+	{"solidfire_capacity_current_iops", Gauge, "Average IOPS over the last 5 seconds.", func(c *sdk.ClusterCapacity) float64 { return float64(c.CurrentIOPS) }},
+	{"solidfire_capacity_average_iops", Gauge, "Average IOPS since midnight UTC.", func(c *sdk.ClusterCapacity) float64 { return float64(c.AverageIOPS) }},
+	{"solidfire_capacity_peak_iops", Gauge, "Peak IOPS since midnight UTC.", func(c *sdk.ClusterCapacity) float64 { return float64(c.PeakIOPS) }},
+	{"solidfire_capacity_max_iops", Gauge, "Estimated maximum IOPS of the cluster.", func(c *sdk.ClusterCapacity) float64 { return float64(c.MaxIOPS) }},
+	{"solidfire_capacity_ops", Counter, "I/O operations over the lifetime of the cluster.", func(c *sdk.ClusterCapacity) float64 { return float64(c.TotalOps) }},
+	{"solidfire_capacity_thin_provisioning_ratio", Gauge, "Thin provisioning efficiency.", func(c *sdk.ClusterCapacity) float64 {
+		return ratio(float64(c.NonZeroBlocks+c.ZeroBlocks), float64(c.NonZeroBlocks))
+	}},
+	{"solidfire_capacity_deduplication_ratio", Gauge, "Deduplication efficiency, including snapshots.", func(c *sdk.ClusterCapacity) float64 {
+		return ratio(float64(c.NonZeroBlocks+c.SnapshotNonZeroBlocks), float64(c.UniqueBlocks))
+	}},
+	{"solidfire_capacity_compression_ratio", Gauge, "Compression efficiency.", func(c *sdk.ClusterCapacity) float64 {
+		// The Element UI discounts 7% of unique block space as overhead.
+		return ratio(float64(c.UniqueBlocks)*4096, float64(c.UniqueBlocksUsedSpace)*0.93)
+	}},
+}
+
+func ratio(a, b float64) float64 {
+	if b == 0 {
+		return 1
+	}
+	return a / b
+}
+
+func (ClusterCapacityCollector) Collect(ctx context.Context, sf *sdk.SFClient) ([]*Family, error) {
+	res, err := sf.GetClusterCapacity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return collect(capacityMetrics, []sdk.ClusterCapacity{res.ClusterCapacity}, nil), nil
+}
+
+// NodeStatsCollector ("node") exports ListNodeStats, labelled with node_id.
+type NodeStatsCollector struct{}
+
+func (NodeStatsCollector) Name() string { return "node" }
+
+var nodeMetrics = []metric[sdk.NodeStatsInfo]{
+	{"solidfire_node_cpu_percent", Gauge, "CPU usage.", func(n *sdk.NodeStatsInfo) float64 { return float64(n.Cpu) }},
+	{"solidfire_node_used_memory_bytes", Gauge, "Memory in use.", func(n *sdk.NodeStatsInfo) float64 { return float64(n.UsedMemory) }},
+	{"solidfire_node_network_utilization_cluster_percent", Gauge, "Cluster interface utilization.", func(n *sdk.NodeStatsInfo) float64 { return float64(n.NetworkUtilizationCluster) }},
+	{"solidfire_node_network_utilization_storage_percent", Gauge, "Storage interface utilization.", func(n *sdk.NodeStatsInfo) float64 { return float64(n.NetworkUtilizationStorage) }},
+	{"solidfire_node_read_ops", Counter, "Read operations.", func(n *sdk.NodeStatsInfo) float64 { return float64(n.ReadOps) }},
+	{"solidfire_node_write_ops", Counter, "Write operations.", func(n *sdk.NodeStatsInfo) float64 { return float64(n.WriteOps) }},
+	{"solidfire_node_read_latency_usec", Counter, "Cumulative read latency in microseconds.", func(n *sdk.NodeStatsInfo) float64 { return float64(n.ReadLatencyUSecTotal) }},
+	{"solidfire_node_write_latency_usec", Counter, "Cumulative write latency in microseconds.", func(n *sdk.NodeStatsInfo) float64 { return float64(n.WriteLatencyUSecTotal) }},
+}
+
+func (NodeStatsCollector) Collect(ctx context.Context, sf *sdk.SFClient) ([]*Family, error) {
+	res, err := sf.ListNodeStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes := res.NodeStats.Nodes
+	nodeLabel := func(n *sdk.NodeStatsInfo) []Label { return []Label{{"node_id", id(n.NodeID)}} }
+	families := collect(nodeMetrics, nodes, nodeLabel)
+
+	// The per-interface byte counters become one family each way with an interface label.
+	rx := &Family{Name: "solidfire_node_network_receive_bytes", Type: Counter, Help: "Bytes received per interface."}
+	tx := &Family{Name: "solidfire_node_network_transmit_bytes", Type: Counter, Help: "Bytes sent per interface."}
+	for _, n := range nodes {
+		node := id(n.NodeID)
+		for _, iface := range []struct {
+			name    string
+			in, out int64
+		}{{"cluster", n.CBytesIn, n.CBytesOut}, {"management", n.MBytesIn, n.MBytesOut}, {"storage", n.SBytesIn, n.SBytesOut}} {
+			rx.Add(float64(iface.in), Label{"node_id", node}, Label{"interface", iface.name})
+			tx.Add(float64(iface.out), Label{"node_id", node}, Label{"interface", iface.name})
+		}
+	}
+	return append(families, rx, tx), nil
+}
+
+// DriveStatsCollector ("drive") exports ListDriveStats, labelled with drive_id.
+type DriveStatsCollector struct{}
+
+func (DriveStatsCollector) Name() string { return "drive" }
+
+var driveMetrics = []metric[sdk.DriveStats]{
+	{"solidfire_drive_life_remaining_percent", Gauge, "Estimated drive life remaining.", func(d *sdk.DriveStats) float64 { return float64(d.LifeRemainingPercent) }},
+	{"solidfire_drive_reserve_capacity_percent", Gauge, "Spare capacity remaining.", func(d *sdk.DriveStats) float64 { return float64(d.ReserveCapacityPercent) }},
+	{"solidfire_drive_power_on_hours", Gauge, "Power-on hours.", func(d *sdk.DriveStats) float64 { return float64(d.PowerOnHours) }},
+	{"solidfire_drive_reallocated_sectors", Gauge, "Reallocated sectors.", func(d *sdk.DriveStats) float64 { return float64(d.ReallocatedSectors) }},
+	{"solidfire_drive_failed_die_count", Gauge, "Failed flash dies.", func(d *sdk.DriveStats) float64 { return float64(d.FailedDieCount) }},
+	{"solidfire_drive_total_capacity_bytes", Gauge, "Drive capacity.", func(d *sdk.DriveStats) float64 { return float64(d.TotalCapacity) }},
+	{"solidfire_drive_used_capacity_bytes", Gauge, "Used drive capacity.", func(d *sdk.DriveStats) float64 { return float64(d.UsedCapacity) }},
+	{"solidfire_drive_active_sessions", Gauge, "Active sessions.", func(d *sdk.DriveStats) float64 { return float64(d.ActiveSessions) }},
+	{"solidfire_drive_lifetime_read_bytes", Counter, "Bytes read over the life of the drive.", func(d *sdk.DriveStats) float64 { return float64(d.LifetimeReadBytes) }},
+	{"solidfire_drive_lifetime_write_bytes", Counter, "Bytes written over the life of the drive.", func(d *sdk.DriveStats) float64 { return float64(d.LifetimeWriteBytes) }},
+	{"solidfire_drive_read_ops", Counter, "Read operations since the drive was added.", func(d *sdk.DriveStats) float64 { return float64(d.ReadOps) }},
+	{"solidfire_drive_write_ops", Counter, "Write operations since the drive was added.", func(d *sdk.DriveStats) float64 { return float64(d.WriteOps) }},
+}
+
+func (DriveStatsCollector) Collect(ctx context.Context, sf *sdk.SFClient) ([]*Family, error) {
+	res, err := sf.ListDriveStats(ctx, &sdk.ListDriveStatsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return collect(driveMetrics, res.DriveStats, func(d *sdk.DriveStats) []Label {
+		return []Label{{"drive_id", id(d.DriveID)}}
+	}), nil
+}
+
+// Inventory caches volume, account and access group names used to label volume
+// metrics. Names change rarely, so it is refreshed at most every Interval (default
+// 5 minutes) instead of on every collection. A failed refresh keeps the old names.
+type Inventory struct {
+	Interval time.Duration
+
+	mu       sync.Mutex
+	updated  time.Time
+	volumes  map[int64]sdk.Volume
+	accounts map[int64]string
+	groups   map[int64]string
+}
+
+func (inv *Inventory) refresh(ctx context.Context, sf *sdk.SFClient) error {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	interval := inv.Interval
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	if inv.volumes != nil && time.Since(inv.updated) < interval {
+		return nil
+	}
+	vols, err := sf.ListVolumes(ctx, &sdk.ListVolumesRequest{})
+	if err != nil {
+		return inv.stale(err)
+	}
+	accts, err := sf.ListAccounts(ctx, &sdk.ListAccountsRequest{})
+	if err != nil {
+		return inv.stale(err)
+	}
+	vags, err := sf.ListVolumeAccessGroups(ctx, &sdk.ListVolumeAccessGroupsRequest{})
+	if err != nil {
+		return inv.stale(err)
+	}
+	inv.volumes = make(map[int64]sdk.Volume, len(vols.Volumes))
+	for _, v := range vols.Volumes {
+		inv.volumes[v.VolumeID] = v
+	}
+	inv.accounts = make(map[int64]string, len(accts.Accounts))
+	for _, a := range accts.Accounts {
+		inv.accounts[a.AccountID] = a.Username
+	}
+	inv.groups = make(map[int64]string, len(vags.VolumeAccessGroups))
+	for _, g := range vags.VolumeAccessGroups {
+		inv.groups[g.VolumeAccessGroupID] = g.Name
+	}
+	inv.updated = time.Now()
+	return nil
+}
+
+// stale returns err unless older names are available to fall back on.
+func (inv *Inventory) stale(err error) error {
+	if inv.volumes != nil {
+		return nil
+	}
+	return err
+}
+
+// labels returns the labels of a volume. accountID and groups override the cached
+// values when the caller has fresher ones, as ListVolumeStats does.
+func (inv *Inventory) labels(volumeID, accountID int64, groups []int64) []Label {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	v := inv.volumes[volumeID]
+	if accountID == 0 {
+		accountID = v.AccountID
+	}
+	if groups == nil {
+		groups = v.VolumeAccessGroups
+	}
+	names := make([]string, 0, len(groups))
+	for _, g := range groups {
+		if name := inv.groups[g]; name != "" {
+			names = append(names, name)
+		} else {
+			names = append(names, id(g))
+		}
+	}
+	sort.Strings(names)
+	return []Label{
+		{"volume_id", id(volumeID)},
+		{"volume", v.Name},
+		{"account", inv.accounts[accountID]},
+		{"access_group", strings.Join(names, ",")},
+	}
+}
+
+// VolumeStatsCollector ("volume") exports ListVolumeStats. Samples are labelled with
+// volume_id, volume, account and access_group; a volume in several access groups has
+// their names joined with commas.
+type VolumeStatsCollector struct {
+	Inventory *Inventory
+}
+
+func (c *VolumeStatsCollector) Name() string { return "volume" }
+
+var volumeMetrics = []metric[sdk.VolumeStats]{
+	{"solidfire_volume_read_bytes", Counter, "Bytes read by clients.", func(v *sdk.VolumeStats) float64 { return float64(v.ReadBytes) }},
+	{"solidfire_volume_write_bytes", Counter, "Bytes written by clients.", func(v *sdk.VolumeStats) float64 { return float64(v.WriteBytes) }},
+	{"solidfire_volume_read_ops", Counter, "Read operations.", func(v *sdk.VolumeStats) float64 { return float64(v.ReadOps) }},
+	{"solidfire_volume_write_ops", Counter, "Write operations.", func(v *sdk.VolumeStats) float64 { return float64(v.WriteOps) }},
+	{"solidfire_volume_unaligned_reads", Counter, "Reads not on a 4k boundary.", func(v *sdk.VolumeStats) float64 { return float64(v.UnalignedReads) }},
+	{"solidfire_volume_unaligned_writes", Counter, "Writes not on a 4k boundary.", func(v *sdk.VolumeStats) float64 { return float64(v.UnalignedWrites) }},
+	{"solidfire_volume_actual_iops", Gauge, "IOPS in the last 500 milliseconds.", func(v *sdk.VolumeStats) float64 { return float64(v.ActualIOPS) }},
+	{"solidfire_volume_average_io_size_bytes", Gauge, "Average I/O size in the last 500 milliseconds.", func(v *sdk.VolumeStats) float64 { return float64(v.AverageIOPSize) }},
+	{"solidfire_volume_latency_usec", Gauge, "Average latency of recent operations.", func(v *sdk.VolumeStats) float64 { return float64(v.LatencyUSec) }},
+	{"solidfire_volume_read_latency_usec", Gauge, "Average latency of recent reads.", func(v *sdk.VolumeStats) float64 { return float64(v.ReadLatencyUSec) }},
+	{"solidfire_volume_write_latency_usec", Gauge, "Average latency of recent writes.", func(v *sdk.VolumeStats) float64 { return float64(v.WriteLatencyUSec) }},
+	{"solidfire_volume_client_queue_depth", Gauge, "Outstanding operations.", func(v *sdk.VolumeStats) float64 { return float64(v.ClientQueueDepth) }},
+	{"solidfire_volume_burst_iops_credit", Gauge, "Accrued burst IOPS credit.", func(v *sdk.VolumeStats) float64 { return float64(v.BurstIOPSCredit) }},
+	{"solidfire_volume_throttle_ratio", Gauge, "How much clients are throttled below max IOPS, from 0 to 1.", func(v *sdk.VolumeStats) float64 { return v.Throttle }},
+	{"solidfire_volume_utilization_ratio", Gauge, "Use relative to max IOPS; above 1 is bursting.", func(v *sdk.VolumeStats) float64 { return v.VolumeUtilization }},
+	{"solidfire_volume_size_bytes", Gauge, "Provisioned size.", func(v *sdk.VolumeStats) float64 { return float64(v.VolumeSize) }},
+	{"solidfire_volume_non_zero_blocks", Gauge, "4KiB blocks with data.", func(v *sdk.VolumeStats) float64 { return float64(v.NonZeroBlocks) }},
+	{"solidfire_volume_zero_blocks", Gauge, "4KiB blocks without data.", func(v *sdk.VolumeStats) float64 { return float64(v.ZeroBlocks) }},
+}
+
+func (c *VolumeStatsCollector) Collect(ctx context.Context, sf *sdk.SFClient) ([]*Family, error) {
+	if err := c.Inventory.refresh(ctx, sf); err != nil {
+		return nil, err
+	}
+	res, err := sf.ListVolumeStats(ctx, &sdk.ListVolumeStatsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return collect(volumeMetrics, res.VolumeStats, func(v *sdk.VolumeStats) []Label {
+		return c.Inventory.labels(v.VolumeID, v.AccountID, v.VolumeAccessGroups)
+	}), nil
+}
+
+// QoSHistogramCollector ("qos") exports ListVolumeQoSHistograms as one counter of
+// samples per volume, histogram and bucket, with the same volume labels as the
+// volume collector.
+type QoSHistogramCollector struct {
+	Inventory *Inventory
+}
+
+func (c *QoSHistogramCollector) Name() string { return "qos" }
+
+// bucket is one bucket of a QoS histogram, named as in the API without "Bucket".
+type bucket struct {
+	name  string
+	value int64
+}
+
+// quintiles returns the buckets of h. Only some histograms have the 0 and 101Plus
+// buckets, so they are included on request.
+func quintiles(h sdk.QuintileHistogram, zero, burst bool) []bucket {
+	var b []bucket
+	if zero {
+		b = append(b, bucket{"0", h.Bucket0})
+	}
+	b = append(b, bucket{"1To19", h.Bucket1To19}, bucket{"20To39", h.Bucket20To39}, bucket{"40To59", h.Bucket40To59},
+		bucket{"60To79", h.Bucket60To79}, bucket{"80To100", h.Bucket80To100})
+	if burst {
+		b = append(b, bucket{"101Plus", h.Bucket101Plus})
+	}
+	return b
+}
+
+func blockSizes(h sdk.BlockSizeHistogram) []bucket {
+	return []bucket{{"512To4095", h.Bucket512To4095}, {"4096To8191", h.Bucket4096to8191}, {"8192To16383", h.Bucket8192To16383},
+		{"16384To32767", h.Bucket16384To32767}, {"32768To65535", h.Bucket32768To65535}, {"65536To131071", h.Bucket65536To131071},
+		{"131072Plus", h.Bucket131072Plus}}
+}
+
+func (c *QoSHistogramCollector) Collect(ctx context.Context, sf *sdk.SFClient) ([]*Family, error) {
+	if err := c.Inventory.refresh(ctx, sf); err != nil {
+		return nil, err
+	}
+	res, err := sf.ListVolumeQoSHistograms(ctx, &sdk.ListVolumeQoSHistogramsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	f := &Family{Name: "solidfire_volume_qos_histogram_samples", Type: Counter, Help: "QoS histogram samples per bucket."}
+	for _, v := range res.QosHistograms {
+		labels := c.Inventory.labels(v.VolumeID, 0, nil)
+		h := &v.Histograms
+		for _, hist := range []struct {
+			name    string
+			buckets []bucket
+		}{
+			{"belowMinIopsPercentages", quintiles(h.BelowMinIopsPercentages, false, false)},
+			{"minToMaxIopsPercentages", quintiles(h.MinToMaxIopsPercentages, false, true)},
+			{"readBlockSizes", blockSizes(h.ReadBlockSizes)},
+			{"targetUtilizationPercentages", quintiles(h.TargetUtilizationPercentages, true, true)},
+			{"throttlePercentages", quintiles(h.ThrottlePercentages, true, false)},
+			{"writeBlockSizes", blockSizes(h.WriteBlockSizes)},
+		} {
+			for _, b := range hist.buckets {
+				l := append(append([]Label(nil), labels...), Label{"histogram", hist.name}, Label{"bucket", b.name})
+				f.Add(float64(b.value), l...)
+			}
+		}
+	}
+	return []*Family{f}, nil
+}
@@ -0,0 +1,189 @@
+// Package exporter serves SolidFire cluster, node, volume and drive statistics as
+// OpenMetrics for Prometheus. Each collector has its own refresh interval and its
+// results are cached in between, so any number of scrapers cost the MVIP one API
+// call per collector per interval.
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultInterval is how long collector results are cached when Config sets no interval.
+const DefaultInterval = time.Minute
+
+// Collector gathers one group of metrics from the cluster.
+type Collector interface {
+	// Name identifies the collector in Config.Intervals and in the exporter's own metrics.
+	Name() string
+	// Collect calls the cluster and returns the metric families.
+	Collect(ctx context.Context, sf *sdk.SFClient) ([]*Family, error)
+}
+
+// Config controls how often collectors call the cluster.
+type Config struct {
+	// Interval is the default refresh interval. Default DefaultInterval.
+	Interval time.Duration `yaml:"interval"`
+	// Intervals overrides Interval per collector name, such as "volume" or "drive".
+	Intervals map[string]time.Duration `yaml:"intervals"`
+	// Timeout bounds one collection. Default 30 seconds.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// Exporter caches collector results and serves them over HTTP.
+type Exporter struct {
+	sf      *sdk.SFClient
+	timeout time.Duration
+	entries []*entry
+	now     func() time.Time
+}
+
+type entry struct {
+	collector Collector
+	interval  time.Duration
+
+	mu          sync.Mutex
+	families    []*Family
+	err         error
+	updated     time.Time
+	lastSuccess time.Time
+	duration    time.Duration
+}
+
+// New returns an exporter for the connected client sf. Without collectors it uses
+// DefaultCollectors.
+func New(sf *sdk.SFClient, cfg Config, collectors ...Collector) (*Exporter, error) {
+	if sf == nil {
+		return nil, fmt.Errorf("exporter: nil SFClient")
+	}
+	if len(collectors) == 0 {
+		collectors = DefaultCollectors(0)
+	}
+	e := &Exporter{sf: sf, timeout: cfg.Timeout, now: time.Now}
+	if e.timeout <= 0 {
+		e.timeout = 30 * time.Second
+	}
+	known := map[string]bool{}
+	for _, c := range collectors {
+		if known[c.Name()] {
+			return nil, fmt.Errorf("exporter: duplicate collector %q", c.Name())
+		}
+		known[c.Name()] = true
+		interval := cfg.Interval
+		if d, ok := cfg.Intervals[c.Name()]; ok {
+			interval = d
+		}
+		if interval <= 0 {
+			interval = DefaultInterval
+		}
+		e.entries = append(e.entries, &entry{collector: c, interval: interval})
+	}
+	for name := range cfg.Intervals {
+		if !known[name] {
+			return nil, fmt.Errorf("exporter: interval set for unknown collector %q", name)
+		}
+	}
+	return e, nil
+}
+
+// Gather returns the metric families of every collector, refreshing those whose
+// cached results are older than their interval. Concurrent callers share a refresh,
+// so it runs detached from ctx, bounded only by the exporter's timeout: a scrape
+// that gives up must not cache a failure for the others.
+func (e *Exporter) Gather(ctx context.Context) []*Family {
+	refreshCtx := context.WithoutCancel(ctx)
+	var wg sync.WaitGroup
+	for _, en := range e.entries {
+		wg.Add(1)
+		go func(en *entry) {
+			defer wg.Done()
+			e.refresh(refreshCtx, en, false)
+		}(en)
+	}
+	wg.Wait()
+
+	var families []*Family
+	up := &Family{Name: "solidfire_exporter_collector_up", Help: "Whether the last collection succeeded."}
+	duration := &Family{Name: "solidfire_exporter_collector_duration_seconds", Help: "Duration of the last collection."}
+	last := &Family{Name: "solidfire_exporter_collector_last_success_timestamp_seconds", Help: "Unix time of the last successful collection."}
+	for _, en := range e.entries {
+		en.mu.Lock()
+		label := Label{"collector", en.collector.Name()}
+		if en.err == nil {
+			families = append(families, en.families...)
+			up.Add(1, label)
+		} else {
+			up.Add(0, label)
+		}
+		duration.Add(en.duration.Seconds(), label)
+		if !en.lastSuccess.IsZero() {
+			last.Add(float64(en.lastSuccess.UnixNano())/1e9, label)
+		}
+		en.mu.Unlock()
+	}
+	return append(families, up, duration, last)
+}
+
+// refresh collects en if its results are stale, or unconditionally if force is set.
+func (e *Exporter) refresh(ctx context.Context, en *entry, force bool) {
+	en.mu.Lock()
+	defer en.mu.Unlock()
+	if !force && !en.updated.IsZero() && e.now().Sub(en.updated) < en.interval {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+	start := e.now()
+	families, err := en.collector.Collect(ctx, e.sf)
+	en.updated = e.now()
+	en.duration = en.updated.Sub(start)
+	en.err = err
+	if err != nil {
+		// Failures are cached for the interval too, so a struggling cluster is not
+		// hit by every scrape.
+		log.WithContext(ctx).Warnf("collector %s failed: %v", en.collector.Name(), err)
+		en.families = nil
+		return
+	}
+	en.families = families
+	en.lastSuccess = en.updated
+}
+
+// Run refreshes every collector on its interval until ctx is done, so scrapes are
+// served from a warm cache instead of waiting for the cluster.
+func (e *Exporter) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, en := range e.entries {
+		wg.Add(1)
+		go func(en *entry) {
+			defer wg.Done()
+			e.refresh(ctx, en, true)
+			ticker := time.NewTicker(en.interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					e.refresh(ctx, en, true)
+				}
+			}
+		}(en)
+	}
+	wg.Wait()
+}
+
+// ServeHTTP writes the cached metrics in the OpenMetrics text format.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	families := e.Gather(r.Context())
+	w.Header().Set("Content-Type", ContentType)
+	if err := WriteOpenMetrics(w, families); err != nil {
+		log.WithContext(r.Context()).Debugf("writing metrics: %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+func newTestExporter(t *testing.T, cfg Config) (*Exporter, *sdktest.Server) {
+	t.Helper()
+	srv := sdktest.NewServer()
+	t.Cleanup(srv.Close)
+	sf, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	acct, sdkErr := sf.AddAccount(ctx, &sdk.AddAccountRequest{Username: "tenant1"})
+	if sdkErr != nil {
+		t.Fatal(sdkErr)
+	}
+	vol, sdkErr := sf.CreateVolume(ctx, &sdk.CreateVolumeRequest{Name: "db", AccountID: acct.AccountID, TotalSize: 1 << 30})
+	if sdkErr != nil {
+		t.Fatal(sdkErr)
+	}
+	if _, sdkErr := sf.CreateVolumeAccessGroup(ctx, &sdk.CreateVolumeAccessGroupRequest{Name: "host1", Volumes: []int64{vol.VolumeID}}); sdkErr != nil {
+		t.Fatal(sdkErr)
+	}
+
+	srv.Handle("GetClusterStats", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{"clusterStats": map[string]interface{}{"readBytes": 1024, "clusterUtilization": 0.25}}, nil
+	})
+	srv.Handle("GetClusterCapacity", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{"clusterCapacity": map[string]interface{}{"nonZeroBlocks": 100, "zeroBlocks": 300}}, nil
+	})
+	srv.Handle("ListNodeStats", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{"nodeStats": map[string]interface{}{"nodes": []interface{}{
+			map[string]interface{}{"nodeID": 1, "cpu": 12, "sBytesIn": 42},
+		}}}, nil
+	})
+	srv.Handle("ListVolumeStats", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{"volumeStats": []interface{}{map[string]interface{}{
+			"volumeID": vol.VolumeID, "accountID": acct.AccountID, "volumeAccessGroups": []int64{1},
+			"readOps": 7, "volumeUtilization": 0.5,
+		}}}, nil
+	})
+	srv.Handle("ListDriveStats", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{"driveStats": []interface{}{map[string]interface{}{"driveID": 5, "lifeRemainingPercent": 98}}, "errors": []interface{}{}}, nil
+	})
+	srv.Handle("ListVolumeQoSHistograms", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{"qosHistograms": []interface{}{map[string]interface{}{
+			"volumeID":  vol.VolumeID,
+			"timestamp": "2024-05-01T12:00:00.000000Z",
+			"histograms": map[string]interface{}{
+				"throttlePercentages": map[string]int{"Bucket0": 9, "Bucket1To19": 1},
+				"readBlockSizes":      map[string]int{"Bucket4096To8191": 12},
+			},
+		}}}, nil
+	})
+
+	e, err := New(sf, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e, srv
+}
+
+func TestExporterServesOpenMetrics(t *testing.T) {
+	e, _ := newTestExporter(t, Config{})
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); ct != ContentType {
+		t.Fatalf("Content-Type = %q", ct)
+	}
+	body := rec.Body.String()
+	volume := `volume_id="1",volume="db",account="tenant1",access_group="host1"`
+	for _, want := range []string{
+		"# TYPE solidfire_cluster_read_bytes counter\n",
+		"solidfire_cluster_read_bytes_total 1024\n",
+		"solidfire_cluster_utilization_ratio 0.25\n",
+		"solidfire_capacity_thin_provisioning_ratio 4\n",
+		`solidfire_node_network_receive_bytes_total{node_id="1",interface="storage"} 42` + "\n",
+		`solidfire_volume_read_ops_total{` + volume + `} 7` + "\n",
+		`solidfire_volume_utilization_ratio{` + volume + `} 0.5` + "\n",
+		`solidfire_drive_life_remaining_percent{drive_id="5"} 98` + "\n",
+		`solidfire_volume_qos_histogram_samples_total{` + volume + `,histogram="throttlePercentages",bucket="1To19"} 1` + "\n",
+		`solidfire_volume_qos_histogram_samples_total{` + volume + `,histogram="readBlockSizes",bucket="4096To8191"} 12` + "\n",
+		`solidfire_exporter_collector_up{collector="volume"} 1` + "\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("missing %q", want)
+		}
+	}
+	if !strings.HasSuffix(body, "# EOF\n") {
+		t.Error("exposition does not end with # EOF")
+	}
+	if t.Failed() {
+		t.Log(body)
+	}
+}
+
+func TestExporterCachesPerCollector(t *testing.T) {
+	e, srv := newTestExporter(t, Config{Intervals: map[string]time.Duration{"drive": time.Hour}})
+	now := time.Now()
+	e.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		e.Gather(ctx)
+	}
+	if n := srv.CallCount("ListVolumeStats"); n != 1 {
+		t.Fatalf("ListVolumeStats called %d times for 3 scrapes, want 1", n)
+	}
+	if n := srv.CallCount("ListVolumes"); n != 1 {
+		t.Fatalf("inventory loaded %d times, want 1", n)
+	}
+
+	now = now.Add(2 * DefaultInterval)
+	e.Gather(ctx)
+	if n := srv.CallCount("ListVolumeStats"); n != 2 {
+		t.Fatalf("ListVolumeStats called %d times after the interval, want 2", n)
+	}
+	if n := srv.CallCount("ListDriveStats"); n != 1 {
+		t.Fatalf("ListDriveStats called %d times within its 1h interval, want 1", n)
+	}
+}
+
+func TestExporterReportsFailedCollector(t *testing.T) {
+	e, srv := newTestExporter(t, Config{})
+	srv.InjectError("ListDriveStats", "xDBConnectionLoss", "database unavailable", 1)
+
+	var out strings.Builder
+	WriteOpenMetrics(&out, e.Gather(context.Background()))
+	body := out.String()
+	if !strings.Contains(body, `solidfire_exporter_collector_up{collector="drive"} 0`) {
+		t.Fatal("failed collector not reported as down")
+	}
+	if strings.Contains(body, "solidfire_drive_life_remaining_percent{") {
+		t.Fatal("samples of a failed collector were served")
+	}
+}
+
+func TestExporterRefreshOutlivesScrape(t *testing.T) {
+	e, _ := newTestExporter(t, Config{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// A scrape that has gone away still refreshes the shared cache for the next one.
+	var out strings.Builder
+	WriteOpenMetrics(&out, e.Gather(ctx))
+	if body := out.String(); strings.Contains(body, "collector_up{collector=\"volume\"} 0") {
+		t.Fatalf("refresh failed with the scrape's context:\n%s", body)
+	}
+}
+
+func TestUnknownCollectorInterval(t *testing.T) {
+	if _, err := New(&sdk.SFClient{}, Config{Intervals: map[string]time.Duration{"volumes": time.Minute}}); err == nil {
+		t.Fatal("expected an error for an unknown collector name")
+	}
+}
+
+func TestLabelEscaping(t *testing.T) {
+	f := &Family{Name: "x", Help: "line\nbreak"}
+	f.Add(1, Label{"v", `a"b\c` + "\n"})
+	var out strings.Builder
+	WriteOpenMetrics(&out, []*Family{f})
+	want := "# TYPE x gauge\n# HELP x line\\nbreak\nx{v=\"a\\\"b\\\\c\\n\"} 1\n# EOF\n"
+	if out.String() != want {
+		t.Fatalf("got %q, want %q", out.String(), want)
+	}
+}
@@ -0,0 +1,97 @@
+package exporter
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ContentType is the media type of the exposition written by WriteOpenMetrics.
+const ContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// Metric types used by the collectors.
+const (
+	Gauge   = "gauge"
+	Counter = "counter"
+)
+
+// Label is a metric label. Labels keep the order they are given in.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is a single value of a family.
+type Sample struct {
+	Labels []Label
+	Value  float64
+}
+
+// Family is a metric family: every sample shares the name, type and help text.
+// Counter names must not end in "_total"; the suffix is added on output.
+type Family struct {
+	Name    string
+	Type    string
+	Help    string
+	Samples []Sample
+}
+
+// Add appends a sample with the given label name/value pairs.
+func (f *Family) Add(value float64, labels ...Label) {
+	f.Samples = append(f.Samples, Sample{Labels: labels, Value: value})
+}
+
+// WriteOpenMetrics writes families in the OpenMetrics text format, terminated by "# EOF".
+func WriteOpenMetrics(w io.Writer, families []*Family) error {
+	bw := bufio.NewWriter(w)
+	for _, f := range families {
+		typ := f.Type
+		if typ == "" {
+			typ = Gauge
+		}
+		bw.WriteString("# TYPE " + f.Name + " " + typ + "\n")
+		if f.Help != "" {
+			bw.WriteString("# HELP " + f.Name + " " + escapeHelp(f.Help) + "\n")
+		}
+		name := f.Name
+		if typ == Counter {
+			name += "_total"
+		}
+		for _, s := range f.Samples {
+			bw.WriteString(name)
+			if len(s.Labels) > 0 {
+				bw.WriteByte('{')
+				for i, l := range s.Labels {
+					if i > 0 {
+						bw.WriteByte(',')
+					}
+					bw.WriteString(l.Name + `="` + escapeLabel(l.Value) + `"`)
+				}
+				bw.WriteByte('}')
+			}
+			bw.WriteString(" " + formatValue(s.Value) + "\n")
+		}
+	}
+	bw.WriteString("# EOF\n")
+	return bw.Flush()
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+var helpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+func escapeLabel(s string) string { return labelEscaper.Replace(s) }
+func escapeHelp(s string) string  { return helpEscaper.Replace(s) }
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
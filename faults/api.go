@@ -0,0 +1,77 @@
+package faults
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// ackRequest is the body of POST /faults/{key}/ack.
+type ackRequest struct {
+	By      string `json:"by"`
+	Comment string `json:"comment"`
+}
+
+// Handler serves the watcher's state as JSON for on-call tooling:
+//
+//	GET    /faults            list the tracked faults
+//	GET    /faults/{key}      one fault
+//	POST   /faults/{key}/ack  acknowledge, with an optional {"by": "...", "comment": "..."} body
+//	DELETE /faults/{key}/ack  remove the acknowledgement
+//
+// Keys contain ':' and ',' and should be URL-escaped. The handler has no
+// authentication of its own; wrap it or bind it to a trusted interface.
+func (w *Watcher) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /faults", func(rw http.ResponseWriter, r *http.Request) {
+		writeJSON(rw, http.StatusOK, w.Faults())
+	})
+	mux.HandleFunc("GET /faults/{key}", func(rw http.ResponseWriter, r *http.Request) {
+		key := r.PathValue("key")
+		for _, st := range w.Faults() {
+			if st.Key == key {
+				writeJSON(rw, http.StatusOK, st)
+				return
+			}
+		}
+		writeError(rw, ErrUnknownFault)
+	})
+	mux.HandleFunc("POST /faults/{key}/ack", func(rw http.ResponseWriter, r *http.Request) {
+		var req ackRequest
+		if r.ContentLength != 0 {
+			if err := json.NewDecoder(http.MaxBytesReader(rw, r.Body, 1<<16)).Decode(&req); err != nil {
+				writeJSON(rw, http.StatusBadRequest, map[string]string{"error": err.Error()})
+				return
+			}
+		}
+		st, err := w.Acknowledge(r.PathValue("key"), req.By, req.Comment)
+		if err != nil {
+			writeError(rw, err)
+			return
+		}
+		writeJSON(rw, http.StatusOK, st)
+	})
+	mux.HandleFunc("DELETE /faults/{key}/ack", func(rw http.ResponseWriter, r *http.Request) {
+		st, err := w.Unacknowledge(r.PathValue("key"))
+		if err != nil {
+			writeError(rw, err)
+			return
+		}
+		writeJSON(rw, http.StatusOK, st)
+	})
+	return mux
+}
+
+func writeJSON(rw http.ResponseWriter, status int, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	json.NewEncoder(rw).Encode(v)
+}
+
+func writeError(rw http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, ErrUnknownFault) {
+		status = http.StatusNotFound
+	}
+	writeJSON(rw, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,300 @@
+package faults
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/smtp"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Sink delivers a batch of notifications.
+type Sink interface {
+	Send(ctx context.Context, notes []Notification) error
+}
+
+// SinkFunc adapts a function to Sink.
+type SinkFunc func(ctx context.Context, notes []Notification) error
+
+func (f SinkFunc) Send(ctx context.Context, notes []Notification) error { return f(ctx, notes) }
+
+// Route sends the notifications it matches to its sinks. Empty match lists match
+// everything.
+type Route struct {
+	// Name identifies the route in the names of its sinks; see Named.
+	Name string
+	// Kinds, Severities, Types and Codes match the change and the fault's severity,
+	// type (such as "drive" or "node") and code (such as "driveFailed").
+	Kinds      []Kind
+	Severities []string
+	Types      []string
+	Codes      []string
+	Sinks      []Sink
+	// Continue lets later routes match a notification this route has taken.
+	Continue bool
+}
+
+// Matches reports whether n is selected by the route.
+func (r *Route) Matches(n *Notification) bool {
+	f := &n.State.Fault
+	return (len(r.Kinds) == 0 || slices.Contains(r.Kinds, n.Kind)) &&
+		(len(r.Severities) == 0 || slices.Contains(r.Severities, f.Severity)) &&
+		(len(r.Types) == 0 || slices.Contains(r.Types, f.Type)) &&
+		(len(r.Codes) == 0 || slices.Contains(r.Codes, f.Code))
+}
+
+// Router checks routes in order. A notification goes to the first matching route,
+// and to later ones as long as the matched routes set Continue. Notifications that
+// match no route go to Default.
+type Router struct {
+	Routes  []Route
+	Default []Sink
+}
+
+// Deliver sends each route's share of notes to its sinks, as one batch per sink.
+// All sinks are tried; their errors are joined.
+func (r *Router) Deliver(ctx context.Context, notes []Notification) error {
+	_, err := r.send(ctx, r.batches(notes))
+	return err
+}
+
+// Named gives a sink the name the watcher keys its undelivered notifications by.
+// The built-in sinks name themselves after their destination; other sinks are
+// named after their route and position unless wrapped with Named.
+func Named(name string, s Sink) Sink {
+	return namedSink{name: name, Sink: s}
+}
+
+type namedSink struct {
+	name string
+	Sink
+}
+
+func (s namedSink) Name() string { return s.name }
+
+// sinkName returns the name of the i-th sink of route.
+func sinkName(route string, i int, s Sink) string {
+	if n, ok := s.(interface{ Name() string }); ok {
+		return n.Name()
+	}
+	return fmt.Sprintf("%s/%d", route, i)
+}
+
+// batch is the share of the notifications for one sink.
+type batch struct {
+	name  string
+	sink  Sink
+	notes []Notification
+}
+
+// batches splits notes into the batch of each sink, in route order. A sink that
+// several matching routes share gets a notification once.
+func (r *Router) batches(notes []Notification) []*batch {
+	var out []*batch
+	byName := map[string]*batch{}
+	add := func(route string, sinks []Sink, n Notification, taken map[*batch]bool) {
+		for j, sink := range sinks {
+			name := sinkName(route, j, sink)
+			b, ok := byName[name]
+			if !ok {
+				b = &batch{name: name, sink: sink}
+				byName[name] = b
+				out = append(out, b)
+			}
+			if !taken[b] {
+				taken[b] = true
+				b.notes = append(b.notes, n)
+			}
+		}
+	}
+	for _, n := range notes {
+		taken := map[*batch]bool{}
+		matched := false
+		for i := range r.Routes {
+			if !r.Routes[i].Matches(&n) {
+				continue
+			}
+			add(r.routeName(i), r.Routes[i].Sinks, n, taken)
+			matched = true
+			if !r.Routes[i].Continue {
+				break
+			}
+		}
+		if !matched {
+			add("default", r.Default, n, taken)
+		}
+	}
+	return out
+}
+
+// sinks returns every sink of the router by name.
+func (r *Router) sinks() map[string]Sink {
+	out := map[string]Sink{}
+	for i := range r.Routes {
+		for j, sink := range r.Routes[i].Sinks {
+			out[sinkName(r.routeName(i), j, sink)] = sink
+		}
+	}
+	for j, sink := range r.Default {
+		out[sinkName("default", j, sink)] = sink
+	}
+	return out
+}
+
+func (r *Router) routeName(i int) string {
+	if name := r.Routes[i].Name; name != "" {
+		return name
+	}
+	return fmt.Sprint(i)
+}
+
+// send delivers batches in order and returns the batches that failed.
+func (r *Router) send(ctx context.Context, batches []*batch) ([]*batch, error) {
+	var failed []*batch
+	var errs []error
+	for _, b := range batches {
+		if len(b.notes) == 0 {
+			continue
+		}
+		if err := b.sink.Send(ctx, b.notes); err != nil {
+			failed = append(failed, b)
+			errs = append(errs, fmt.Errorf("sink %s: %w", b.name, err))
+		}
+	}
+	return failed, errors.Join(errs...)
+}
+
+// webhookPayload is the JSON body posted by WebhookSink.
+type webhookPayload struct {
+	Notifications []Notification `json:"notifications"`
+}
+
+// WebhookSink posts {"notifications": [...]} as JSON to URL. Any status outside
+// 2xx is an error.
+type WebhookSink struct {
+	URL string
+	// Header is added to each request, for example an Authorization token.
+	Header http.Header
+	// Client defaults to an http.Client with a 30 second timeout.
+	Client *http.Client
+}
+
+// Name is "webhook" and the URL.
+func (s *WebhookSink) Name() string { return "webhook " + s.URL }
+
+func (s *WebhookSink) Send(ctx context.Context, notes []Notification) error {
+	body, err := json.Marshal(webhookPayload{Notifications: notes})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range s.Header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := s.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook %s returned %s", s.URL, resp.Status)
+	}
+	return nil
+}
+
+// SMTPSink mails each batch through an SMTP server (or any SMTP-compatible relay)
+// with net/smtp, which upgrades to STARTTLS when the server offers it.
+type SMTPSink struct {
+	// Addr is the server's host:port.
+	Addr string
+	From string
+	To   []string
+	// Auth is optional, for example smtp.PlainAuth.
+	Auth smtp.Auth
+	// SubjectPrefix starts the subject line. Default "[SolidFire]".
+	SubjectPrefix string
+}
+
+// Name is "smtp" and the recipients.
+func (s *SMTPSink) Name() string { return "smtp " + strings.Join(s.To, ",") }
+
+func (s *SMTPSink) Send(ctx context.Context, notes []Notification) error {
+	if err := smtp.SendMail(s.Addr, s.Auth, s.From, s.To, s.message(notes)); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	return nil
+}
+
+// message renders notes as a plain text mail.
+func (s *SMTPSink) message(notes []Notification) []byte {
+	prefix := s.SubjectPrefix
+	if prefix == "" {
+		prefix = "[SolidFire]"
+	}
+	subject := prefix + " " + notes[0].Summary()
+	if len(notes) > 1 {
+		subject = fmt.Sprintf("%s %d cluster fault changes", prefix, len(notes))
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", s.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(s.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", strings.NewReplacer("\r", " ", "\n", " ").Replace(subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n")
+	for _, n := range notes {
+		f := &n.State.Fault
+		fmt.Fprintf(&b, "%s\r\n", n.Summary())
+		fmt.Fprintf(&b, "  key: %s\r\n  type: %s\r\n  fault ID: %d\r\n  first seen: %s\r\n",
+			n.State.Key, f.Type, f.ClusterFaultID, n.State.FirstSeen.Format(time.RFC3339))
+		if n.State.Flaps > 0 {
+			fmt.Fprintf(&b, "  flaps: %d\r\n", n.State.Flaps)
+		}
+		b.WriteString("\r\n")
+	}
+	return []byte(b.String())
+}
+
+// FileSink appends each notification to Path as a line of JSON. The file is created
+// with mode 0600.
+type FileSink struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// Name is "file" and the path.
+func (s *FileSink) Name() string { return "file " + s.Path }
+
+func (s *FileSink) Send(ctx context.Context, notes []Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := os.OpenFile(s.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	for _, n := range notes {
+		if err := enc.Encode(n); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	return f.Close()
+}
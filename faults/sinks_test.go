@@ -0,0 +1,130 @@
+package faults
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func testNotes() []Notification {
+	return []Notification{{
+		Kind:    New,
+		Cluster: "prod",
+		State:   State{Key: "driveFailed:drives=3", Fault: sdk.ClusterFaultInfo{Code: "driveFailed", Severity: "critical", Type: "drive", Details: "Drive 3 failed"}},
+	}}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var got webhookPayload
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer srv.Close()
+
+	sink := &WebhookSink{URL: srv.URL, Header: http.Header{"Authorization": {"Bearer t"}}}
+	if err := sink.Send(context.Background(), testNotes()); err != nil {
+		t.Fatal(err)
+	}
+	if auth != "Bearer t" || len(got.Notifications) != 1 || got.Notifications[0].State.Fault.Code != "driveFailed" {
+		t.Fatalf("webhook received %q %+v", auth, got)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusBadGateway)
+	}))
+	defer failing.Close()
+	if err := (&WebhookSink{URL: failing.URL}).Send(context.Background(), testNotes()); err == nil {
+		t.Fatal("expected an error for a 502 response")
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "faults.jsonl")
+	sink := &FileSink{Path: path}
+	for i := 0; i < 2; i++ {
+		if err := sink.Send(context.Background(), testNotes()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, _ := os.ReadFile(path)
+	if lines := strings.Count(string(data), "\n"); lines != 2 {
+		t.Fatalf("file has %d lines, want 2", lines)
+	}
+	if fi, _ := os.Stat(path); fi.Mode().Perm() != 0o600 {
+		t.Fatalf("file mode %v", fi.Mode())
+	}
+}
+
+// fakeSMTP accepts one message without authentication and returns it on the channel.
+func fakeSMTP(t *testing.T) (string, <-chan string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	msgs := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		io.WriteString(conn, "220 fake ESMTP\r\n")
+		var data strings.Builder
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				io.WriteString(conn, "250 fake\r\n")
+			case cmd == "DATA":
+				io.WriteString(conn, "354 go ahead\r\n")
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil || l == ".\r\n" {
+						break
+					}
+					data.WriteString(l)
+				}
+				msgs <- data.String()
+				io.WriteString(conn, "250 queued\r\n")
+			case cmd == "QUIT":
+				io.WriteString(conn, "221 bye\r\n")
+				return
+			default:
+				io.WriteString(conn, "250 ok\r\n")
+			}
+		}
+	}()
+	return ln.Addr().String(), msgs
+}
+
+func TestSMTPSink(t *testing.T) {
+	addr, msgs := fakeSMTP(t)
+	sink := &SMTPSink{Addr: addr, From: "sf@example.com", To: []string{"oncall@example.com"}}
+	if err := sink.Send(context.Background(), testNotes()); err != nil {
+		t.Fatal(err)
+	}
+	msg := <-msgs
+	for _, want := range []string{"To: oncall@example.com", "Subject: [SolidFire] [new] critical driveFailed (prod): Drive 3 failed", "key: driveFailed:drives=3"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("message lacks %q:\n%s", want, msg)
+		}
+	}
+}
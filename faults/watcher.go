@@ -0,0 +1,421 @@
+// Package faults watches ListClusterFaults and notifies sinks about faults that
+// appear, resolve or escalate. Faults that clear and come back within a flap window
+// are reported once, routing rules pick the sinks by severity, type and code, and
+// the watcher keeps an acknowledgement state that on-call tooling can read and
+// change over HTTP.
+package faults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	log "github.com/sirupsen/logrus"
+)
+
+// Kind is the change a notification reports.
+type Kind string
+
+const (
+	// New is a fault that was not active at the previous poll.
+	New Kind = "new"
+	// Resolved is a fault that has stayed cleared for the flap window.
+	Resolved Kind = "resolved"
+	// Escalated is an active fault whose severity went up.
+	Escalated Kind = "escalated"
+)
+
+// severityRank orders the severities Element reports.
+var severityRank = map[string]int{"bestPractice": 0, "warning": 1, "error": 2, "critical": 3}
+
+// Key identifies a fault across polls. Element assigns a new ClusterFaultID each
+// time a fault recurs, so the key is built from the code and the affected node,
+// drives, service and interface instead, for example "driveFailed:node=1:drives=5".
+func Key(f *sdk.ClusterFaultInfo) string {
+	parts := []string{f.Code}
+	if f.NodeID != 0 {
+		parts = append(parts, "node="+strconv.FormatInt(f.NodeID, 10))
+	}
+	drives := append([]int64(nil), f.DriveIDs...)
+	if len(drives) == 0 && f.DriveID != 0 {
+		drives = []int64{f.DriveID}
+	}
+	if len(drives) > 0 {
+		sort.Slice(drives, func(i, j int) bool { return drives[i] < drives[j] })
+		ids := make([]string, len(drives))
+		for i, d := range drives {
+			ids[i] = strconv.FormatInt(d, 10)
+		}
+		parts = append(parts, "drives="+strings.Join(ids, ","))
+	}
+	if f.ServiceID != 0 {
+		parts = append(parts, "service="+strconv.FormatInt(f.ServiceID, 10))
+	}
+	if f.NetworkInterface != "" {
+		parts = append(parts, "iface="+f.NetworkInterface)
+	}
+	return strings.Join(parts, ":")
+}
+
+// State is what the watcher knows about a fault.
+type State struct {
+	Key   string               `json:"key"`
+	Fault sdk.ClusterFaultInfo `json:"fault"`
+	// FirstSeen is when the fault was first reported; LastSeen is the last poll that listed it.
+	FirstSeen time.Time `json:"firstSeen"`
+	LastSeen  time.Time `json:"lastSeen"`
+	// ClearedAt is set while a fault is absent but still inside the flap window.
+	ClearedAt time.Time `json:"clearedAt,omitempty"`
+	// Flaps counts how often the fault cleared and came back within the flap window.
+	Flaps int `json:"flaps"`
+
+	Acknowledged   bool      `json:"acknowledged"`
+	AcknowledgedBy string    `json:"acknowledgedBy,omitempty"`
+	AcknowledgedAt time.Time `json:"acknowledgedAt,omitempty"`
+	Comment        string    `json:"comment,omitempty"`
+}
+
+// Notification reports one change to the sinks.
+type Notification struct {
+	Kind Kind `json:"kind"`
+	// Cluster is Watcher.Cluster, to tell clusters apart in shared channels.
+	Cluster string `json:"cluster,omitempty"`
+	// PreviousSeverity is set for escalations.
+	PreviousSeverity string    `json:"previousSeverity,omitempty"`
+	Time             time.Time `json:"time"`
+	State            State     `json:"state"`
+}
+
+// Summary is a one-line description such as "[new] critical driveFailed on node 1: ...".
+func (n *Notification) Summary() string {
+	f := &n.State.Fault
+	s := fmt.Sprintf("[%s] %s %s", n.Kind, f.Severity, f.Code)
+	if n.Kind == Escalated {
+		s = fmt.Sprintf("[%s] %s -> %s %s", n.Kind, n.PreviousSeverity, f.Severity, f.Code)
+	}
+	if f.NodeID != 0 {
+		s += fmt.Sprintf(" on node %d", f.NodeID)
+	}
+	if n.Cluster != "" {
+		s += " (" + n.Cluster + ")"
+	}
+	if f.Details != "" {
+		s += ": " + f.Details
+	}
+	return s
+}
+
+// Watcher polls ListClusterFaults and reports changes through its routes.
+type Watcher struct {
+	// Cluster names the cluster in notifications.
+	Cluster string
+	// Interval between polls. Default 30 seconds, the rate at which the cluster refreshes faults.
+	Interval time.Duration
+	// FlapWindow is how long a fault must stay cleared before it is reported as
+	// resolved. A fault that comes back sooner is counted as a flap and not reported
+	// again. Default 5 minutes; negative reports resolutions immediately.
+	FlapWindow time.Duration
+	// BestPractices includes faults for suboptimal configuration.
+	BestPractices bool
+	// SuppressInitial records the faults found by the first poll without notifying.
+	SuppressInitial bool
+	// ClearResolved calls ClearClusterFaults after a poll that found resolved faults,
+	// whether or not the sinks took the notifications.
+	ClearResolved bool
+	// Routes select the sinks for each notification; see Router.
+	Routes Router
+	// MaxAttempts is how many polls in a row a sink may fail to take its
+	// notifications before they go to DeadLetter instead. Default 10.
+	MaxAttempts int
+	// MaxBacklog caps the notifications kept for a failing sink. The oldest go to
+	// DeadLetter first. Default 100.
+	MaxBacklog int
+	// DeadLetter, if set, receives the notifications a failing sink gave up on.
+	// Otherwise they are logged and dropped.
+	DeadLetter Sink
+
+	sf     *sdk.SFClient
+	now    func() time.Time
+	mu     sync.Mutex
+	polled bool
+	states map[string]*State
+	// undelivered holds the notifications sinks failed to take, by sink name, for
+	// the next Poll.
+	undelivered map[string]*backlog
+}
+
+// backlog is what a sink failed to take, and in how many polls in a row.
+type backlog struct {
+	notes    []Notification
+	failures int
+}
+
+// NewWatcher returns a watcher for the connected client sf.
+func NewWatcher(sf *sdk.SFClient, routes ...Route) *Watcher {
+	return &Watcher{sf: sf, Routes: Router{Routes: routes}, now: time.Now, states: map[string]*State{}}
+}
+
+// Run polls until ctx is done. Poll errors are logged and do not stop the watcher.
+func (w *Watcher) Run(ctx context.Context) error {
+	interval := w.Interval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.Poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.WithContext(ctx).Warnf("cluster fault poll failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll lists the current faults once, updates the state, and delivers the resulting
+// notifications. It returns the notifications even if some sinks failed. What a sink
+// failed to take is sent to it again, ahead of its new notifications, on the next
+// Poll, until MaxAttempts polls in a row have failed.
+func (w *Watcher) Poll(ctx context.Context) ([]Notification, error) {
+	res, sdkErr := w.sf.ListClusterFaults(ctx, &sdk.ListClusterFaultsRequest{FaultTypes: "current", BestPractices: w.BestPractices})
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	notes := w.diff(res.Faults)
+
+	w.mu.Lock()
+	backlogs := w.undelivered
+	w.mu.Unlock()
+	batches := w.Routes.batches(notes)
+	byName := map[string]*batch{}
+	for _, b := range batches {
+		byName[b.name] = b
+	}
+	sinks := w.Routes.sinks()
+	var dead []Notification
+	for _, name := range sortedKeys(backlogs) {
+		b := byName[name]
+		if b == nil {
+			sink, ok := sinks[name]
+			if !ok {
+				// The sink was removed from the routes.
+				dead = merge(dead, backlogs[name].notes)
+				continue
+			}
+			b = &batch{name: name, sink: sink}
+			batches = append(batches, b)
+		}
+		b.notes = merge(backlogs[name].notes, b.notes)
+	}
+	failed, err := w.Routes.send(ctx, batches)
+
+	maxAttempts, maxBacklog := w.MaxAttempts, w.MaxBacklog
+	if maxAttempts <= 0 {
+		maxAttempts = 10
+	}
+	if maxBacklog <= 0 {
+		maxBacklog = 100
+	}
+	next := map[string]*backlog{}
+	for _, b := range failed {
+		bl := &backlog{notes: b.notes, failures: 1}
+		if prev, ok := backlogs[b.name]; ok {
+			bl.failures = prev.failures + 1
+		}
+		if over := len(bl.notes) - maxBacklog; over > 0 {
+			dead = merge(dead, bl.notes[:over])
+			bl.notes = bl.notes[over:]
+		}
+		if bl.failures >= maxAttempts {
+			dead = merge(dead, bl.notes)
+			continue
+		}
+		next[b.name] = bl
+	}
+	w.mu.Lock()
+	w.undelivered = next
+	w.mu.Unlock()
+	if len(dead) > 0 {
+		err = errors.Join(err, w.deadLetter(ctx, dead))
+	}
+
+	if w.ClearResolved && hasKind(notes, Resolved) {
+		if _, sdkErr := w.sf.ClearClusterFaults(ctx, &sdk.ClearClusterFaultsRequest{FaultTypes: "resolved"}); sdkErr != nil {
+			err = errors.Join(err, fmt.Errorf("clearing resolved faults: %w", sdkErr))
+		}
+	}
+	return notes, err
+}
+
+// deadLetter hands notes that could not be delivered to DeadLetter, or logs them.
+func (w *Watcher) deadLetter(ctx context.Context, notes []Notification) error {
+	if w.DeadLetter == nil {
+		for _, n := range notes {
+			log.WithContext(ctx).Warnf("dropping undelivered cluster fault notification: %s", n.Summary())
+		}
+		return nil
+	}
+	if err := w.DeadLetter.Send(ctx, notes); err != nil {
+		return fmt.Errorf("dead letter sink: %w", err)
+	}
+	return nil
+}
+
+// merge appends the notifications of more that are not in notes already.
+func merge(notes, more []Notification) []Notification {
+	out := slices.Clip(notes)
+	for _, n := range more {
+		if !slices.ContainsFunc(out, func(o Notification) bool {
+			return o.Kind == n.Kind && o.State.Key == n.State.Key && o.Time.Equal(n.Time)
+		}) {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+func hasKind(notes []Notification, kind Kind) bool {
+	for _, n := range notes {
+		if n.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// diff merges the current faults into the state and returns the changes.
+func (w *Watcher) diff(current []sdk.ClusterFaultInfo) []Notification {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	now := w.now()
+	flapWindow := w.FlapWindow
+	if flapWindow == 0 {
+		flapWindow = 5 * time.Minute
+	}
+	notify := w.polled || !w.SuppressInitial
+	w.polled = true
+
+	var notes []Notification
+	add := func(kind Kind, st *State, previous string) {
+		if notify {
+			notes = append(notes, Notification{Kind: kind, Cluster: w.Cluster, PreviousSeverity: previous, Time: now, State: *st})
+		}
+	}
+
+	// Collapse duplicate entries for the same fault to the most severe one.
+	var keys []string
+	seen := map[string]sdk.ClusterFaultInfo{}
+	for _, f := range current {
+		key := Key(&f)
+		if prev, ok := seen[key]; !ok {
+			keys = append(keys, key)
+		} else if severityRank[f.Severity] <= severityRank[prev.Severity] {
+			continue
+		}
+		seen[key] = f
+	}
+
+	for _, key := range keys {
+		f := seen[key]
+		st, ok := w.states[key]
+		switch {
+		case !ok:
+			st = &State{Key: key, Fault: f, FirstSeen: now, LastSeen: now}
+			w.states[key] = st
+			add(New, st, "")
+		case severityRank[f.Severity] > severityRank[st.Fault.Severity]:
+			previous := st.Fault.Severity
+			st.Fault, st.LastSeen = f, now
+			if !st.ClearedAt.IsZero() {
+				st.ClearedAt = time.Time{}
+				st.Flaps++
+			}
+			// A worse fault needs attention again.
+			st.Acknowledged, st.AcknowledgedBy, st.AcknowledgedAt, st.Comment = false, "", time.Time{}, ""
+			add(Escalated, st, previous)
+		default:
+			if !st.ClearedAt.IsZero() {
+				st.ClearedAt = time.Time{}
+				st.Flaps++
+			}
+			st.Fault, st.LastSeen = f, now
+		}
+	}
+
+	for _, key := range sortedKeys(w.states) {
+		st := w.states[key]
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		if st.ClearedAt.IsZero() {
+			st.ClearedAt = now
+		}
+		if flapWindow < 0 || now.Sub(st.ClearedAt) >= flapWindow {
+			st.Fault.Resolved = true
+			add(Resolved, st, "")
+			delete(w.states, key)
+		}
+	}
+	return notes
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ErrUnknownFault is returned when acknowledging a fault the watcher does not track.
+var ErrUnknownFault = errors.New("faults: unknown fault")
+
+// Faults returns the tracked faults, including those inside their flap window, sorted by key.
+func (w *Watcher) Faults() []State {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	out := make([]State, 0, len(w.states))
+	for _, key := range sortedKeys(w.states) {
+		out = append(out, *w.states[key])
+	}
+	return out
+}
+
+// Acknowledge marks a fault as being handled. The acknowledgement is dropped when
+// the fault escalates.
+func (w *Watcher) Acknowledge(key, by, comment string) (State, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	st, ok := w.states[key]
+	if !ok {
+		return State{}, fmt.Errorf("%w %q", ErrUnknownFault, key)
+	}
+	st.Acknowledged, st.AcknowledgedBy, st.AcknowledgedAt, st.Comment = true, by, w.now(), comment
+	return *st, nil
+}
+
+// Unacknowledge removes an acknowledgement.
+func (w *Watcher) Unacknowledge(key string) (State, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	st, ok := w.states[key]
+	if !ok {
+		return State{}, fmt.Errorf("%w %q", ErrUnknownFault, key)
+	}
+	st.Acknowledged, st.AcknowledgedBy, st.AcknowledgedAt, st.Comment = false, "", time.Time{}, ""
+	return *st, nil
+}
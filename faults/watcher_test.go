@@ -0,0 +1,292 @@
+package faults
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+// recorder is a sink that keeps what it was sent.
+type recorder struct{ notes []Notification }
+
+func (r *recorder) Send(ctx context.Context, notes []Notification) error {
+	r.notes = append(r.notes, notes...)
+	return nil
+}
+
+func (r *recorder) kinds() []string {
+	var out []string
+	for _, n := range r.notes {
+		out = append(out, string(n.Kind)+" "+n.State.Fault.Code)
+	}
+	r.notes = nil
+	return out
+}
+
+func newTestWatcher(t *testing.T, routes ...Route) (*Watcher, *sdktest.Server, *time.Time) {
+	t.Helper()
+	srv := sdktest.NewServer()
+	t.Cleanup(srv.Close)
+	sf, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := NewWatcher(sf, routes...)
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+	return w, srv, &now
+}
+
+func poll(t *testing.T, w *Watcher) {
+	t.Helper()
+	if _, err := w.Poll(context.Background()); err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+}
+
+func expectKinds(t *testing.T, r *recorder, want ...string) {
+	t.Helper()
+	got := r.kinds()
+	if strings.Join(got, "; ") != strings.Join(want, "; ") {
+		t.Fatalf("notifications %q, want %q", got, want)
+	}
+}
+
+func TestWatcherDiff(t *testing.T) {
+	sink := &recorder{}
+	w, srv, now := newTestWatcher(t)
+	w.Routes.Default = []Sink{sink}
+
+	disk := srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "driveWearLifeThresholdWarning", Type: "drive", Severity: "warning", NodeID: 1, DriveIDs: []int64{5}})
+	poll(t, w)
+	expectKinds(t, sink, "new driveWearLifeThresholdWarning")
+	poll(t, w)
+	expectKinds(t, sink)
+
+	// The same fault coming back as critical is an escalation, not a new fault.
+	srv.ResolveClusterFault(disk)
+	srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "driveWearLifeThresholdWarning", Type: "drive", Severity: "critical", NodeID: 1, DriveIDs: []int64{5}})
+	poll(t, w)
+	expectKinds(t, sink, "escalated driveWearLifeThresholdWarning")
+
+	for _, f := range w.Faults() {
+		srv.ResolveClusterFault(f.Fault.ClusterFaultID)
+	}
+	poll(t, w)
+	expectKinds(t, sink) // still inside the flap window
+	*now = now.Add(6 * time.Minute)
+	poll(t, w)
+	expectKinds(t, sink, "resolved driveWearLifeThresholdWarning")
+	if len(w.Faults()) != 0 {
+		t.Fatalf("resolved fault still tracked: %+v", w.Faults())
+	}
+}
+
+func TestWatcherCollapsesDuplicateEntries(t *testing.T) {
+	sink := &recorder{}
+	w, srv, _ := newTestWatcher(t)
+	w.Routes.Default = []Sink{sink}
+
+	srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "driveFailed", Type: "drive", Severity: "warning", DriveIDs: []int64{5}})
+	srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "driveFailed", Type: "drive", Severity: "critical", DriveIDs: []int64{5}})
+	poll(t, w)
+	notes := sink.notes
+	expectKinds(t, sink, "new driveFailed")
+	if notes[0].State.Fault.Severity != "critical" {
+		t.Fatalf("new fault has severity %q, want the most severe entry", notes[0].State.Fault.Severity)
+	}
+}
+
+// failing is a sink that fails while down is set and records what it takes.
+type failing struct {
+	recorder
+	down     bool
+	attempts int
+}
+
+func (f *failing) Send(ctx context.Context, notes []Notification) error {
+	f.attempts++
+	if f.down {
+		return errors.New("unreachable")
+	}
+	return f.recorder.Send(ctx, notes)
+}
+
+func TestWatcherRetriesFailedDelivery(t *testing.T) {
+	sink, flaky := &recorder{}, &failing{down: true}
+	w, srv, _ := newTestWatcher(t)
+	w.Routes.Default = []Sink{sink, Named("flaky", flaky)}
+
+	srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "nodeOffline", Type: "node", Severity: "error", NodeID: 4})
+	if _, err := w.Poll(context.Background()); err == nil || !strings.Contains(err.Error(), "sink flaky: unreachable") {
+		t.Fatalf("Poll error = %v", err)
+	}
+	expectKinds(t, sink, "new nodeOffline")
+
+	// The backlog follows the sink's name when the routes change.
+	w.Routes.Routes = []Route{{Name: "drives", Types: []string{"drive"}, Sinks: []Sink{sink}}}
+	flaky.down = false
+	srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "driveFailed", Type: "drive", Severity: "error", DriveIDs: []int64{5}})
+	poll(t, w)
+	// Only the sink that failed gets the earlier notification again.
+	expectKinds(t, sink, "new driveFailed")
+	expectKinds(t, &flaky.recorder, "new nodeOffline")
+	poll(t, w)
+	expectKinds(t, &flaky.recorder)
+}
+
+func TestWatcherGivesUpOnFailingSink(t *testing.T) {
+	dead, down := &recorder{}, &failing{down: true}
+	w, srv, _ := newTestWatcher(t)
+	w.Routes.Default = []Sink{Named("down", down)}
+	w.MaxAttempts = 3
+	w.MaxBacklog = 2
+	w.DeadLetter = dead
+
+	for _, code := range []string{"a", "b", "c"} {
+		srv.AddClusterFault(sdk.ClusterFaultInfo{Code: code, Severity: "warning"})
+		if _, err := w.Poll(context.Background()); err == nil {
+			t.Fatal("expected the sink to fail")
+		}
+	}
+	// The backlog holds two notifications, so "a" went first; the third failure
+	// gives up on the rest.
+	if down.attempts != 3 || len(w.undelivered) != 0 {
+		t.Fatalf("%d attempts, backlog %v", down.attempts, w.undelivered)
+	}
+	expectKinds(t, dead, "new a", "new b", "new c")
+	poll(t, w)
+	if down.attempts != 3 {
+		t.Fatal("sink retried after giving up")
+	}
+}
+
+func TestWatcherClearsDespiteFailedDelivery(t *testing.T) {
+	w, srv, _ := newTestWatcher(t)
+	w.Routes.Default = []Sink{Named("down", &failing{down: true})}
+	w.FlapWindow = -1
+	w.ClearResolved = true
+
+	id := srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "nodeOffline", Severity: "error", NodeID: 4})
+	w.Poll(context.Background())
+	srv.ResolveClusterFault(id)
+	if _, err := w.Poll(context.Background()); err == nil {
+		t.Fatal("expected the sink to fail")
+	}
+	if srv.CallCount("ClearClusterFaults") != 1 {
+		t.Fatal("resolved faults were not cleared")
+	}
+}
+
+func TestWatcherDeduplicatesFlapping(t *testing.T) {
+	sink := &recorder{}
+	w, srv, now := newTestWatcher(t)
+	w.Routes.Default = []Sink{sink}
+
+	id := srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "networkLinkDown", Type: "node", Severity: "error", NodeID: 2, NetworkInterface: "Bond10G"})
+	poll(t, w)
+	for i := 0; i < 3; i++ {
+		srv.ResolveClusterFault(id)
+		*now = now.Add(time.Minute)
+		poll(t, w)
+		id = srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "networkLinkDown", Type: "node", Severity: "error", NodeID: 2, NetworkInterface: "Bond10G"})
+		*now = now.Add(time.Minute)
+		poll(t, w)
+	}
+	expectKinds(t, sink, "new networkLinkDown")
+	if f := w.Faults(); len(f) != 1 || f[0].Flaps != 3 {
+		t.Fatalf("faults = %+v, want one with 3 flaps", f)
+	}
+}
+
+func TestWatcherRoutingAndClear(t *testing.T) {
+	critical, drives, rest := &recorder{}, &recorder{}, &recorder{}
+	w, srv, _ := newTestWatcher(t,
+		Route{Name: "pager", Severities: []string{"critical"}, Sinks: []Sink{critical}, Continue: true},
+		Route{Name: "storage", Types: []string{"drive"}, Sinks: []Sink{drives}},
+	)
+	w.Routes.Default = []Sink{rest}
+	w.FlapWindow = -1
+	w.ClearResolved = true
+
+	a := srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "driveFailed", Type: "drive", Severity: "critical", DriveIDs: []int64{3}})
+	srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "nodeOffline", Type: "node", Severity: "critical", NodeID: 4})
+	srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "driveAvailable", Type: "drive", Severity: "warning", DriveIDs: []int64{9}})
+	srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "clusterIOPSAreOverProvisioned", Type: "cluster", Severity: "warning"})
+	poll(t, w)
+	expectKinds(t, critical, "new driveFailed", "new nodeOffline")
+	expectKinds(t, drives, "new driveFailed", "new driveAvailable")
+	expectKinds(t, rest, "new clusterIOPSAreOverProvisioned")
+
+	srv.ResolveClusterFault(a)
+	poll(t, w)
+	expectKinds(t, drives, "resolved driveFailed")
+	if srv.CallCount("ClearClusterFaults") != 1 {
+		t.Fatal("resolved faults were not cleared")
+	}
+	res, _ := srv.NewClient()
+	all, _ := res.ListClusterFaults(context.Background(), &sdk.ListClusterFaultsRequest{FaultTypes: "resolved"})
+	if len(all.Faults) != 0 {
+		t.Fatalf("resolved faults left on the cluster: %+v", all.Faults)
+	}
+}
+
+func TestWatcherSuppressInitial(t *testing.T) {
+	sink := &recorder{}
+	w, srv, _ := newTestWatcher(t)
+	w.Routes.Default = []Sink{sink}
+	w.SuppressInitial = true
+	srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "old", Severity: "warning"})
+	poll(t, w)
+	srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "fresh", Severity: "warning"})
+	poll(t, w)
+	expectKinds(t, sink, "new fresh")
+}
+
+func TestStateAPI(t *testing.T) {
+	w, srv, _ := newTestWatcher(t)
+	srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "driveFailed", Severity: "error", NodeID: 1, DriveIDs: []int64{7}})
+	poll(t, w)
+	api := httptest.NewServer(w.Handler())
+	defer api.Close()
+
+	key := "driveFailed:node=1:drives=7"
+	resp, err := http.Post(api.URL+"/faults/"+url.PathEscape(key)+"/ack", "application/json", strings.NewReader(`{"by":"oncall","comment":"replacing drive"}`))
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("ack: %v %v", err, resp.Status)
+	}
+	resp.Body.Close()
+
+	resp, err = http.Get(api.URL + "/faults")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var states []State
+	json.NewDecoder(resp.Body).Decode(&states)
+	resp.Body.Close()
+	if len(states) != 1 || !states[0].Acknowledged || states[0].AcknowledgedBy != "oncall" {
+		t.Fatalf("states = %+v", states)
+	}
+
+	// Escalation drops the acknowledgement.
+	srv.AddClusterFault(sdk.ClusterFaultInfo{Code: "driveFailed", Severity: "critical", NodeID: 1, DriveIDs: []int64{7}})
+	poll(t, w)
+	if w.Faults()[0].Acknowledged {
+		t.Fatal("escalated fault is still acknowledged")
+	}
+
+	resp, _ = http.Post(api.URL+"/faults/nope/ack", "application/json", nil)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("unknown fault: %s", resp.Status)
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,97 @@
+package iscsi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Executor runs host commands. Implementations return a *CommandError when a
+// command runs but exits with a non-zero status, so callers can check exit codes.
+type Executor interface {
+	Run(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+// InputExecutor is an Executor that can also feed input to a command. Host uses it
+// to hand secrets to root helpers through stdin instead of the command line.
+type InputExecutor interface {
+	Executor
+	RunInput(ctx context.Context, input []byte, name string, args ...string) ([]byte, error)
+}
+
+// ExecutorFunc adapts a function to Executor.
+type ExecutorFunc func(ctx context.Context, name string, args ...string) ([]byte, error)
+
+func (f ExecutorFunc) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	return f(ctx, name, args...)
+}
+
+// CommandError is a command that exited with a non-zero status.
+type CommandError struct {
+	Command  string
+	Args     []string
+	ExitCode int
+	Output   string
+}
+
+func (e *CommandError) Error() string {
+	out := strings.TrimSpace(e.Output)
+	if out == "" {
+		return fmt.Sprintf("%s %s: exit status %d", e.Command, strings.Join(e.Args, " "), e.ExitCode)
+	}
+	return fmt.Sprintf("%s %s: exit status %d: %s", e.Command, strings.Join(e.Args, " "), e.ExitCode, out)
+}
+
+// ExitCode returns the exit status of a *CommandError in err's chain, or -1.
+func ExitCode(err error) int {
+	var ce *CommandError
+	if errors.As(err, &ce) {
+		return ce.ExitCode
+	}
+	return -1
+}
+
+// OSExecutor runs commands on the local host.
+type OSExecutor struct {
+	// Sudo runs commands through "sudo -n", for callers that are not root.
+	Sudo bool
+}
+
+// NewOSExecutor returns an executor that uses sudo unless the process runs as root.
+func NewOSExecutor() OSExecutor {
+	return OSExecutor{Sudo: os.Geteuid() != 0}
+}
+
+func (e OSExecutor) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	return e.RunInput(ctx, nil, name, args...)
+}
+
+// RunInput runs a command like Run with input as its stdin.
+func (e OSExecutor) RunInput(ctx context.Context, input []byte, name string, args ...string) ([]byte, error) {
+	cmdName, cmdArgs := name, args
+	if e.Sudo {
+		cmdName, cmdArgs = "sudo", append([]string{"-n", name}, args...)
+	}
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, cmdName, cmdArgs...)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if input != nil {
+		cmd.Stdin = bytes.NewReader(input)
+	}
+	// A fixed locale keeps the output parseable.
+	cmd.Env = append(os.Environ(), "LC_ALL=C")
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return out.Bytes(), &CommandError{Command: name, Args: args, ExitCode: exitErr.ExitCode(), Output: out.String()}
+	}
+	if err != nil {
+		return out.Bytes(), fmt.Errorf("running %s: %w", name, err)
+	}
+	return out.Bytes(), nil
+}
@@ -0,0 +1,314 @@
+// Package iscsi attaches iSCSI targets on a Linux host with open-iscsi. It runs
+// iscsiadm through an Executor for discovery, node records, login, logout and
+// session listing, and resolves SCSI block devices from sysfs instead of parsing
+// "ls" output. CHAP secrets are written to the node record file rather than passed
+// on the iscsiadm command line, so they never show up in process listings.
+//
+// The iscsitest package provides a fake executor and sysfs tree for tests on
+// machines without iscsid.
+package iscsi
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// iscsiadm exit codes this package handles.
+const (
+	exitSessionExists = 15 // ISCSI_ERR_SESS_EXISTS
+	exitNoObjects     = 21 // ISCSI_ERR_NO_OBJS_FOUND
+)
+
+// DefaultPort is the iSCSI port added to portals without one.
+const DefaultPort = "3260"
+
+// Target is a target IQN reachable through a portal.
+type Target struct {
+	// Portal is "host:port"; the port defaults to 3260.
+	Portal string
+	// TPGT is the target portal group tag reported by discovery, or -1.
+	TPGT int
+	IQN  string
+}
+
+// Session is an active iSCSI session.
+type Session struct {
+	ID        int
+	Transport string
+	Portal    string
+	TPGT      int
+	IQN       string
+	// Iface is the iSCSI interface the session uses, from sysfs.
+	Iface string
+}
+
+// CHAP holds CHAP credentials. TargetUsername and TargetPassword enable mutual
+// CHAP, where the initiator also authenticates the target.
+type CHAP struct {
+	Username       string
+	Password       string
+	TargetUsername string
+	TargetPassword string
+}
+
+// Host runs iSCSI operations on one machine.
+type Host struct {
+	// Exec runs iscsiadm. Default is NewOSExecutor().
+	Exec Executor
+	// Root is prepended to /sys, /dev and /etc paths. Tests point it at a fake tree.
+	Root string
+	// NodeDir is the open-iscsi node database. Default is /etc/iscsi/nodes, or
+	// /var/lib/iscsi/nodes when only that exists.
+	NodeDir string
+	// PollInterval is how often WaitForDevice checks sysfs. Default 250ms.
+	PollInterval time.Duration
+}
+
+// NewHost returns a Host for the local machine.
+func NewHost() *Host {
+	return &Host{Exec: NewOSExecutor()}
+}
+
+func (h *Host) path(p string) string {
+	return filepath.Join(h.Root, p)
+}
+
+func (h *Host) exec() Executor {
+	if h.Exec == nil {
+		return NewOSExecutor()
+	}
+	return h.Exec
+}
+
+func (h *Host) iscsiadm(ctx context.Context, args ...string) ([]byte, error) {
+	log.WithContext(ctx).Debugf("iscsiadm %s", strings.Join(args, " "))
+	return h.exec().Run(ctx, "iscsiadm", args...)
+}
+
+// NormalizePortal adds the default port to a portal without one.
+func NormalizePortal(portal string) string {
+	if _, _, err := net.SplitHostPort(portal); err == nil {
+		return portal
+	}
+	return net.JoinHostPort(strings.Trim(portal, "[]"), DefaultPort)
+}
+
+func nodeArgs(t Target, iface string) []string {
+	args := []string{"-m", "node", "-T", t.IQN, "-p", NormalizePortal(t.Portal)}
+	if iface != "" {
+		args = append(args, "-I", iface)
+	}
+	return args
+}
+
+// InitiatorNames returns the initiator IQNs in /etc/iscsi/initiatorname.iscsi.
+// The file is often readable by root only; it is then read with "cat" through
+// the executor, which uses sudo when needed.
+func (h *Host) InitiatorNames() ([]string, error) {
+	path := h.path("/etc/iscsi/initiatorname.iscsi")
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrPermission) {
+		data, err = h.exec().Run(context.Background(), "cat", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var iqns []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if name, ok := strings.CutPrefix(line, "InitiatorName="); ok && name != "" {
+			iqns = append(iqns, name)
+		}
+	}
+	return iqns, scanner.Err()
+}
+
+// Discover runs sendtargets discovery against portal through iface ("" for the
+// default). Like iscsiadm itself, it creates node records for the targets found.
+func (h *Host) Discover(ctx context.Context, portal, iface string) ([]Target, error) {
+	args := []string{"-m", "discovery", "-t", "sendtargets", "-p", NormalizePortal(portal)}
+	if iface != "" {
+		args = append(args, "-I", iface)
+	}
+	out, err := h.iscsiadm(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("discovery on %s: %w", portal, err)
+	}
+	var targets []Target
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		portal, tpgt := splitTPGT(fields[0])
+		targets = append(targets, Target{Portal: portal, TPGT: tpgt, IQN: fields[1]})
+	}
+	return targets, nil
+}
+
+// splitTPGT splits "10.0.0.1:3260,1" into the portal and the portal group tag.
+func splitTPGT(s string) (string, int) {
+	i := strings.LastIndex(s, ",")
+	if i < 0 {
+		return s, -1
+	}
+	tpgt, err := strconv.Atoi(s[i+1:])
+	if err != nil {
+		return s, -1
+	}
+	return s[:i], tpgt
+}
+
+// CreateNode creates (or resets) the node record for t on iface.
+func (h *Host) CreateNode(ctx context.Context, t Target, iface string) error {
+	if _, err := h.iscsiadm(ctx, append(nodeArgs(t, iface), "-o", "new")...); err != nil {
+		return fmt.Errorf("creating node %s: %w", t.IQN, err)
+	}
+	return nil
+}
+
+// UpdateNode sets one node record setting through iscsiadm. Secrets are refused;
+// use SetCHAP for those.
+func (h *Host) UpdateNode(ctx context.Context, t Target, iface, name, value string) error {
+	if isSecret(name) {
+		return fmt.Errorf("refusing to pass %s on the command line; use SetCHAP", name)
+	}
+	if _, err := h.iscsiadm(ctx, append(nodeArgs(t, iface), "-o", "update", "-n", name, "-v", value)...); err != nil {
+		return fmt.Errorf("updating node %s: %w", t.IQN, err)
+	}
+	return nil
+}
+
+func isSecret(name string) bool {
+	return strings.Contains(strings.ToLower(name), "password")
+}
+
+// SetCHAP enables CHAP on the node record of t. The usernames are set through
+// iscsiadm; the passwords are written straight into the record file, or handed to
+// a root helper on stdin when the caller cannot write it.
+func (h *Host) SetCHAP(ctx context.Context, t Target, iface string, chap CHAP) error {
+	settings := [][2]string{
+		{"node.session.auth.authmethod", "CHAP"},
+		{"node.session.auth.username", chap.Username},
+	}
+	if chap.TargetUsername != "" {
+		settings = append(settings, [2]string{"node.session.auth.username_in", chap.TargetUsername})
+	}
+	for _, s := range settings {
+		if err := h.UpdateNode(ctx, t, iface, s[0], s[1]); err != nil {
+			return err
+		}
+	}
+	secrets := map[string]string{"node.session.auth.password": chap.Password}
+	if chap.TargetUsername != "" {
+		secrets["node.session.auth.password_in"] = chap.TargetPassword
+	}
+	return h.writeRecord(ctx, t, iface, secrets)
+}
+
+// Login logs in to t through iface. An existing session counts as success.
+func (h *Host) Login(ctx context.Context, t Target, iface string) error {
+	_, err := h.iscsiadm(ctx, append(nodeArgs(t, iface), "--login")...)
+	if err != nil && ExitCode(err) != exitSessionExists {
+		return fmt.Errorf("login to %s via %s: %w", t.IQN, NormalizePortal(t.Portal), err)
+	}
+	return nil
+}
+
+// LoginCHAP creates the node record for t, sets the CHAP credentials and logs in.
+func (h *Host) LoginCHAP(ctx context.Context, t Target, iface string, chap CHAP) error {
+	if err := h.CreateNode(ctx, t, iface); err != nil {
+		return err
+	}
+	if err := h.SetCHAP(ctx, t, iface, chap); err != nil {
+		return err
+	}
+	return h.Login(ctx, t, iface)
+}
+
+// Logout ends the session to t on iface. A missing session counts as success.
+func (h *Host) Logout(ctx context.Context, t Target, iface string) error {
+	_, err := h.iscsiadm(ctx, append(nodeArgs(t, iface), "--logout")...)
+	if err != nil && ExitCode(err) != exitNoObjects {
+		return fmt.Errorf("logout from %s: %w", t.IQN, err)
+	}
+	return nil
+}
+
+// DeleteNode removes the node record of t on iface, so the target is not logged in
+// again at boot. A missing record counts as success.
+func (h *Host) DeleteNode(ctx context.Context, t Target, iface string) error {
+	_, err := h.iscsiadm(ctx, append(nodeArgs(t, iface), "-o", "delete")...)
+	if err != nil && ExitCode(err) != exitNoObjects {
+		return fmt.Errorf("deleting node %s: %w", t.IQN, err)
+	}
+	return nil
+}
+
+// sessionLine matches "tcp: [3] 10.0.0.1:3260,1 iqn.2010-01.com.solidfire:abcd.vol.7 (non-flash)".
+var sessionLine = regexp.MustCompile(`^(\S+): \[(\d+)\] (\S+) (\S+)`)
+
+// Sessions lists the active sessions.
+func (h *Host) Sessions(ctx context.Context) ([]Session, error) {
+	out, err := h.iscsiadm(ctx, "-m", "session")
+	if ExitCode(err) == exitNoObjects {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing sessions: %w", err)
+	}
+	var sessions []Session
+	for _, line := range strings.Split(string(out), "\n") {
+		m := sessionLine.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		id, _ := strconv.Atoi(m[2])
+		portal, tpgt := splitTPGT(m[3])
+		s := Session{ID: id, Transport: m[1], Portal: portal, TPGT: tpgt, IQN: m[4]}
+		s.Iface = h.readSysfs(fmt.Sprintf("/sys/class/iscsi_session/session%d/ifacename", id))
+		sessions = append(sessions, s)
+	}
+	return sessions, nil
+}
+
+// TargetSessions returns the sessions to t's IQN. If t.Portal is set, only
+// sessions through that portal are returned.
+func (h *Host) TargetSessions(ctx context.Context, t Target) ([]Session, error) {
+	all, err := h.Sessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []Session
+	for _, s := range all {
+		if s.IQN == t.IQN && (t.Portal == "" || s.Portal == NormalizePortal(t.Portal)) {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+func (h *Host) readSysfs(p string) string {
+	data, err := os.ReadFile(h.path(p))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// ErrNoNodeRecord is returned when the node record of a target cannot be found.
+var ErrNoNodeRecord = errors.New("iscsi: node record not found")
@@ -0,0 +1,163 @@
+package iscsi_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/iscsi"
+	"github.com/scaleoutsean/solidfire-go/iscsi/iscsitest"
+)
+
+const (
+	portal = "10.10.0.1"
+	iqn    = "iqn.2010-01.com.solidfire:abcd.pvc-1.7"
+)
+
+func newFake(t *testing.T) (*iscsitest.Fake, *iscsi.Host) {
+	t.Helper()
+	f := iscsitest.New(t.TempDir())
+	f.AddTarget(iscsitest.Target{IQN: iqn, Portals: []string{portal}, CHAPUser: "tenant", CHAPSecret: "s3cr3t-initiator"})
+	return f, f.Host()
+}
+
+func TestLoginCHAPKeepsSecretOffCommandLine(t *testing.T) {
+	f, h := newFake(t)
+	ctx := context.Background()
+	target := iscsi.Target{Portal: portal, IQN: iqn}
+	chap := iscsi.CHAP{Username: "tenant", Password: "s3cr3t-initiator"}
+
+	if err := h.LoginCHAP(ctx, target, "default", chap); err != nil {
+		t.Fatalf("LoginCHAP: %v", err)
+	}
+	for _, call := range f.Calls() {
+		if strings.Contains(call, chap.Password) {
+			t.Fatalf("password passed on the command line: %s", call)
+		}
+	}
+	rec := f.Record(iqn, portal, "default")
+	if rec["node.session.auth.password"] != chap.Password || rec["node.session.auth.authmethod"] != "CHAP" {
+		t.Fatalf("record not updated: %v", rec)
+	}
+
+	// A second login finds the existing session and succeeds.
+	if err := h.Login(ctx, target, "default"); err != nil {
+		t.Fatalf("repeated Login: %v", err)
+	}
+	if f.SessionCount() != 1 {
+		t.Fatalf("expected one session, got %d", f.SessionCount())
+	}
+
+	dev, err := h.WaitForDevice(ctx, target, "default", 0)
+	if err != nil || dev.Name != "sdb" || dev.Path != "/dev/sdb" || dev.LUN != 0 {
+		t.Fatalf("WaitForDevice: %v %+v", err, dev)
+	}
+}
+
+func TestLoginWrongSecret(t *testing.T) {
+	_, h := newFake(t)
+	err := h.LoginCHAP(context.Background(), iscsi.Target{Portal: portal, IQN: iqn}, "", iscsi.CHAP{Username: "tenant", Password: "wrong"})
+	if iscsi.ExitCode(err) != 24 {
+		t.Fatalf("expected an authorization failure, got %v", err)
+	}
+}
+
+func TestUpdateNodeRefusesSecrets(t *testing.T) {
+	f, h := newFake(t)
+	err := h.UpdateNode(context.Background(), iscsi.Target{Portal: portal, IQN: iqn}, "", "node.session.auth.password", "x")
+	if err == nil || len(f.Calls()) != 0 {
+		t.Fatalf("expected UpdateNode to refuse a password, got %v, calls %v", err, f.Calls())
+	}
+}
+
+func TestDiscoverySessionsAndLogout(t *testing.T) {
+	f, h := newFake(t)
+	ctx := context.Background()
+	f.AddTarget(iscsitest.Target{IQN: "iqn.2010-01.com.solidfire:abcd.open.8", Portals: []string{portal}})
+
+	targets, err := h.Discover(ctx, portal, "")
+	if err != nil || len(targets) != 2 {
+		t.Fatalf("Discover: %v %+v", err, targets)
+	}
+	if targets[1].Portal != "10.10.0.1:3260" || targets[1].TPGT != 1 || targets[1].IQN != iqn {
+		t.Fatalf("unexpected target %+v", targets[1])
+	}
+
+	if sessions, err := h.Sessions(ctx); err != nil || len(sessions) != 0 {
+		t.Fatalf("Sessions without logins: %v %+v", err, sessions)
+	}
+	open := targets[0]
+	if err := h.Login(ctx, open, "default"); err != nil {
+		t.Fatal(err)
+	}
+	sessions, err := h.Sessions(ctx)
+	if err != nil || len(sessions) != 1 {
+		t.Fatalf("Sessions: %v %+v", err, sessions)
+	}
+	if s := sessions[0]; s.IQN != open.IQN || s.Portal != "10.10.0.1:3260" || s.Iface != "default" || s.Transport != "tcp" {
+		t.Fatalf("unexpected session %+v", s)
+	}
+	devices, err := h.Devices(ctx, open, 0)
+	if err != nil || len(devices) != 1 || devices[0].SessionID != sessions[0].ID {
+		t.Fatalf("Devices: %v %+v", err, devices)
+	}
+
+	if err := h.Logout(ctx, open, "default"); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Logout(ctx, open, "default"); err != nil {
+		t.Fatalf("logout without a session should succeed: %v", err)
+	}
+	if err := h.DeleteNode(ctx, open, "default"); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.DeleteNode(ctx, open, "default"); err != nil {
+		t.Fatalf("deleting a missing record should succeed: %v", err)
+	}
+}
+
+func TestWaitForDeviceHonoursContext(t *testing.T) {
+	_, h := newFake(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := h.WaitForDevice(ctx, iscsi.Target{Portal: portal, IQN: iqn}, "", 0)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline error, got %v", err)
+	}
+}
+
+func TestInitiatorNamesAndResolve(t *testing.T) {
+	f, h := newFake(t)
+	names, err := h.InitiatorNames()
+	if err != nil || len(names) != 1 || names[0] != iscsitest.DefaultInitiatorName {
+		t.Fatalf("InitiatorNames: %v %v", err, names)
+	}
+
+	byPath := filepath.Join(f.Root, "dev/disk/by-path")
+	if err := os.MkdirAll(byPath, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(f.Root, "dev/sdq"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	link := "ip-10.10.0.1:3260-iscsi-" + iqn + "-lun-0"
+	if err := os.Symlink("../../sdq", filepath.Join(byPath, link)); err != nil {
+		t.Fatal(err)
+	}
+	dev, err := h.ResolveDevicePath("/dev/disk/by-path/" + link)
+	if err != nil || dev != "/dev/sdq" {
+		t.Fatalf("ResolveDevicePath: %v %q", err, dev)
+	}
+}
+
+func TestOSExecutorExitCode(t *testing.T) {
+	_, err := iscsi.OSExecutor{}.Run(context.Background(), "sh", "-c", "echo nope >&2; exit 21")
+	var ce *iscsi.CommandError
+	if !errors.As(err, &ce) || ce.ExitCode != 21 || !strings.Contains(ce.Output, "nope") {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
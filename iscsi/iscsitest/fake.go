@@ -0,0 +1,519 @@
+// Package iscsitest fakes open-iscsi for tests. Fake implements iscsi.Executor by
+// simulating iscsiadm (discovery, node records, login with CHAP checks, logout and
+// session listing), multipath -f and blockdev --flushbufs, and keeps a matching
+// sysfs and /dev tree under a root directory, so iscsi.Host can be exercised end
+// to end without iscsid, multipathd or root. Writes to a device's sysfs "delete"
+// file take effect on the next command, as the kernel's would.
+package iscsitest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/scaleoutsean/solidfire-go/iscsi"
+)
+
+// DefaultInitiatorName is written to etc/iscsi/initiatorname.iscsi by New.
+const DefaultInitiatorName = "iqn.1993-08.org.debian:01:fakehost"
+
+// Target is a target the fake host can discover and log in to. Every login
+// creates LUN 0 as a new sd device.
+type Target struct {
+	IQN     string
+	Portals []string
+	// CHAPUser and CHAPSecret, when set, must match the node record at login.
+	CHAPUser   string
+	CHAPSecret string
+	// WWID is the NAA identifier of LUN 0, shown as "naa.<WWID>" in the device's
+	// sysfs wwid file.
+	WWID string
+}
+
+type session struct {
+	id     int
+	host   int
+	iqn    string
+	portal string
+	iface  string
+	// disk is "" once the device has been deleted.
+	disk string
+}
+
+// dmMap is a multipath map assembled from the paths of one WWID.
+type dmMap struct {
+	name   string // dm-N
+	alias  string // map name, "3" + WWID
+	wwid   string
+	slaves []string
+}
+
+// Fake is a fake iSCSI host rooted at Root.
+type Fake struct {
+	Root string
+	// Multipath makes the fake act like multipathd: each path of a target with a
+	// WWID joins a dm-N map for that WWID.
+	Multipath bool
+
+	mu       sync.Mutex
+	targets  map[string]*Target
+	sessions []*session
+	maps     []*dmMap
+	nextSID  int
+	nextHost int
+	nextDisk int
+	nextDM   int
+	calls    []string
+	events   []string
+	failures map[string]int
+}
+
+// New creates the fake tree under root, usually t.TempDir().
+func New(root string) *Fake {
+	f := &Fake{Root: root, targets: map[string]*Target{}, failures: map[string]int{}, nextHost: 2}
+	for _, dir := range []string{"etc/iscsi/nodes", "sys/class/iscsi_session", "sys/block", "dev"} {
+		must(os.MkdirAll(filepath.Join(root, dir), 0o755))
+	}
+	must(os.WriteFile(filepath.Join(root, "etc/iscsi/initiatorname.iscsi"),
+		[]byte("## DO NOT EDIT OR REMOVE THIS FILE!\nInitiatorName="+DefaultInitiatorName+"\n"), 0o600))
+	return f
+}
+
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Host returns an iscsi.Host that runs its commands on f.
+func (f *Fake) Host() *iscsi.Host {
+	return &iscsi.Host{Exec: f, Root: f.Root, PollInterval: 1}
+}
+
+// AddTarget makes t discoverable through its portals.
+func (f *Fake) AddTarget(t Target) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, p := range t.Portals {
+		t.Portals[i] = iscsi.NormalizePortal(p)
+	}
+	f.targets[t.IQN] = &t
+}
+
+// Fail makes the next times commands whose arguments contain match fail.
+func (f *Fake) Fail(match string, times int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures[match] = times
+}
+
+// Calls returns the commands run so far, one string per command.
+func (f *Fake) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.calls)
+}
+
+// Events returns what happened to the host so far, in order: "login <iqn> <portal>
+// <iface>", "map <alias> <dm-N>", "flush <alias>", "delete <sdX>" and "logout <iqn>
+// <portal> <iface>". Logging out a session whose device was not deleted first also
+// logs "delete <sdX>".
+func (f *Fake) Events() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sync()
+	return slices.Clone(f.events)
+}
+
+// SessionCount returns the number of active sessions.
+func (f *Fake) SessionCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.sessions)
+}
+
+// Run implements iscsi.Executor.
+func (f *Fake) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sync()
+	line := strings.Join(append([]string{name}, args...), " ")
+	f.calls = append(f.calls, line)
+	for match, n := range f.failures {
+		if n > 0 && strings.Contains(line, match) {
+			f.failures[match] = n - 1
+			return nil, f.fail(name, args, 1, "injected failure")
+		}
+	}
+	if h, ok := f.handlers()[name]; ok {
+		return h(args)
+	}
+	return nil, f.fail(name, args, 127, name+": command not found")
+}
+
+func (f *Fake) fail(name string, args []string, code int, msg string) error {
+	return &iscsi.CommandError{Command: name, Args: args, ExitCode: code, Output: msg}
+}
+
+func (f *Fake) handlers() map[string]func([]string) ([]byte, error) {
+	return map[string]func([]string) ([]byte, error){
+		"iscsiadm":  f.iscsiadm,
+		"multipath": f.multipath,
+		"blockdev":  f.blockdev,
+	}
+}
+
+// opts parses iscsiadm arguments into flags; "--login" style flags map to "".
+func opts(args []string) map[string]string {
+	out := map[string]string{}
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		switch {
+		case strings.HasPrefix(a, "--") && strings.Contains(a, "="):
+			k, v, _ := strings.Cut(a, "=")
+			out[k] = v
+		case strings.HasPrefix(a, "-") && i+1 < len(args) && !strings.HasPrefix(args[i+1], "-"):
+			out[a] = args[i+1]
+			i++
+		default:
+			out[a] = ""
+		}
+	}
+	return out
+}
+
+func (f *Fake) iscsiadm(args []string) ([]byte, error) {
+	o := opts(args)
+	iface := o["-I"]
+	if iface == "" {
+		iface = "default"
+	}
+	switch o["-m"] {
+	case "discovery":
+		return f.discover(args, iscsi.NormalizePortal(o["-p"]), iface)
+	case "session":
+		return f.listSessions(args)
+	case "node":
+		portal := iscsi.NormalizePortal(o["-p"])
+		iqn := o["-T"]
+		if _, ok := o["--login"]; ok {
+			return f.login(args, iqn, portal, iface)
+		}
+		if _, ok := o["--logout"]; ok {
+			return f.logout(args, iqn, portal, iface)
+		}
+		switch o["-o"] {
+		case "new":
+			return f.newRecord(iqn, portal, iface, -1)
+		case "update":
+			return f.updateRecord(args, iqn, portal, iface, o["-n"], o["-v"])
+		case "delete":
+			return f.deleteRecord(args, iqn, portal, iface)
+		}
+	}
+	return nil, f.fail("iscsiadm", args, 7, "iscsiadm: unsupported arguments")
+}
+
+func (f *Fake) recordPath(iqn, portal, iface string, tpgt int) string {
+	host, port, _ := net.SplitHostPort(portal)
+	return filepath.Join(f.Root, "etc/iscsi/nodes", iqn, fmt.Sprintf("%s,%s,%d", host, port, tpgt), iface)
+}
+
+// findRecord returns the record file of iqn at portal on iface, whatever its tpgt.
+func (f *Fake) findRecord(iqn, portal, iface string) string {
+	host, port, _ := net.SplitHostPort(portal)
+	matches, _ := filepath.Glob(filepath.Join(f.Root, "etc/iscsi/nodes", iqn, host+","+port+",*", iface))
+	if len(matches) == 0 {
+		return ""
+	}
+	return matches[0]
+}
+
+func (f *Fake) newRecord(iqn, portal, iface string, tpgt int) ([]byte, error) {
+	if old := f.findRecord(iqn, portal, iface); old != "" {
+		os.Remove(old)
+	}
+	p := f.recordPath(iqn, portal, iface, tpgt)
+	must(os.MkdirAll(filepath.Dir(p), 0o700))
+	host, port, _ := net.SplitHostPort(portal)
+	record := fmt.Sprintf("# BEGIN RECORD 2.1.8\nnode.name = %s\nnode.tpgt = %d\niface.iscsi_ifacename = %s\n"+
+		"node.session.auth.authmethod = None\nnode.conn[0].address = %s\nnode.conn[0].port = %s\n# END RECORD\n",
+		iqn, tpgt, iface, host, port)
+	must(os.WriteFile(p, []byte(record), 0o600))
+	return []byte(fmt.Sprintf("New iSCSI node [tcp:[hw=,ip=,net_if=,iscsi_if=%s] %s,%d %s] added\n", iface, portal, tpgt, iqn)), nil
+}
+
+// Record returns the settings in the node record of iqn at portal on iface.
+func (f *Fake) Record(iqn, portal, iface string) map[string]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.readRecord(f.findRecord(iqn, iscsi.NormalizePortal(portal), iface))
+}
+
+func (f *Fake) readRecord(p string) map[string]string {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil
+	}
+	out := map[string]string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		if k, v, ok := strings.Cut(line, "="); ok && !strings.HasPrefix(line, "#") {
+			out[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+	return out
+}
+
+func (f *Fake) updateRecord(args []string, iqn, portal, iface, name, value string) ([]byte, error) {
+	p := f.findRecord(iqn, portal, iface)
+	if p == "" {
+		return nil, f.fail("iscsiadm", args, 21, "iscsiadm: No records found")
+	}
+	data, _ := os.ReadFile(p)
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	found := false
+	for i, line := range lines {
+		if k, _, ok := strings.Cut(line, "="); ok && strings.TrimSpace(k) == name {
+			lines[i] = name + " = " + value
+			found = true
+		}
+	}
+	if !found {
+		lines = append(lines[:len(lines)-1], name+" = "+value, lines[len(lines)-1])
+	}
+	must(os.WriteFile(p, []byte(strings.Join(lines, "\n")+"\n"), 0o600))
+	return nil, nil
+}
+
+func (f *Fake) deleteRecord(args []string, iqn, portal, iface string) ([]byte, error) {
+	p := f.findRecord(iqn, portal, iface)
+	if p == "" {
+		return nil, f.fail("iscsiadm", args, 21, "iscsiadm: No records found")
+	}
+	os.Remove(p)
+	return nil, nil
+}
+
+func (f *Fake) discover(args []string, portal, iface string) ([]byte, error) {
+	var b strings.Builder
+	for _, iqn := range sortedKeys(f.targets) {
+		if slices.Contains(f.targets[iqn].Portals, portal) {
+			f.newRecord(iqn, portal, iface, 1)
+			fmt.Fprintf(&b, "%s,1 %s\n", portal, iqn)
+		}
+	}
+	if b.Len() == 0 {
+		return nil, f.fail("iscsiadm", args, 21, "iscsiadm: No portals found")
+	}
+	return []byte(b.String()), nil
+}
+
+func (f *Fake) findSession(iqn, portal, iface string) *session {
+	for _, s := range f.sessions {
+		if s.iqn == iqn && s.portal == portal && s.iface == iface {
+			return s
+		}
+	}
+	return nil
+}
+
+func (f *Fake) login(args []string, iqn, portal, iface string) ([]byte, error) {
+	p := f.findRecord(iqn, portal, iface)
+	if p == "" {
+		return nil, f.fail("iscsiadm", args, 21, "iscsiadm: No records found")
+	}
+	if f.findSession(iqn, portal, iface) != nil {
+		return nil, f.fail("iscsiadm", args, 15, "iscsiadm: default: 1 session requested, but 1 already present.")
+	}
+	t, ok := f.targets[iqn]
+	if !ok || !slices.Contains(t.Portals, portal) {
+		return nil, f.fail("iscsiadm", args, 8, "iscsiadm: Could not login to target (connection timed out)")
+	}
+	if t.CHAPUser != "" {
+		rec := f.readRecord(p)
+		if rec["node.session.auth.authmethod"] != "CHAP" || rec["node.session.auth.username"] != t.CHAPUser ||
+			rec["node.session.auth.password"] != t.CHAPSecret {
+			return nil, f.fail("iscsiadm", args, 24, "iscsiadm: Could not login to target (iSCSI login failed due to authorization failure)")
+		}
+	}
+
+	f.nextSID++
+	f.nextHost++
+	s := &session{id: f.nextSID, host: f.nextHost, iqn: iqn, portal: portal, iface: iface, disk: diskName(f.nextDisk)}
+	f.nextDisk++
+	f.sessions = append(f.sessions, s)
+
+	sdir := filepath.Join(f.Root, fmt.Sprintf("sys/class/iscsi_session/session%d", s.id))
+	hctl := fmt.Sprintf("%d:0:0:0", s.host)
+	must(os.MkdirAll(filepath.Join(sdir, "device", fmt.Sprintf("target%d:0:0", s.host), hctl, "block", s.disk), 0o755))
+	must(os.WriteFile(filepath.Join(sdir, "targetname"), []byte(iqn+"\n"), 0o644))
+	must(os.WriteFile(filepath.Join(sdir, "ifacename"), []byte(iface+"\n"), 0o644))
+	dev := filepath.Join(f.Root, "sys/block", s.disk, "device")
+	must(os.MkdirAll(dev, 0o755))
+	must(os.MkdirAll(filepath.Join(f.Root, "sys/block", s.disk, "holders"), 0o755))
+	must(os.WriteFile(filepath.Join(dev, "delete"), nil, 0o600))
+	if t.WWID != "" {
+		must(os.WriteFile(filepath.Join(dev, "wwid"), []byte("naa."+t.WWID+"\n"), 0o444))
+	}
+	must(os.WriteFile(filepath.Join(f.Root, "dev", s.disk), nil, 0o600))
+	f.events = append(f.events, fmt.Sprintf("login %s %s %s", iqn, portal, iface))
+	if f.Multipath && t.WWID != "" {
+		f.addPath(t.WWID, s.disk)
+	}
+	return []byte(fmt.Sprintf("Logging in to [iface: %s, target: %s, portal: %s]\nLogin to [iface: %s, target: %s, portal: %s] successful.\n",
+		iface, iqn, portal, iface, iqn, portal)), nil
+}
+
+func (f *Fake) logout(args []string, iqn, portal, iface string) ([]byte, error) {
+	s := f.findSession(iqn, portal, iface)
+	if s == nil {
+		return nil, f.fail("iscsiadm", args, 21, "iscsiadm: No matching sessions found")
+	}
+	f.removeSession(s)
+	f.events = append(f.events, fmt.Sprintf("logout %s %s %s", iqn, portal, iface))
+	return []byte(fmt.Sprintf("Logout of [sid: %d, target: %s, portal: %s] successful.\n", s.id, iqn, portal)), nil
+}
+
+func (f *Fake) removeSession(s *session) {
+	if s.disk != "" {
+		f.removeDisk(s)
+	}
+	os.RemoveAll(filepath.Join(f.Root, fmt.Sprintf("sys/class/iscsi_session/session%d", s.id)))
+	f.sessions = slices.DeleteFunc(f.sessions, func(o *session) bool { return o == s })
+}
+
+// removeDisk removes the SCSI device of s, as the kernel does on a write to its
+// delete file or at logout. A multipath map keeps running without the path.
+func (f *Fake) removeDisk(s *session) {
+	hctl := fmt.Sprintf("%d:0:0:0", s.host)
+	os.RemoveAll(filepath.Join(f.Root, fmt.Sprintf("sys/class/iscsi_session/session%d/device/target%d:0:0", s.id, s.host), hctl))
+	os.RemoveAll(filepath.Join(f.Root, "sys/block", s.disk))
+	os.Remove(filepath.Join(f.Root, "dev", s.disk))
+	for _, m := range f.maps {
+		if i := slices.Index(m.slaves, s.disk); i >= 0 {
+			m.slaves = slices.Delete(m.slaves, i, i+1)
+			os.Remove(filepath.Join(f.Root, "sys/block", m.name, "slaves", s.disk))
+		}
+	}
+	f.events = append(f.events, "delete "+s.disk)
+	s.disk = ""
+}
+
+// sync applies writes to the delete files of SCSI devices.
+func (f *Fake) sync() {
+	for _, s := range f.sessions {
+		if s.disk == "" {
+			continue
+		}
+		data, _ := os.ReadFile(filepath.Join(f.Root, "sys/block", s.disk, "device/delete"))
+		if strings.TrimSpace(string(data)) == "1" {
+			f.removeDisk(s)
+		}
+	}
+}
+
+// addPath adds disk to the map of wwid, creating the map for its first path.
+func (f *Fake) addPath(wwid, disk string) {
+	var m *dmMap
+	for _, o := range f.maps {
+		if o.wwid == wwid {
+			m = o
+		}
+	}
+	if m == nil {
+		m = &dmMap{name: fmt.Sprintf("dm-%d", f.nextDM), alias: "3" + wwid, wwid: wwid}
+		f.nextDM++
+		f.maps = append(f.maps, m)
+		dm := filepath.Join(f.Root, "sys/block", m.name)
+		must(os.MkdirAll(filepath.Join(dm, "dm"), 0o755))
+		must(os.MkdirAll(filepath.Join(dm, "slaves"), 0o755))
+		must(os.WriteFile(filepath.Join(dm, "dm/name"), []byte(m.alias+"\n"), 0o444))
+		must(os.WriteFile(filepath.Join(dm, "dm/uuid"), []byte("mpath-"+m.alias+"\n"), 0o444))
+		must(os.WriteFile(filepath.Join(f.Root, "dev", m.name), nil, 0o600))
+		f.events = append(f.events, fmt.Sprintf("map %s %s", m.alias, m.name))
+	}
+	m.slaves = append(m.slaves, disk)
+	must(os.Symlink("../../"+disk, filepath.Join(f.Root, "sys/block", m.name, "slaves", disk)))
+	must(os.Symlink("../../"+m.name, filepath.Join(f.Root, "sys/block", disk, "holders", m.name)))
+}
+
+// MapCount returns the number of multipath maps.
+func (f *Fake) MapCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.maps)
+}
+
+// multipath handles "multipath -f <map>", where map is the alias or dm name.
+func (f *Fake) multipath(args []string) ([]byte, error) {
+	o := opts(args)
+	name, ok := o["-f"]
+	if !ok {
+		return nil, f.fail("multipath", args, 1, "multipath: unsupported arguments")
+	}
+	name = strings.TrimPrefix(name, "/dev/")
+	for _, m := range f.maps {
+		if m.alias != name && m.name != name {
+			continue
+		}
+		for _, disk := range m.slaves {
+			os.Remove(filepath.Join(f.Root, "sys/block", disk, "holders", m.name))
+		}
+		os.RemoveAll(filepath.Join(f.Root, "sys/block", m.name))
+		os.Remove(filepath.Join(f.Root, "dev", m.name))
+		f.maps = slices.DeleteFunc(f.maps, func(o *dmMap) bool { return o == m })
+		f.events = append(f.events, "flush "+m.alias)
+		return nil, nil
+	}
+	return nil, f.fail("multipath", args, 1, name+": map does not exist")
+}
+
+// blockdev handles "blockdev --flushbufs <device>".
+func (f *Fake) blockdev(args []string) ([]byte, error) {
+	if len(args) != 2 || args[0] != "--flushbufs" {
+		return nil, f.fail("blockdev", args, 1, "blockdev: unsupported arguments")
+	}
+	if _, err := os.Stat(filepath.Join(f.Root, args[1])); err != nil {
+		return nil, f.fail("blockdev", args, 1, "blockdev: cannot open "+args[1]+": No such file or directory")
+	}
+	return nil, nil
+}
+
+func (f *Fake) listSessions(args []string) ([]byte, error) {
+	if len(f.sessions) == 0 {
+		return nil, f.fail("iscsiadm", args, 21, "iscsiadm: No active sessions.")
+	}
+	var b strings.Builder
+	for _, s := range f.sessions {
+		fmt.Fprintf(&b, "tcp: [%d] %s,1 %s (non-flash)\n", s.id, s.portal, s.iqn)
+	}
+	return []byte(b.String()), nil
+}
+
+// diskName returns sdb, sdc, ..., sdz, sdaa, ... (sda is left for the root disk).
+func diskName(n int) string {
+	n++
+	name := ""
+	for {
+		name = string(rune('a'+n%26)) + name
+		n = n/26 - 1
+		if n < 0 {
+			break
+		}
+	}
+	return "sd" + name
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,383 @@
+package iscsi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Multipath is a dm-multipath device.
+type Multipath struct {
+	// Name is the kernel name, such as "dm-3"; Path is "/dev/" + Name.
+	Name string
+	Path string
+	// Map is the multipath map name, the WWID or a user friendly name such as "mpatha".
+	Map string
+	// Slaves are the path devices, such as "sdb".
+	Slaves []string
+}
+
+// AttachOptions configures Attach and Detach.
+type AttachOptions struct {
+	// Ifaces are the iSCSI interfaces to log in through, one path each.
+	// Default is "default".
+	Ifaces []string
+	// CHAP, when set, is configured on each node record before login.
+	CHAP *CHAP
+	LUN  int
+	// WWID is the NAA identifier of the LUN, such as a SolidFire volume's
+	// ScsiNAADeviceID. Attach checks each path against it.
+	WWID string
+	// Multipath makes Attach wait for the dm-multipath device over all paths.
+	// It requires WWID.
+	Multipath bool
+}
+
+func (o AttachOptions) ifaces() []string {
+	if len(o.Ifaces) == 0 {
+		return []string{"default"}
+	}
+	return o.Ifaces
+}
+
+// Attachment is a LUN attached through one or more paths.
+type Attachment struct {
+	Target Target
+	// Paths has one device per iface, in the order of AttachOptions.Ifaces.
+	Paths []Device
+	// Multipath is set when AttachOptions.Multipath is.
+	Multipath *Multipath
+}
+
+// DevicePath returns the device to use: the multipath device if there is one,
+// otherwise the first path.
+func (a *Attachment) DevicePath() string {
+	if a.Multipath != nil {
+		return a.Multipath.Path
+	}
+	if len(a.Paths) > 0 {
+		return a.Paths[0].Path
+	}
+	return ""
+}
+
+// NormalizeWWID lowercases an NAA identifier and strips the "naa." prefix sysfs
+// uses, so "naa.6F47ACC1..." and "6f47acc1..." compare equal.
+func NormalizeWWID(wwid string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(wwid)), "naa.")
+}
+
+// DeviceWWID returns the WWID of a SCSI device such as "sdb" from sysfs, or "".
+func (h *Host) DeviceWWID(name string) string {
+	return NormalizeWWID(h.readSysfs("/sys/block/" + name + "/device/wwid"))
+}
+
+// readMultipath reads dm device name ("dm-3") from sysfs. ok is false when it is
+// not a multipath map.
+func (h *Host) readMultipath(name string) (m *Multipath, uuid string, ok bool) {
+	uuid = h.readSysfs("/sys/block/" + name + "/dm/uuid")
+	if !strings.HasPrefix(uuid, "mpath-") {
+		return nil, "", false
+	}
+	m = &Multipath{Name: name, Path: "/dev/" + name, Map: h.readSysfs("/sys/block/" + name + "/dm/name")}
+	entries, _ := os.ReadDir(h.path("/sys/block/" + name + "/slaves"))
+	for _, e := range entries {
+		m.Slaves = append(m.Slaves, e.Name())
+	}
+	return m, uuid, true
+}
+
+// FindMultipath returns the multipath device of wwid, or nil if there is none.
+// multipathd names the map's device-mapper UUID "mpath-3<NAA id>".
+func (h *Host) FindMultipath(wwid string) (*Multipath, error) {
+	want := "mpath-3" + NormalizeWWID(wwid)
+	matches, err := filepath.Glob(h.path("/sys/block/dm-*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range matches {
+		if m, uuid, ok := h.readMultipath(filepath.Base(dir)); ok && strings.ToLower(uuid) == want {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
+// Holders returns the multipath devices that use the SCSI device name.
+func (h *Host) Holders(name string) []*Multipath {
+	entries, _ := os.ReadDir(h.path("/sys/block/" + name + "/holders"))
+	var out []*Multipath
+	for _, e := range entries {
+		if m, _, ok := h.readMultipath(e.Name()); ok {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// WaitForMultipath waits until multipathd has assembled the map of wwid with
+// every device in paths, or ctx is done.
+func (h *Host) WaitForMultipath(ctx context.Context, wwid string, paths []Device) (*Multipath, error) {
+	for {
+		m, err := h.FindMultipath(wwid)
+		if err != nil {
+			return nil, err
+		}
+		if m != nil && slices.IndexFunc(paths, func(d Device) bool { return !slices.Contains(m.Slaves, d.Name) }) < 0 {
+			return m, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for multipath device of %s: %w", wwid, ctx.Err())
+		case <-time.After(h.pollInterval()):
+		}
+	}
+}
+
+// FlushMultipath flushes the buffers of m and removes the map with "multipath -f".
+func (h *Host) FlushMultipath(ctx context.Context, m *Multipath) error {
+	if _, err := h.exec().Run(ctx, "blockdev", "--flushbufs", m.Path); err != nil {
+		return fmt.Errorf("flushing %s: %w", m.Path, err)
+	}
+	name := m.Map
+	if name == "" {
+		name = m.Name
+	}
+	if _, err := h.exec().Run(ctx, "multipath", "-f", name); err != nil {
+		return fmt.Errorf("removing multipath map %s: %w", name, err)
+	}
+	return nil
+}
+
+// DeleteDevice flushes the buffers of d and removes it from the SCSI layer by
+// writing to /sys/block/<name>/device/delete. A failed flush is logged only:
+// the path may already be gone on the target side.
+func (h *Host) DeleteDevice(ctx context.Context, d Device) error {
+	if _, err := h.exec().Run(ctx, "blockdev", "--flushbufs", d.Path); err != nil {
+		log.WithContext(ctx).Warnf("flushing %s before delete: %v", d.Path, err)
+	}
+	if err := h.writeSysfs(ctx, "/sys/block/"+d.Name+"/device/delete", "1"); err != nil {
+		return fmt.Errorf("deleting %s: %w", d.Name, err)
+	}
+	return nil
+}
+
+// writeSysfs writes value to the sysfs attribute p. Without permission to write
+// it, the write runs through the executor, which uses sudo when needed.
+func (h *Host) writeSysfs(ctx context.Context, p, value string) error {
+	f, err := os.OpenFile(h.path(p), os.O_WRONLY|os.O_TRUNC, 0)
+	if errors.Is(err, fs.ErrPermission) {
+		_, err = h.exec().Run(ctx, "sh", "-c", `echo "$1" > "$2"`, "sh", value, h.path(p))
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(value); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (h *Host) pollInterval() time.Duration {
+	if h.PollInterval <= 0 {
+		return 250 * time.Millisecond
+	}
+	return h.PollInterval
+}
+
+// Attach logs in to t through every iface in opts and waits for the LUN on each
+// path, and for the multipath device when opts.Multipath is set. Ifaces that
+// already have a session to t are not logged in again. On error the sessions
+// Attach opened are removed again, with their devices and node records; sessions
+// that existed before are left alone.
+func (h *Host) Attach(ctx context.Context, t Target, opts AttachOptions) (*Attachment, error) {
+	if opts.Multipath && opts.WWID == "" {
+		return nil, errors.New("iscsi: multipath attach requires a WWID")
+	}
+	a, opened, err := h.attach(ctx, t, opts)
+	if err != nil && len(opened) > 0 {
+		h.undoAttach(ctx, t, opened)
+	}
+	return a, err
+}
+
+// attach does the work of Attach and returns the ifaces it logged in through.
+func (h *Host) attach(ctx context.Context, t Target, opts AttachOptions) (*Attachment, []string, error) {
+	sessions, err := h.TargetSessions(ctx, t)
+	if err != nil {
+		return nil, nil, err
+	}
+	var opened []string
+	a := &Attachment{Target: t}
+	for _, iface := range opts.ifaces() {
+		if !slices.ContainsFunc(sessions, func(s Session) bool { return s.Iface == iface }) {
+			// A failed login may still leave a node record or a session behind.
+			opened = append(opened, iface)
+			if err := h.login(ctx, t, iface, opts.CHAP); err != nil {
+				return nil, opened, err
+			}
+		}
+		d, err := h.WaitForDevice(ctx, t, iface, opts.LUN)
+		if err != nil {
+			return nil, opened, err
+		}
+		if wwid := h.DeviceWWID(d.Name); opts.WWID != "" && wwid != "" && wwid != NormalizeWWID(opts.WWID) {
+			return nil, opened, fmt.Errorf("iscsi: %s on iface %s has WWID %s, expected %s", d.Name, iface, wwid, NormalizeWWID(opts.WWID))
+		}
+		a.Paths = append(a.Paths, d)
+	}
+	if opts.Multipath {
+		if a.Multipath, err = h.WaitForMultipath(ctx, opts.WWID, a.Paths); err != nil {
+			return nil, opened, err
+		}
+	}
+	return a, opened, nil
+}
+
+// undoAttach removes the sessions to t on ifaces, which a failed Attach opened,
+// like Detach does. A multipath map is only flushed when all its paths are among
+// those sessions' devices; otherwise multipathd drops the deleted paths from it.
+// Errors are logged, since the caller is already failing.
+func (h *Host) undoAttach(ctx context.Context, t Target, ifaces []string) {
+	// The attach may have failed because ctx is done; the cleanup gets its own time.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer cancel()
+	logger := log.WithContext(ctx)
+	sessions, err := h.TargetSessions(ctx, t)
+	if err != nil {
+		logger.Warnf("cleaning up failed attach of %s: %v", t.IQN, err)
+		return
+	}
+	sessions = slices.DeleteFunc(sessions, func(s Session) bool { return !slices.Contains(ifaces, s.Iface) })
+	var devices []Device
+	for _, s := range sessions {
+		d, err := h.SessionDevices(s.ID)
+		if err != nil {
+			logger.Warnf("cleaning up failed attach of %s: %v", t.IQN, err)
+			return
+		}
+		devices = append(devices, d...)
+	}
+	ours := func(name string) bool {
+		return slices.ContainsFunc(devices, func(d Device) bool { return d.Name == name })
+	}
+	for _, d := range devices {
+		for _, m := range h.Holders(d.Name) {
+			if !slices.ContainsFunc(m.Slaves, func(slave string) bool { return !ours(slave) }) {
+				if err := h.FlushMultipath(ctx, m); err != nil {
+					logger.Warnf("cleaning up failed attach of %s: %v", t.IQN, err)
+					return
+				}
+			}
+		}
+	}
+	for _, d := range devices {
+		if err := h.DeleteDevice(ctx, d); err != nil {
+			logger.Warnf("cleaning up failed attach of %s: %v", t.IQN, err)
+			return
+		}
+	}
+	for _, iface := range ifaces {
+		if slices.ContainsFunc(sessions, func(s Session) bool { return s.Iface == iface }) {
+			if err := h.Logout(ctx, t, iface); err != nil {
+				logger.Warnf("cleaning up failed attach of %s: %v", t.IQN, err)
+				continue
+			}
+		}
+		if err := h.DeleteNode(ctx, t, iface); err != nil {
+			logger.Warnf("cleaning up failed attach of %s: %v", t.IQN, err)
+		}
+	}
+}
+
+func (h *Host) login(ctx context.Context, t Target, iface string, chap *CHAP) error {
+	if chap != nil {
+		return h.LoginCHAP(ctx, t, iface, *chap)
+	}
+	if err := h.CreateNode(ctx, t, iface); err != nil {
+		return err
+	}
+	return h.Login(ctx, t, iface)
+}
+
+// Detach tears down every session to t in order: it flushes and removes the
+// multipath maps over the target's devices (and the map of opts.WWID, if any),
+// deletes the SCSI devices, then logs out and deletes the node records of the
+// sessions' ifaces and opts.Ifaces. A map that cannot be flushed, usually
+// because it is still mounted or open, stops the teardown before any path is
+// removed.
+func (h *Host) Detach(ctx context.Context, t Target, opts AttachOptions) error {
+	sessions, err := h.TargetSessions(ctx, t)
+	if err != nil {
+		return err
+	}
+	var devices []Device
+	for _, s := range sessions {
+		d, err := h.SessionDevices(s.ID)
+		if err != nil {
+			return err
+		}
+		devices = append(devices, d...)
+	}
+
+	var maps []*Multipath
+	addMap := func(m *Multipath) {
+		if m != nil && !slices.ContainsFunc(maps, func(o *Multipath) bool { return o.Name == m.Name }) {
+			maps = append(maps, m)
+		}
+	}
+	for _, d := range devices {
+		for _, m := range h.Holders(d.Name) {
+			addMap(m)
+		}
+	}
+	if opts.WWID != "" {
+		m, err := h.FindMultipath(opts.WWID)
+		if err != nil {
+			return err
+		}
+		addMap(m)
+	}
+	for _, m := range maps {
+		if err := h.FlushMultipath(ctx, m); err != nil {
+			return err
+		}
+	}
+	for _, d := range devices {
+		if err := h.DeleteDevice(ctx, d); err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+	ifaces := slices.Clone(opts.Ifaces)
+	for _, s := range sessions {
+		st := Target{Portal: s.Portal, TPGT: s.TPGT, IQN: s.IQN}
+		if err := h.Logout(ctx, st, s.Iface); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := h.DeleteNode(ctx, st, s.Iface); err != nil {
+			errs = append(errs, err)
+		}
+		ifaces = slices.DeleteFunc(ifaces, func(i string) bool { return i == s.Iface })
+	}
+	if t.Portal != "" {
+		for _, iface := range ifaces {
+			if err := h.DeleteNode(ctx, t, iface); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
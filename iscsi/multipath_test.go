@@ -0,0 +1,136 @@
+package iscsi_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/scaleoutsean/solidfire-go/iscsi"
+	"github.com/scaleoutsean/solidfire-go/iscsi/iscsitest"
+)
+
+const wwid = "6f47acc100000000616263640000000b"
+
+func newMultipathFake(t *testing.T) (*iscsitest.Fake, *iscsi.Host, iscsi.Target, iscsi.AttachOptions) {
+	t.Helper()
+	f := iscsitest.New(t.TempDir())
+	f.Multipath = true
+	f.AddTarget(iscsitest.Target{IQN: iqn, Portals: []string{portal}, CHAPUser: "tenant", CHAPSecret: "secret", WWID: wwid})
+	opts := iscsi.AttachOptions{
+		Ifaces:    []string{"iface0", "iface1"},
+		CHAP:      &iscsi.CHAP{Username: "tenant", Password: "secret"},
+		WWID:      strings.ToUpper(wwid),
+		Multipath: true,
+	}
+	return f, f.Host(), iscsi.Target{Portal: portal, IQN: iqn}, opts
+}
+
+func TestMultipathAttachDetach(t *testing.T) {
+	f, h, target, opts := newMultipathFake(t)
+	ctx := context.Background()
+
+	a, err := h.Attach(ctx, target, opts)
+	if err != nil {
+		t.Fatalf("Attach: %v", err)
+	}
+	if len(a.Paths) != 2 || a.Paths[0].Name != "sdb" || a.Paths[1].Name != "sdc" {
+		t.Fatalf("unexpected paths %+v", a.Paths)
+	}
+	if a.DevicePath() != "/dev/dm-0" || a.Multipath.Map != "3"+wwid || !slices.Equal(a.Multipath.Slaves, []string{"sdb", "sdc"}) {
+		t.Fatalf("unexpected multipath device %+v", a.Multipath)
+	}
+	if h.DeviceWWID("sdb") != wwid {
+		t.Fatalf("DeviceWWID: %q", h.DeviceWWID("sdb"))
+	}
+
+	again, err := h.Attach(ctx, target, opts)
+	if err != nil || again.DevicePath() != a.DevicePath() || f.SessionCount() != 2 {
+		t.Fatalf("repeated Attach: %v %+v, %d sessions", err, again, f.SessionCount())
+	}
+
+	if err := h.Detach(ctx, target, opts); err != nil {
+		t.Fatalf("Detach: %v", err)
+	}
+	want := []string{
+		"login " + iqn + " 10.10.0.1:3260 iface0",
+		"map 3" + wwid + " dm-0",
+		"login " + iqn + " 10.10.0.1:3260 iface1",
+		"flush 3" + wwid,
+		"delete sdb",
+		"delete sdc",
+		"logout " + iqn + " 10.10.0.1:3260 iface0",
+		"logout " + iqn + " 10.10.0.1:3260 iface1",
+	}
+	if got := f.Events(); !slices.Equal(got, want) {
+		t.Fatalf("teardown order:\n got %q\nwant %q", got, want)
+	}
+	if f.SessionCount() != 0 || f.MapCount() != 0 || f.Record(iqn, portal, "iface0") != nil {
+		t.Fatalf("state left after Detach: %d sessions, %d maps", f.SessionCount(), f.MapCount())
+	}
+	if err := h.Detach(ctx, target, opts); err != nil {
+		t.Fatalf("Detach without sessions: %v", err)
+	}
+}
+
+func TestDetachStopsWhenMapIsBusy(t *testing.T) {
+	f, h, target, opts := newMultipathFake(t)
+	ctx := context.Background()
+	if _, err := h.Attach(ctx, target, opts); err != nil {
+		t.Fatal(err)
+	}
+	f.Fail("multipath -f", 1)
+	if err := h.Detach(ctx, target, opts); err == nil {
+		t.Fatal("expected Detach to fail")
+	}
+	if f.SessionCount() != 2 || f.MapCount() != 1 || slices.ContainsFunc(f.Events(), func(e string) bool { return strings.HasPrefix(e, "delete") }) {
+		t.Fatalf("paths removed under a busy map: %q", f.Events())
+	}
+	if err := h.Detach(ctx, target, opts); err != nil || f.SessionCount() != 0 {
+		t.Fatalf("retried Detach: %v", err)
+	}
+}
+
+func TestFailedAttachKeepsExistingPaths(t *testing.T) {
+	f, h, target, opts := newMultipathFake(t)
+	ctx := context.Background()
+	opts.Ifaces = []string{"iface0"}
+	if _, err := h.Attach(ctx, target, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	// iface1 logs in, iface2 does not.
+	opts.Ifaces = []string{"iface0", "iface1", "iface2"}
+	f.Fail("-I iface2 --login", 1)
+	if _, err := h.Attach(ctx, target, opts); err == nil {
+		t.Fatal("expected Attach to fail")
+	}
+	want := []string{
+		"login " + iqn + " 10.10.0.1:3260 iface0",
+		"map 3" + wwid + " dm-0",
+		"login " + iqn + " 10.10.0.1:3260 iface1",
+		"delete sdc",
+		"logout " + iqn + " 10.10.0.1:3260 iface1",
+	}
+	if got := f.Events(); !slices.Equal(got, want) {
+		t.Fatalf("cleanup of the failed attach:\n got %q\nwant %q", got, want)
+	}
+	if f.SessionCount() != 1 || f.MapCount() != 1 || f.Record(iqn, portal, "iface0") == nil {
+		t.Fatalf("existing path removed: %d sessions, %d maps", f.SessionCount(), f.MapCount())
+	}
+	if f.Record(iqn, portal, "iface1") != nil || f.Record(iqn, portal, "iface2") != nil {
+		t.Fatal("node records of the failed attach left behind")
+	}
+}
+
+func TestAttachChecksWWID(t *testing.T) {
+	_, h, target, opts := newMultipathFake(t)
+	opts.WWID = "6f47acc1000000006162636400000099"
+	if _, err := h.Attach(context.Background(), target, opts); err == nil || !strings.Contains(err.Error(), "expected") {
+		t.Fatalf("expected a WWID mismatch, got %v", err)
+	}
+	opts.WWID = ""
+	if _, err := h.Attach(context.Background(), target, opts); err == nil {
+		t.Fatal("expected multipath without a WWID to be refused")
+	}
+}
@@ -0,0 +1,236 @@
+package iscsi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func (h *Host) nodeDir() string {
+	if h.NodeDir != "" {
+		return h.path(h.NodeDir)
+	}
+	for _, dir := range []string{"/etc/iscsi/nodes", "/var/lib/iscsi/nodes"} {
+		if _, err := os.Stat(h.path(dir)); err == nil {
+			return h.path(dir)
+		}
+	}
+	return h.path("/etc/iscsi/nodes")
+}
+
+// recordPath finds the node record file of t. open-iscsi stores records as
+// <nodes>/<iqn>/<address>,<port>,<tpgt>/<iface>; older versions keep the record of
+// the default iface in a plain file named <address>,<port>,<tpgt>.
+func (h *Host) recordPath(t Target, iface string) (string, error) {
+	dir, prefix, err := h.recordDir(t)
+	if err != nil {
+		return "", err
+	}
+	// Glob skips directories it cannot read, which would hide the record from
+	// callers without access to the node database.
+	if _, err := os.ReadDir(dir); errors.Is(err, fs.ErrPermission) {
+		return "", err
+	}
+	matches, err := filepath.Glob(filepath.Join(dir, prefix+"*"))
+	if err != nil {
+		return "", err
+	}
+	var entries []recordEntry
+	for _, m := range matches {
+		fi, err := os.Stat(m)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, recordEntry{m, fi.IsDir()})
+	}
+	return pickRecord(t, iface, entries, fileExists)
+}
+
+// recordDir returns the directory holding the records of t and the prefix of the
+// names of its records at t's portal.
+func (h *Host) recordDir(t Target) (string, string, error) {
+	host, port, err := net.SplitHostPort(NormalizePortal(t.Portal))
+	if err != nil {
+		return "", "", err
+	}
+	return filepath.Join(h.nodeDir(), t.IQN), host + "," + port + ",", nil
+}
+
+// recordEntry is a file or directory named after a target portal.
+type recordEntry struct {
+	path string
+	dir  bool
+}
+
+// pickRecord returns the record of iface among entries. hasFile reports whether
+// an iface record exists in a directory entry.
+func pickRecord(t Target, iface string, entries []recordEntry, hasFile func(string) bool) (string, error) {
+	if iface == "" {
+		iface = "default"
+	}
+	for _, e := range entries {
+		if !e.dir {
+			if iface == "default" {
+				return e.path, nil
+			}
+			continue
+		}
+		if p := filepath.Join(e.path, iface); hasFile(p) {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("%w for %s at %s on iface %s", ErrNoNodeRecord, t.IQN, t.Portal, iface)
+}
+
+func fileExists(p string) bool {
+	fi, err := os.Stat(p)
+	return err == nil && !fi.IsDir()
+}
+
+// writeRecord sets values in the node record file of t, replacing existing keys
+// and adding missing ones before "# END RECORD". The file is replaced atomically
+// and keeps its permissions. Without access to the node database, the record is
+// rewritten through the executor instead; see writeRecordExec.
+func (h *Host) writeRecord(ctx context.Context, t Target, iface string, values map[string]string) error {
+	err := h.writeRecordFile(t, iface, values)
+	if errors.Is(err, fs.ErrPermission) {
+		return h.writeRecordExec(ctx, t, iface, values)
+	}
+	return err
+}
+
+func (h *Host) writeRecordFile(t Target, iface string, values map[string]string) error {
+	path, err := h.recordPath(t, iface)
+	if err != nil {
+		return err
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading node record: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".record-*")
+	if err != nil {
+		return fmt.Errorf("writing node record: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(fi.Mode().Perm() & 0o600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing node record: %w", err)
+	}
+	if _, err := tmp.Write(mergeRecord(data, values)); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing node record: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing node record: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("writing node record: %w", err)
+	}
+	return nil
+}
+
+// listRecordsScript prints "d <path>" for each directory and "f <path>" for each
+// file named $2* in $1, and "f <path>" for the files in those directories.
+const listRecordsScript = `for p in "$1"/"$2"*; do
+	if [ -d "$p" ]; then
+		echo "d $p"
+		for q in "$p"/*; do [ -f "$q" ] && echo "f $q"; done
+	elif [ -f "$p" ]; then
+		echo "f $p"
+	fi
+done
+true`
+
+// replaceScript replaces the file $1 with stdin through a private temporary file
+// next to it.
+const replaceScript = `set -e
+t=$(mktemp "$1.XXXXXX")
+trap 'rm -f "$t"' EXIT
+cat > "$t"
+mv -f "$t" "$1"`
+
+// writeRecordExec is writeRecord for callers that cannot read the node database,
+// such as a non-root process using NewOSExecutor. It finds and reads the record
+// through the executor, which uses sudo when needed, and hands the new record to a
+// root helper on stdin, so the secrets stay off the command line. The executor
+// must implement InputExecutor.
+func (h *Host) writeRecordExec(ctx context.Context, t Target, iface string, values map[string]string) error {
+	ex, ok := h.exec().(InputExecutor)
+	if !ok {
+		return fmt.Errorf("writing node record: %w, and the executor cannot pass it on stdin", fs.ErrPermission)
+	}
+	dir, prefix, err := h.recordDir(t)
+	if err != nil {
+		return err
+	}
+	out, err := ex.Run(ctx, "sh", "-c", listRecordsScript, "sh", dir, prefix)
+	if err != nil {
+		return fmt.Errorf("listing node records: %w", err)
+	}
+	var entries []recordEntry
+	files := map[string]bool{}
+	for _, line := range strings.Split(string(out), "\n") {
+		kind, p, ok := strings.Cut(line, " ")
+		switch {
+		case !ok:
+		case kind == "d":
+			entries = append(entries, recordEntry{p, true})
+		case filepath.Dir(p) == dir:
+			entries = append(entries, recordEntry{p, false})
+		default:
+			files[p] = true
+		}
+	}
+	path, err := pickRecord(t, iface, entries, func(p string) bool { return files[p] })
+	if err != nil {
+		return err
+	}
+
+	data, err := ex.Run(ctx, "cat", path)
+	if err != nil {
+		return fmt.Errorf("reading node record: %w", err)
+	}
+	if _, err := ex.RunInput(ctx, mergeRecord(data, values), "sh", "-c", replaceScript, "sh", path); err != nil {
+		return fmt.Errorf("writing node record: %w", err)
+	}
+	return nil
+}
+
+// mergeRecord returns the record data with values set.
+func mergeRecord(data []byte, values map[string]string) []byte {
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	done := map[string]bool{}
+	for i, line := range lines {
+		k, _, ok := strings.Cut(line, "=")
+		k = strings.TrimSpace(k)
+		if v, want := values[k]; ok && want {
+			lines[i] = k + " = " + v
+			done[k] = true
+		}
+	}
+	var missing []string
+	for k, v := range values {
+		if !done[k] {
+			missing = append(missing, k+" = "+v)
+		}
+	}
+	sort.Strings(missing)
+	end := len(lines)
+	if end > 0 && strings.HasPrefix(lines[end-1], "# END RECORD") {
+		end--
+	}
+	lines = append(lines[:end], append(missing, lines[end:]...)...)
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
@@ -0,0 +1,89 @@
+package iscsi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// rootHelper is an executor for a caller without access to the node database. It
+// runs commands locally, as sudo would, and records them with their input.
+type rootHelper struct {
+	calls  []string
+	inputs [][]byte
+}
+
+func (r *rootHelper) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	return r.RunInput(ctx, nil, name, args...)
+}
+
+func (r *rootHelper) RunInput(ctx context.Context, input []byte, name string, args ...string) ([]byte, error) {
+	r.calls = append(r.calls, strings.Join(append([]string{name}, args...), " "))
+	r.inputs = append(r.inputs, input)
+	return OSExecutor{}.RunInput(ctx, input, name, args...)
+}
+
+func TestWriteRecordThroughExecutor(t *testing.T) {
+	const iqn = "iqn.2010-01.com.solidfire:abcd.pvc-1.7"
+	root := t.TempDir()
+	dir := filepath.Join(root, "etc/iscsi/nodes", iqn, "10.10.0.1,3260,1")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	record := "node.name = " + iqn + "\nnode.session.auth.authmethod = CHAP\nnode.session.auth.password = old\n# END RECORD\n"
+	for _, iface := range []string{"default", "iface0"} {
+		if err := os.WriteFile(filepath.Join(dir, iface), []byte(record), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ex := &rootHelper{}
+	h := &Host{Exec: ex, Root: root}
+	target := Target{Portal: "10.10.0.1", IQN: iqn}
+	secrets := map[string]string{"node.session.auth.password": "s3cr3t", "node.session.auth.password_in": "t4rget"}
+	if err := h.writeRecordExec(context.Background(), target, "iface0", secrets); err != nil {
+		t.Fatalf("writeRecordExec: %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "iface0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "node.name = " + iqn + "\nnode.session.auth.authmethod = CHAP\nnode.session.auth.password = s3cr3t\nnode.session.auth.password_in = t4rget\n# END RECORD\n"
+	if string(got) != want {
+		t.Fatalf("record is\n%s\nwant\n%s", got, want)
+	}
+	if other, _ := os.ReadFile(filepath.Join(dir, "default")); string(other) != record {
+		t.Fatalf("record of another iface changed:\n%s", other)
+	}
+	if fi, err := os.Stat(filepath.Join(dir, "iface0")); err != nil || fi.Mode().Perm() != 0o600 {
+		t.Fatalf("record mode: %v %v", fi.Mode(), err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 2 {
+		t.Fatalf("temporary files left: %v", entries)
+	}
+	for i, call := range ex.calls {
+		if strings.Contains(call, "s3cr3t") || strings.Contains(call, "t4rget") {
+			t.Fatalf("secret passed on the command line: %s", call)
+		}
+		if i == len(ex.calls)-1 && !bytes.Contains(ex.inputs[i], []byte("s3cr3t")) {
+			t.Fatalf("last call %q did not get the record on stdin", call)
+		}
+	}
+}
+
+func TestWriteRecordThroughExecutorNeedsInput(t *testing.T) {
+	h := &Host{Exec: ExecutorFunc(func(context.Context, string, ...string) ([]byte, error) {
+		t.Fatal("executor called")
+		return nil, nil
+	}), Root: t.TempDir()}
+	err := h.writeRecordExec(context.Background(), Target{Portal: "10.10.0.1", IQN: "iqn.x"}, "", map[string]string{"node.session.auth.password": "x"})
+	if !errors.Is(err, fs.ErrPermission) {
+		t.Fatalf("expected a permission error, got %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package iscsi
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Device is a SCSI block device of an iSCSI session.
+type Device struct {
+	// Name is the kernel name, such as "sdb"; Path is "/dev/" + Name.
+	Name string
+	Path string
+	// HCTL is the SCSI address "host:channel:target:lun".
+	HCTL      string
+	LUN       int
+	SessionID int
+}
+
+// SessionDevices returns the block devices of session sid, read from
+// /sys/class/iscsi_session/session<sid>/device/target*/<hctl>/block.
+func (h *Host) SessionDevices(sid int) ([]Device, error) {
+	pattern := h.path(fmt.Sprintf("/sys/class/iscsi_session/session%d/device/target*/*:*:*:*/block/*", sid))
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var devices []Device
+	for _, m := range matches {
+		name := filepath.Base(m)
+		hctl := filepath.Base(filepath.Dir(filepath.Dir(m)))
+		parts := strings.Split(hctl, ":")
+		lun, err := strconv.Atoi(parts[len(parts)-1])
+		if err != nil {
+			continue
+		}
+		devices = append(devices, Device{Name: name, Path: "/dev/" + name, HCTL: hctl, LUN: lun, SessionID: sid})
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i].HCTL < devices[j].HCTL })
+	return devices, nil
+}
+
+// Devices returns the devices for lun on every session to t (see TargetSessions).
+func (h *Host) Devices(ctx context.Context, t Target, lun int) ([]Device, error) {
+	sessions, err := h.TargetSessions(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	var out []Device
+	for _, s := range sessions {
+		devices, err := h.SessionDevices(s.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range devices {
+			if d.LUN == lun {
+				out = append(out, d)
+			}
+		}
+	}
+	return out, nil
+}
+
+// WaitForDevice waits until lun of t shows up as a block device on a session
+// through iface ("" for any), or ctx is done.
+func (h *Host) WaitForDevice(ctx context.Context, t Target, iface string, lun int) (Device, error) {
+	for {
+		sessions, err := h.TargetSessions(ctx, t)
+		if err != nil {
+			return Device{}, err
+		}
+		for _, s := range sessions {
+			if iface != "" && s.Iface != "" && s.Iface != iface {
+				continue
+			}
+			devices, err := h.SessionDevices(s.ID)
+			if err != nil {
+				return Device{}, err
+			}
+			for _, d := range devices {
+				if d.LUN == lun {
+					return d, nil
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return Device{}, fmt.Errorf("waiting for LUN %d of %s: %w", lun, t.IQN, ctx.Err())
+		case <-time.After(h.pollInterval()):
+		}
+	}
+}
+
+// ResolveDevicePath follows a /dev/disk/by-path (or any other) symlink to the
+// device node it points at.
+func (h *Host) ResolveDevicePath(p string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(h.path(p))
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(resolved); err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(h.path("/"), resolved)
+	if err != nil {
+		return "", err
+	}
+	return "/" + rel, nil
+}
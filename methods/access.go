@@ -0,0 +1,339 @@
+package cloudops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+// AccessGroupHostAttribute is set to the host name on the volume access groups
+// EnsureHostAccessGroup creates. DeleteEmptyAccessGroups only removes groups
+// that carry it.
+const AccessGroupHostAttribute = "access-group-host"
+
+// limits returns the cluster limits loaded at start, fetching them if that failed.
+func (c *Client) limits(ctx context.Context) (*sdk.GetLimitsResult, error) {
+	if c.Limits != nil {
+		return c.Limits, nil
+	}
+	limits, err := c.SFClient.GetLimits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster limits: %w", err)
+	}
+	c.Limits = limits
+	return limits, nil
+}
+
+func (c *Client) getAccessGroup(ctx context.Context, vagID int64) (*sdk.VolumeAccessGroup, error) {
+	res, err := c.SFClient.ListVolumeAccessGroups(ctx, &sdk.ListVolumeAccessGroupsRequest{VolumeAccessGroups: []int64{vagID}})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.VolumeAccessGroups) == 0 {
+		return nil, fmt.Errorf("volume access group %d not found", vagID)
+	}
+	return &res.VolumeAccessGroups[0], nil
+}
+
+// GetHostAccessGroup returns the volume access group named host, or nil if there
+// is none. Names are not unique on the cluster, so more than one group with the
+// name is an error rather than a guess.
+func (c *Client) GetHostAccessGroup(host string) (*sdk.VolumeAccessGroup, error) {
+	res, err := c.SFClient.ListVolumeAccessGroups(context.Background(), &sdk.ListVolumeAccessGroupsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return findAccessGroup(res.VolumeAccessGroups, host)
+}
+
+func findAccessGroup(vags []sdk.VolumeAccessGroup, name string) (*sdk.VolumeAccessGroup, error) {
+	var found *sdk.VolumeAccessGroup
+	for i := range vags {
+		if vags[i].Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("more than one volume access group is named %q (%d and %d)", name, found.VolumeAccessGroupID, vags[i].VolumeAccessGroupID)
+		}
+		found = &vags[i]
+	}
+	return found, nil
+}
+
+// EnsureHostAccessGroup returns the volume access group named host, creating it
+// if needed, with initiators registered in it. host defaults to os.Hostname() and
+// initiators to the IQNs in /etc/iscsi/initiatorname.iscsi. Initiators already in
+// the group stay; other initiators are created with host as their alias. It fails
+// before changing anything if the group, or an initiator, would go over the
+// cluster's limits.
+func (c *Client) EnsureHostAccessGroup(host string, initiators ...string) (*sdk.VolumeAccessGroup, error) {
+	ctx := context.Background()
+	if host == "" {
+		name, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		host = name
+	}
+	if len(initiators) == 0 {
+		iqns, err := c.host().InitiatorNames()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read initiator names: %w", err)
+		}
+		if len(iqns) == 0 {
+			return nil, errors.New("no initiator name found in /etc/iscsi/initiatorname.iscsi")
+		}
+		initiators = iqns
+	}
+	var iqns []string
+	for _, iqn := range initiators {
+		// The cluster stores initiator names in lower case.
+		if iqn = strings.ToLower(iqn); !slices.Contains(iqns, iqn) {
+			iqns = append(iqns, iqn)
+		}
+	}
+
+	limits, err := c.limits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n := int64(len(host)); n < limits.VolumeAccessGroupNameLengthMin || n > limits.VolumeAccessGroupNameLengthMax {
+		return nil, fmt.Errorf("volume access group name %q must be %d to %d characters", host, limits.VolumeAccessGroupNameLengthMin, limits.VolumeAccessGroupNameLengthMax)
+	}
+	list, sdkErr := c.SFClient.ListVolumeAccessGroups(ctx, &sdk.ListVolumeAccessGroupsRequest{})
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	vag, err := findAccessGroup(list.VolumeAccessGroups, host)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := iqns
+	var vagID int64
+	if vag != nil {
+		vagID = vag.VolumeAccessGroupID
+		missing = slices.DeleteFunc(slices.Clone(iqns), func(iqn string) bool { return slices.Contains(vag.Initiators, iqn) })
+		if len(missing) == 0 {
+			return vag, nil
+		}
+		if n := int64(len(vag.Initiators) + len(missing)); n > limits.InitiatorsPerVolumeAccessGroupCountMax {
+			return nil, fmt.Errorf("volume access group %q would have %d initiators, the limit is %d", host, n, limits.InitiatorsPerVolumeAccessGroupCountMax)
+		}
+	} else {
+		if int64(len(list.VolumeAccessGroups)) >= limits.VolumeAccessGroupCountMax {
+			return nil, fmt.Errorf("the cluster has the maximum of %d volume access groups", limits.VolumeAccessGroupCountMax)
+		}
+		if n := int64(len(missing)); n > limits.InitiatorsPerVolumeAccessGroupCountMax {
+			return nil, fmt.Errorf("volume access group %q would have %d initiators, the limit is %d", host, n, limits.InitiatorsPerVolumeAccessGroupCountMax)
+		}
+	}
+	if err := c.createInitiators(ctx, host, vagID, missing, limits); err != nil {
+		return nil, err
+	}
+
+	if vag == nil {
+		res, err := c.SFClient.CreateVolumeAccessGroup(ctx, &sdk.CreateVolumeAccessGroupRequest{
+			Name:       host,
+			Initiators: missing,
+			Attributes: map[string]interface{}{AccessGroupHostAttribute: host},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &res.VolumeAccessGroup, nil
+	}
+	res, sdkErr := c.SFClient.AddInitiatorsToVolumeAccessGroup(ctx, &sdk.AddInitiatorsToVolumeAccessGroupRequest{
+		VolumeAccessGroupID: vagID,
+		Initiators:          missing,
+	})
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	return &res.VolumeAccessGroup, nil
+}
+
+// createInitiators creates the initiator objects in iqns that do not exist yet,
+// and checks that the existing ones can join one more volume access group.
+func (c *Client) createInitiators(ctx context.Context, host string, vagID int64, iqns []string, limits *sdk.GetLimitsResult) error {
+	res, err := c.SFClient.ListInitiators(ctx, &sdk.ListInitiatorsRequest{})
+	if err != nil {
+		return err
+	}
+	var create []sdk.CreateInitiator
+	for _, iqn := range iqns {
+		i := slices.IndexFunc(res.Initiators, func(init sdk.Initiator) bool { return strings.EqualFold(init.InitiatorName, iqn) })
+		if i < 0 {
+			create = append(create, sdk.CreateInitiator{Name: iqn, Alias: host})
+			continue
+		}
+		groups := slices.DeleteFunc(slices.Clone(res.Initiators[i].VolumeAccessGroups), func(id int64) bool { return id == vagID })
+		if int64(len(groups)) >= limits.VolumeAccessGroupsPerInitiatorCountMax {
+			return fmt.Errorf("initiator %s is already in volume access group(s) %v, the limit is %d", iqn, groups, limits.VolumeAccessGroupsPerInitiatorCountMax)
+		}
+	}
+	if len(create) == 0 {
+		return nil
+	}
+	if _, err := c.SFClient.CreateInitiators(ctx, &sdk.CreateInitiatorsRequest{Initiators: create}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MapVolume adds the volume to the volume access group and returns its LUN. With
+// lun >= 0 the volume is given that LUN, otherwise the cluster picks the lowest
+// free one. The cluster keeps a volume's LUN while it stays in the group, so a
+// mapped volume keeps its LUN unless a different lun is asked for. It fails
+// before changing anything if the LUN is taken or out of range, or if the group
+// or the volume would go over the cluster's limits.
+func (c *Client) MapVolume(vagID, volumeID, lun int64) (int64, error) {
+	ctx := context.Background()
+	v, err := c.GetVolume(volumeID)
+	if err != nil {
+		return 0, err
+	}
+	limits, err := c.limits(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if lun > limits.VolumeAccessGroupLunMax {
+		return 0, fmt.Errorf("LUN %d is out of range, the limit is %d", lun, limits.VolumeAccessGroupLunMax)
+	}
+	vag, err := c.getAccessGroup(ctx, vagID)
+	if err != nil {
+		return 0, err
+	}
+	assigned, err := c.lunAssignments(ctx, vagID)
+	if err != nil {
+		return 0, err
+	}
+	if lun >= 0 {
+		for id, l := range assigned {
+			if l == lun && id != volumeID {
+				return 0, fmt.Errorf("LUN %d of volume access group %d is taken by volume %d", lun, vagID, id)
+			}
+		}
+	}
+
+	current, mapped := assigned[volumeID]
+	if !mapped {
+		if n := int64(len(vag.Volumes)); n >= limits.VolumesPerVolumeAccessGroupCountMax {
+			return 0, fmt.Errorf("volume access group %d has the maximum of %d volumes", vagID, limits.VolumesPerVolumeAccessGroupCountMax)
+		}
+		if n := int64(len(v.VolumeAccessGroups)); n >= limits.VolumeAccessGroupsPerVolumeCountMax {
+			return 0, fmt.Errorf("volume %d is in the maximum of %d volume access groups", volumeID, limits.VolumeAccessGroupsPerVolumeCountMax)
+		}
+		req := sdk.AddVolumesToVolumeAccessGroupRequest{VolumeAccessGroupID: vagID, Volumes: []int64{volumeID}}
+		if _, err := c.SFClient.AddVolumesToVolumeAccessGroup(ctx, &req); err != nil {
+			return 0, err
+		}
+		if assigned, err = c.lunAssignments(ctx, vagID); err != nil {
+			return 0, err
+		}
+		current = assigned[volumeID]
+	}
+	if lun < 0 || lun == current {
+		return current, nil
+	}
+	req := sdk.ModifyVolumeAccessGroupLunAssignmentsRequest{
+		VolumeAccessGroupID: vagID,
+		LunAssignments:      []sdk.LunAssignment{{VolumeID: volumeID, Lun: lun}},
+	}
+	if _, err := c.SFClient.ModifyVolumeAccessGroupLunAssignments(ctx, &req); err != nil {
+		return 0, err
+	}
+	return lun, nil
+}
+
+func (c *Client) lunAssignments(ctx context.Context, vagID int64) (map[int64]int64, error) {
+	res, err := c.SFClient.GetVolumeAccessGroupLunAssignments(ctx, &sdk.GetVolumeAccessGroupLunAssignmentsRequest{VolumeAccessGroupID: vagID})
+	if err != nil {
+		return nil, err
+	}
+	luns := map[int64]int64{}
+	for _, a := range res.VolumeAccessGroupLunAssignments.LunAssignments {
+		luns[a.VolumeID] = a.Lun
+	}
+	for _, a := range res.VolumeAccessGroupLunAssignments.DeletedLunAssignments {
+		luns[a.VolumeID] = a.Lun
+	}
+	return luns, nil
+}
+
+// UnmapVolume removes the volume from the volume access group. A volume that is
+// not in the group is not an error.
+func (c *Client) UnmapVolume(vagID, volumeID int64) error {
+	ctx := context.Background()
+	vag, err := c.getAccessGroup(ctx, vagID)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(vag.Volumes, volumeID) && !slices.Contains(vag.DeletedVolumes, volumeID) {
+		return nil
+	}
+	req := sdk.RemoveVolumesFromVolumeAccessGroupRequest{VolumeAccessGroupID: vagID, Volumes: []int64{volumeID}}
+	if _, err := c.SFClient.RemoveVolumesFromVolumeAccessGroup(ctx, &req); err != nil {
+		if sdk.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteAccessGroupIfEmpty deletes the volume access group, and the initiators
+// left in no other group, if it has no volumes, including deleted volumes not yet
+// purged. It reports whether the group is gone.
+func (c *Client) DeleteAccessGroupIfEmpty(vagID int64) (bool, error) {
+	ctx := context.Background()
+	res, err := c.SFClient.ListVolumeAccessGroups(ctx, &sdk.ListVolumeAccessGroupsRequest{VolumeAccessGroups: []int64{vagID}})
+	if err != nil {
+		return false, err
+	}
+	if len(res.VolumeAccessGroups) == 0 {
+		return true, nil
+	}
+	return c.deleteIfEmpty(ctx, &res.VolumeAccessGroups[0])
+}
+
+func (c *Client) deleteIfEmpty(ctx context.Context, vag *sdk.VolumeAccessGroup) (bool, error) {
+	if len(vag.Volumes) > 0 || len(vag.DeletedVolumes) > 0 {
+		return false, nil
+	}
+	req := sdk.DeleteVolumeAccessGroupRequest{VolumeAccessGroupID: vag.VolumeAccessGroupID, DeleteOrphanInitiators: true}
+	if _, err := c.SFClient.DeleteVolumeAccessGroup(ctx, &req); err != nil && !sdk.IsNotFound(err) {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteEmptyAccessGroups deletes the empty volume access groups created by
+// EnsureHostAccessGroup (those with AccessGroupHostAttribute) and returns their IDs.
+func (c *Client) DeleteEmptyAccessGroups() ([]int64, error) {
+	ctx := context.Background()
+	res, err := c.SFClient.ListVolumeAccessGroups(ctx, &sdk.ListVolumeAccessGroupsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var deleted []int64
+	for i := range res.VolumeAccessGroups {
+		vag := &res.VolumeAccessGroups[i]
+		if attrs, ok := vag.Attributes.(map[string]interface{}); !ok || attrs[AccessGroupHostAttribute] == nil {
+			continue
+		}
+		gone, err := c.deleteIfEmpty(ctx, vag)
+		if err != nil {
+			return deleted, err
+		}
+		if gone {
+			deleted = append(deleted, vag.VolumeAccessGroupID)
+		}
+	}
+	return deleted, nil
+}
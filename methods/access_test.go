@@ -0,0 +1,117 @@
+package cloudops
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/scaleoutsean/solidfire-go/iscsi/iscsitest"
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func TestHostAccessGroupLifecycle(t *testing.T) {
+	c, srv := newSimClient(t)
+	c.Host = iscsitest.New(t.TempDir()).Host()
+
+	vag, err := c.EnsureHostAccessGroup("host1")
+	if err != nil {
+		t.Fatalf("EnsureHostAccessGroup: %v", err)
+	}
+	if !slices.Equal(vag.Initiators, []string{iscsitest.DefaultInitiatorName}) {
+		t.Fatalf("initiators not registered: %v", vag.Initiators)
+	}
+	again, err := c.EnsureHostAccessGroup("host1", iscsitest.DefaultInitiatorName, "IQN.1993-08.org.debian:01:Second")
+	if err != nil || again.VolumeAccessGroupID != vag.VolumeAccessGroupID || srv.CallCount("CreateVolumeAccessGroup") != 1 {
+		t.Fatalf("EnsureHostAccessGroup is not idempotent: %v %+v", err, again)
+	}
+	if !slices.Contains(again.Initiators, "iqn.1993-08.org.debian:01:second") {
+		t.Fatalf("second initiator not added: %v", again.Initiators)
+	}
+	id := vag.VolumeAccessGroupID
+
+	var vols []int64
+	for _, name := range []string{"a", "b"} {
+		v, err := c.GetCreateVolume(sdk.CreateVolumeRequest{Name: name, AccountID: c.AccountID, TotalSize: GiB})
+		if err != nil {
+			t.Fatal(err)
+		}
+		vols = append(vols, v.VolumeID)
+	}
+	if lun, err := c.MapVolume(id, vols[0], -1); err != nil || lun != 0 {
+		t.Fatalf("MapVolume: %v %d", err, lun)
+	}
+	if lun, err := c.MapVolume(id, vols[1], 5); err != nil || lun != 5 {
+		t.Fatalf("MapVolume at LUN 5: %v %d", err, lun)
+	}
+	if lun, err := c.MapVolume(id, vols[1], -1); err != nil || lun != 5 {
+		t.Fatalf("remapping moved the LUN: %v %d", err, lun)
+	}
+	if _, err := c.MapVolume(id, vols[0], 5); err == nil || !strings.Contains(err.Error(), "taken") {
+		t.Fatalf("expected LUN 5 to be taken, got %v", err)
+	}
+
+	if err := c.UnmapVolume(id, vols[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.UnmapVolume(id, vols[0]); err != nil {
+		t.Fatalf("unmapping twice: %v", err)
+	}
+	if gone, err := c.DeleteAccessGroupIfEmpty(id); err != nil || gone {
+		t.Fatalf("deleted a group with volumes: %v", err)
+	}
+	if err := c.UnmapVolume(id, vols[1]); err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := c.DeleteEmptyAccessGroups()
+	if err != nil || !slices.Equal(deleted, []int64{id}) {
+		t.Fatalf("DeleteEmptyAccessGroups: %v %v", err, deleted)
+	}
+	if vag, err := c.GetHostAccessGroup("host1"); err != nil || vag != nil {
+		t.Fatalf("group still there: %v %+v", err, vag)
+	}
+}
+
+func TestAccessGroupLimits(t *testing.T) {
+	c, srv := newSimClient(t)
+	c.Host = iscsitest.New(t.TempDir()).Host()
+
+	vag, err := c.EnsureHostAccessGroup("host1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The simulator, like the cluster, allows an initiator in one group only.
+	if _, err := c.EnsureHostAccessGroup("host2"); err == nil || srv.CallCount("CreateVolumeAccessGroup") != 1 {
+		t.Fatalf("expected the initiator limit to stop host2, got %v", err)
+	}
+
+	c.Limits.VolumesPerVolumeAccessGroupCountMax = 1
+	for i, name := range []string{"a", "b"} {
+		v, err := c.GetCreateVolume(sdk.CreateVolumeRequest{Name: name, AccountID: c.AccountID, TotalSize: GiB})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = c.MapVolume(vag.VolumeAccessGroupID, v.VolumeID, -1)
+		if i == 0 && err != nil {
+			t.Fatal(err)
+		}
+		if i == 1 && (err == nil || srv.CallCount("AddVolumesToVolumeAccessGroup") != 1) {
+			t.Fatalf("expected the volume limit to stop the second mapping, got %v", err)
+		}
+	}
+	if _, err := c.MapVolume(vag.VolumeAccessGroupID, 1, c.Limits.VolumeAccessGroupLunMax+1); err == nil {
+		t.Fatal("expected an out of range LUN to be refused")
+	}
+}
+
+func TestGetHostAccessGroupDuplicateNames(t *testing.T) {
+	c, _ := newSimClient(t)
+	for range 2 {
+		if _, err := c.SFClient.CreateVolumeAccessGroup(context.Background(), &sdk.CreateVolumeAccessGroupRequest{Name: "dup"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := c.GetHostAccessGroup("dup"); err == nil {
+		t.Fatal("expected duplicate names to be an error")
+	}
+}
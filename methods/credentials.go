@@ -0,0 +1,56 @@
+package cloudops
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+// CredentialsConfig selects where the cluster admin credentials come from, so they
+// do not have to be embedded in the endpoint URL.
+type CredentialsConfig struct {
+	// Source is one of "env", "file", "secretdir", "netrc" or "exec".
+	Source string `yaml:"source"`
+	// UsernameEnv and PasswordEnv name the variables for "env". They default to
+	// SOLIDFIRE_USERNAME and SOLIDFIRE_PASSWORD.
+	UsernameEnv string `yaml:"usernameenv"`
+	PasswordEnv string `yaml:"passwordenv"`
+	// UsernameFile and PasswordFile are read for "file".
+	UsernameFile string `yaml:"usernamefile"`
+	PasswordFile string `yaml:"passwordfile"`
+	// SecretDir holds "username" and "password" files for "secretdir".
+	SecretDir string `yaml:"secretdir"`
+	// NetrcFile is read for "netrc"; empty means ~/.netrc. The entry is looked up by MVIP host.
+	NetrcFile string `yaml:"netrcfile"`
+	// Command, Args and TTL configure "exec"; see sdk.ExecCredentials.
+	Command string        `yaml:"command"`
+	Args    []string      `yaml:"args"`
+	TTL     time.Duration `yaml:"ttl"`
+}
+
+// Provider builds the credential provider described by cc for the MVIP host.
+func (cc *CredentialsConfig) Provider(host string) (sdk.CredentialProvider, error) {
+	switch cc.Source {
+	case "env":
+		return sdk.EnvCredentials(cc.UsernameEnv, cc.PasswordEnv), nil
+	case "file":
+		if cc.UsernameFile == "" || cc.PasswordFile == "" {
+			return nil, fmt.Errorf("credentials source file needs usernamefile and passwordfile")
+		}
+		return sdk.FileCredentials(cc.UsernameFile, cc.PasswordFile), nil
+	case "secretdir":
+		if cc.SecretDir == "" {
+			return nil, fmt.Errorf("credentials source secretdir needs secretdir")
+		}
+		return sdk.SecretDirCredentials(cc.SecretDir), nil
+	case "netrc":
+		return sdk.NetrcCredentials(cc.NetrcFile, host), nil
+	case "exec":
+		if cc.Command == "" {
+			return nil, fmt.Errorf("credentials source exec needs command")
+		}
+		return &sdk.ExecCredentials{Command: cc.Command, Args: cc.Args, TTL: cc.TTL}, nil
+	}
+	return nil, fmt.Errorf("unknown credentials source %q", cc.Source)
+}
@@ -2,11 +2,14 @@ package cloudops
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/scaleoutsean/solidfire-go/iscsi"
 	"github.com/scaleoutsean/solidfire-go/sdk"
 	"gopkg.in/yaml.v2"
 )
@@ -28,21 +31,84 @@ type Client struct {
 	TenantName        string
 	AccountID         int64
 	Limits            *sdk.GetLimitsResult
+	// CACertFile is a PEM bundle used to verify the MVIP certificate.
+	CACertFile string
+	// InsecureSkipVerify disables MVIP certificate verification. It must be set explicitly.
+	InsecureSkipVerify bool
+	// Credentials, when set, replaces the credentials embedded in Endpoint.
+	Credentials *CredentialsConfig
+	// CredentialProvider overrides both Credentials and Login/Password.
+	CredentialProvider sdk.CredentialProvider `yaml:"-"`
+	// PageSize is the number of volumes requested per list call. Default sdk.DefaultPageSize.
+	PageSize int64
+	// Host attaches volumes on this machine. Default iscsi.NewHost().
+	Host *iscsi.Host `yaml:"-"`
+	// AttachTimeout bounds ConnectVolume and DisconnectVolume. Default 30s.
+	AttachTimeout time.Duration
+	// InitiatorIfaces are the iSCSI ifaces ConnectVolume logs in through, one path
+	// each. When empty, InitiatorIface is used.
+	InitiatorIfaces []string
+	// Multipath makes ConnectVolume return the dm-multipath device of the volume.
+	Multipath bool
 }
 
+// parseEndpointString splits https://[user:pass@]host/json-rpc/<version>. The
+// credentials are optional when a credential provider supplies them.
 func parseEndpointString(ep string, c *Client) error {
 	items := strings.Split(ep, "/")
-	creds := strings.Split(items[2], "@")
-	login := strings.Split(creds[0], ":")
+	if len(items) < 5 {
+		return fmt.Errorf("invalid endpoint %q, expected https://[user:pass@]host/json-rpc/<version>", ep)
+	}
+	host := items[2]
+	if at := strings.LastIndex(host, "@"); at >= 0 {
+		login := strings.SplitN(host[:at], ":", 2)
+		c.Login = login[0]
+		if len(login) == 2 {
+			c.Password = login[1]
+		}
+		host = host[at+1:]
+	}
 
-	c.URL = creds[1]
-	c.Login = login[0]
-	c.Password = login[1]
+	c.URL = host
 	c.Version = items[4]
 	return nil
 
 }
 
+// connect builds the SFClient from the TLS settings on c plus any extra options and
+// verifies the endpoint with an initial API call.
+func (c *Client) connect(ctx context.Context, opts ...sdk.ClientOption) error {
+	var clientOpts []sdk.ClientOption
+	if c.CACertFile != "" {
+		clientOpts = append(clientOpts, sdk.WithCACertFile(c.CACertFile))
+	}
+	if c.InsecureSkipVerify {
+		clientOpts = append(clientOpts, sdk.WithInsecureSkipVerify())
+	}
+	provider := c.CredentialProvider
+	if provider == nil && c.Credentials != nil {
+		p, err := c.Credentials.Provider(c.URL)
+		if err != nil {
+			return err
+		}
+		provider = p
+	}
+	if provider != nil {
+		clientOpts = append(clientOpts, sdk.WithCredentialProvider(provider))
+	}
+	sf, err := sdk.NewSFClient(append(clientOpts, opts...)...)
+	if err != nil {
+		return err
+	}
+	// We want to persist the connection info we created above, otherwise ever call is prefaced with
+	// this connect routine (blek)
+	c.SFClient = sf
+	if sdkErr := sf.Connect(ctx, c.URL, c.Version, c.Login, c.Password); sdkErr != nil {
+		return sdkErr
+	}
+	return nil
+}
+
 func NewClient(c string) (*Client, error) {
 	var client Client
 
@@ -51,20 +117,13 @@ func NewClient(c string) (*Client, error) {
 		log.Printf("failure parsing supplied config yaml: %v\n", err)
 		return &client, err
 	}
-	if parseEndpointString(client.Endpoint, &client) != nil {
+	if err := parseEndpointString(client.Endpoint, &client); err != nil {
 		log.Printf("failure parsing endpoint string: %v\n", err)
 		os.Exit(1)
 	}
 
-	var sf sdk.SFClient
 	ctx := context.Background()
-	sf.Connect(ctx, client.URL, client.Version, client.Login, client.Password)
-
-	// We want to persist the connection info we created above, otherwise ever call is prefaced with
-	// this connect routine (blek)
-	client.SFClient = &sf
-
-	if err != nil {
+	if err := client.connect(ctx); err != nil {
 		log.Printf("failure verifying endpoint config while conducting initial client connection: %v\n", err)
 		os.Exit(1)
 	}
@@ -80,7 +139,9 @@ func NewClient(c string) (*Client, error) {
 	return &client, nil
 }
 
-func NewClientWithArgs(endpoint, version, tenantName string, defaultVolSize string) (*Client, error) {
+// NewClientWithArgs connects using credentials embedded in endpoint. TLS behaviour,
+// such as a CA bundle or an explicit insecure opt-in, is controlled through opts.
+func NewClientWithArgs(endpoint, version, tenantName string, defaultVolSize string, opts ...sdk.ClientOption) (*Client, error) {
 	client := &Client{
 		Endpoint:          endpoint,
 		Version:           version,
@@ -93,10 +154,10 @@ func NewClientWithArgs(endpoint, version, tenantName string, defaultVolSize stri
 		return nil, err
 	}
 
-	var sf sdk.SFClient
 	ctx := context.Background()
-	sf.Connect(ctx, client.URL, client.Version, client.Login, client.Password)
-	client.SFClient = &sf
+	if err := client.connect(ctx, opts...); err != nil {
+		return nil, err
+	}
 
 	if err := client.initAccount(ctx); err != nil {
 		return nil, err
@@ -107,7 +168,8 @@ func NewClientWithArgs(endpoint, version, tenantName string, defaultVolSize stri
 	return client, nil
 }
 
-func NewClientFromSecrets(url, user, password, version, tenantName, defaultVolSize string) (*Client, error) {
+// NewClientFromSecrets connects using explicit credentials. TLS behaviour is controlled through opts.
+func NewClientFromSecrets(url, user, password, version, tenantName, defaultVolSize string, opts ...sdk.ClientOption) (*Client, error) {
 	client := &Client{
 		URL:               url,
 		Login:             user,
@@ -116,28 +178,49 @@ func NewClientFromSecrets(url, user, password, version, tenantName, defaultVolSi
 		TenantName:        tenantName,
 		DefaultVolumeSize: defaultVolSize,
 	}
+	if err := client.start(context.Background(), opts...); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
 
-	var sf sdk.SFClient
-	ctx := context.Background()
-	sf.Connect(ctx, client.URL, client.Version, client.Login, client.Password)
-	client.SFClient = &sf
-
-	if err := client.initAccount(ctx); err != nil {
+// NewClientWithProvider connects to the MVIP at url, resolving the admin credentials from
+// provider on every request, so rotated secrets are used without restarting the process.
+func NewClientWithProvider(url, version, tenantName, defaultVolSize string, provider sdk.CredentialProvider, opts ...sdk.ClientOption) (*Client, error) {
+	client := &Client{
+		URL:                url,
+		Version:            version,
+		TenantName:         tenantName,
+		DefaultVolumeSize:  defaultVolSize,
+		CredentialProvider: provider,
+	}
+	if err := client.start(context.Background(), opts...); err != nil {
 		return nil, err
 	}
-	if client.InitiatorIface == "" {
-		client.InitiatorIface = "default"
+	return client, nil
+}
+
+// start connects, initializes the tenant account and loads the cluster limits.
+func (c *Client) start(ctx context.Context, opts ...sdk.ClientOption) error {
+	if err := c.connect(ctx, opts...); err != nil {
+		return err
+	}
+
+	if err := c.initAccount(ctx); err != nil {
+		return err
+	}
+	if c.InitiatorIface == "" {
+		c.InitiatorIface = "default"
 	}
 
 	// Fetch Cluster Limits
-	limits, limitErr := client.SFClient.GetLimits(ctx)
+	limits, limitErr := c.SFClient.GetLimits(ctx)
 	if limitErr != nil {
 		log.Printf("Warning: Failed to fetch cluster limits: %v\n", limitErr)
 	} else {
-		client.Limits = limits
+		c.Limits = limits
 	}
-
-	return client, nil
+	return nil
 }
 
 func (c *Client) initAccount(ctx context.Context) error {
@@ -148,8 +231,7 @@ func (c *Client) initAccount(ctx context.Context) error {
 	var account sdk.Account
 	result, sdkErr := c.SFClient.GetAccountByName(ctx, &req)
 	if sdkErr != nil {
-		// handle both specific xUnknownAccount or wrapped error containing it
-		if strings.Contains(sdkErr.Detail, "xUnknownAccount") {
+		if sdk.IsNotFound(sdkErr) {
 			req := sdk.AddAccountRequest{}
 			req.Username = c.TenantName
 			addResult, sdkErr := c.SFClient.AddAccount(ctx, &req)
@@ -202,8 +284,7 @@ func (c *Client) DeleteVolume(volumeID int64) error {
 	ctx := context.Background()
 	_, err := c.SFClient.DeleteVolume(ctx, &req)
 	if err != nil {
-		dneString := fmt.Sprintf("500:Volume %d does not exist.", req.VolumeID)
-		if err.Detail == dneString {
+		if sdk.IsNotFound(err) {
 			return nil
 		}
 		return err
@@ -242,12 +323,10 @@ func (c *Client) GetVolumeByName(volumeName string) (*sdk.Volume, error) {
 	req.AccountID = c.AccountID
 
 	ctx := context.Background()
-	response, err := c.SFClient.ListVolumesForAccount(ctx, &req)
-	if err != nil {
-		return &sdk.Volume{}, err
-	}
-	// Removed incorrect return of first volume
-	for _, v := range response.Volumes {
+	for v, err := range c.SFClient.AllVolumesForAccount(ctx, req, c.PageSize) {
+		if err != nil {
+			return &sdk.Volume{}, err
+		}
 		// NOTE: Warning, I'm not checking for duplicate names which sadly is valid on SF
 		if v.Name == volumeName {
 			return &v, nil
@@ -256,83 +335,113 @@ func (c *Client) GetVolumeByName(volumeName string) (*sdk.Volume, error) {
 	return nil, nil
 }
 
+// GetVolume returns the active volume with volumeID if it belongs to the client's account.
 func (c *Client) GetVolume(volumeID int64) (*sdk.Volume, error) {
-	req := sdk.ListActiveVolumesRequest{}
-	req.StartVolumeID = volumeID
-	req.Limit = 1
+	req := sdk.ListVolumesRequest{}
+	req.VolumeIDs = []int64{volumeID}
 
 	ctx := context.Background()
-	response, err := c.SFClient.ListActiveVolumes(ctx, &req)
+	response, err := c.SFClient.ListVolumes(ctx, &req)
 	if err != nil {
+		if sdk.IsNotFound(err) {
+			return nil, fmt.Errorf("volume %d not found", volumeID)
+		}
 		return nil, err
 	}
-	if len(response.Volumes) > 0 {
-		for _, v := range response.Volumes {
-			if v.VolumeID == volumeID {
-				if v.AccountID == c.AccountID {
-					// We need to return a pointer to the value in the slice, but since v is a copy (range loop),
-					// we can either return &v (which points to the stack local copy that might be unsafe if not copied properly)
-					// or index into the slice.
-					// Actually, range over slice returns a copy 'v'.
-					// Safest is to return &response.Volumes[i] or return a copy.
-					// Since struct is simple, returning &v is okay if we return *Volume.
-					// Wait, Go 1.22+ changed loop variable semantics, but to be safe:
-					vol := v
-					return &vol, nil
-				}
-				// Found volume but wrong account?
-				// This might be okay if we are admin? But we filter by account usually?
-				// Actually ListActiveVolumes lists all volumes visible to the user.
-				// If we are admin, we see all.
-				// The check `v.AccountID == c.AccountID` implies we only want to see our own volumes.
-				// If we want to allow admin to see everything, we should remove this check or make it optional.
-				// But for now, let's keep it but Fix the Loop.
-				return nil, fmt.Errorf("volume %d found but belongs to account %d (expected %d)", volumeID, v.AccountID, c.AccountID)
-			}
+	for _, v := range response.Volumes {
+		if v.VolumeID != volumeID || v.Status == "deleted" {
+			continue
+		}
+		if v.AccountID != c.AccountID {
+			return nil, fmt.Errorf("volume %d found but belongs to account %d (expected %d)", volumeID, v.AccountID, c.AccountID)
 		}
+		return &v, nil
 	}
 	return nil, fmt.Errorf("volume %d not found", volumeID)
 }
 
+// ListVolumes returns the account's volumes, fetched PageSize at a time.
 func (c *Client) ListVolumes() ([]sdk.Volume, error) {
 	req := sdk.ListVolumesForAccountRequest{}
 	req.AccountID = c.AccountID
 
 	ctx := context.Background()
-	response, err := c.SFClient.ListVolumesForAccount(ctx, &req)
+	volumes := []sdk.Volume{}
+	for v, err := range c.SFClient.AllVolumesForAccount(ctx, req, c.PageSize) {
+		if err != nil {
+			var sdkErr *sdk.SdkError
+			if errors.As(err, &sdkErr) {
+				return nil, fmt.Errorf("list volumes failed (code=%s): %s", sdkErr.Code, sdkErr.Detail)
+			}
+			return nil, fmt.Errorf("list volumes failed: %w", err)
+		}
+		volumes = append(volumes, v)
+	}
+	return volumes, nil
+}
 
-	// FIX: Check for typed nil pointer trap
-	// The generated SDK returns *SdkError. If the pointer is nil, accessing it is safe (it's address 0),
-	// but assigning it to an `error` interface makes the interface non-nil.
-	if err != nil {
-		// Log the raw value/pointer to be absolutely sure
-		return nil, fmt.Errorf("list volumes failed (code=%s): %s", err.Code, err.Detail)
+func (c *Client) host() *iscsi.Host {
+	if c.Host == nil {
+		c.Host = iscsi.NewHost()
+	}
+	return c.Host
+}
+
+func (c *Client) attachOptions(v *sdk.Volume) iscsi.AttachOptions {
+	ifaces := c.InitiatorIfaces
+	if len(ifaces) == 0 {
+		ifaces = []string{c.InitiatorIface}
+	}
+	return iscsi.AttachOptions{
+		Ifaces:    ifaces,
+		CHAP:      &iscsi.CHAP{Username: c.TenantName, Password: c.InitiatorSecret},
+		WWID:      v.ScsiNAADeviceID,
+		Multipath: c.Multipath,
 	}
-	if response == nil {
-		return nil, fmt.Errorf("unexpected nil response from ListVolumesForAccount")
+}
+
+func (c *Client) attachContext() (context.Context, context.CancelFunc) {
+	timeout := c.AttachTimeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
 	}
-	return response.Volumes, nil
+	return context.WithTimeout(context.Background(), timeout)
 }
 
+// ConnectVolume logs in to the volume's target through each of InitiatorIfaces
+// with the account's CHAP credentials, waits for LUN 0 on every path and returns
+// its device: /dev/dm-N, found through the volume's ScsiNAADeviceID, when
+// Multipath is set, otherwise the /dev/sdX of the first path. If the attach
+// fails, the sessions it opened are logged out again; paths that were already
+// connected are left alone.
 func (c *Client) ConnectVolume(volumeID int64) (string, error) {
 	v, err := c.GetVolume(volumeID)
 	if err != nil {
 		return "", err
 	}
-	path := "/dev/disk/by-path/ip-" + c.SVIP + "-iscsi-" + v.Iqn + "-lun-0"
+	ctx, cancel := c.attachContext()
+	defer cancel()
 
-	// Make sure it's not already attached
-	if !sdk.WaitForPathToExist(path, 1) {
-		err = sdk.LoginWithChap(v.Iqn, c.SVIP, c.TenantName, c.InitiatorSecret, c.InitiatorIface)
-		if err != nil {
-			return "", err
-		}
+	a, err := c.host().Attach(ctx, iscsi.Target{Portal: c.SVIP, IQN: v.Iqn}, c.attachOptions(v))
+	if err != nil {
+		return "", err
 	}
+	return a.DevicePath(), nil
+}
 
-	if !sdk.WaitForPathToExist(path, 5) {
-		return path, fmt.Errorf("failed to find device at path: %v\n", path)
+// DisconnectVolume detaches the volume from this host: it flushes and removes
+// its multipath map, deletes the SCSI devices, then logs out of every session to
+// the volume's target and deletes the node records. The device must not be
+// mounted or open.
+func (c *Client) DisconnectVolume(volumeID int64) error {
+	v, err := c.GetVolume(volumeID)
+	if err != nil {
+		return err
 	}
-	return sdk.GetDeviceFileFromIscsiPath(path)
+	ctx, cancel := c.attachContext()
+	defer cancel()
+
+	return c.host().Detach(ctx, iscsi.Target{Portal: c.SVIP, IQN: v.Iqn}, c.attachOptions(v))
 }
 
 func (c *Client) CreateGroupSnapshot(volumes []int64, name string, enableRemoteReplication bool, ensureSerialCreation bool, retention string) (*sdk.CreateGroupSnapshotResult, error) {
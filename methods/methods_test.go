@@ -0,0 +1,269 @@
+package cloudops
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/scaleoutsean/solidfire-go/iscsi/iscsitest"
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+// newSimClient returns a Client connected to an in-memory cluster.
+func newSimClient(t *testing.T) (*Client, *sdktest.Server) {
+	t.Helper()
+	srv := sdktest.NewServer()
+	t.Cleanup(srv.Close)
+	c, err := NewClientFromSecrets(srv.Host(), "admin", "admin", srv.Version, "tenant", "1", srv.ClientOptions()...)
+	if err != nil {
+		t.Fatalf("NewClientFromSecrets: %v", err)
+	}
+	return c, srv
+}
+
+func TestNewClientCreatesAccount(t *testing.T) {
+	c, srv := newSimClient(t)
+	if c.AccountID == 0 || c.InitiatorSecret == "" {
+		t.Fatalf("account not initialized: %+v", c)
+	}
+	if c.SVIP != srv.ClusterInfo.Svip || c.Limits == nil {
+		t.Fatalf("cluster info not loaded: svip %q limits %v", c.SVIP, c.Limits)
+	}
+
+	// A second client for the same tenant reuses the account.
+	again, err := NewClientFromSecrets(srv.Host(), "admin", "admin", srv.Version, "tenant", "1", srv.ClientOptions()...)
+	if err != nil {
+		t.Fatalf("NewClientFromSecrets: %v", err)
+	}
+	if again.AccountID != c.AccountID || srv.CallCount("AddAccount") != 1 {
+		t.Fatalf("expected the existing account to be reused")
+	}
+}
+
+func TestVolumeLifecycle(t *testing.T) {
+	c, _ := newSimClient(t)
+
+	req := sdk.CreateVolumeRequest{Name: "pvc-1", AccountID: c.AccountID, TotalSize: GiB}
+	vol, err := c.GetCreateVolume(req)
+	if err != nil {
+		t.Fatalf("GetCreateVolume: %v", err)
+	}
+	same, err := c.GetCreateVolume(req)
+	if err != nil || same.VolumeID != vol.VolumeID {
+		t.Fatalf("GetCreateVolume is not idempotent: %v %+v", err, same)
+	}
+
+	if err := c.ExpandVolume(vol.VolumeID, 2); err != nil {
+		t.Fatalf("ExpandVolume: %v", err)
+	}
+	got, err := c.GetVolume(vol.VolumeID)
+	if err != nil || got.TotalSize != 2*GiB {
+		t.Fatalf("GetVolume after expand: %v %+v", err, got)
+	}
+
+	if err := c.DeleteVolume(vol.VolumeID); err != nil {
+		t.Fatalf("DeleteVolume: %v", err)
+	}
+	if err := c.DeleteVolume(vol.VolumeID); err != nil {
+		t.Fatalf("deleting a missing volume should succeed, got %v", err)
+	}
+}
+
+func TestGroupSnapshots(t *testing.T) {
+	c, _ := newSimClient(t)
+
+	var ids []int64
+	for _, name := range []string{"a", "b"} {
+		vol, err := c.GetCreateVolume(sdk.CreateVolumeRequest{Name: name, AccountID: c.AccountID, TotalSize: GiB})
+		if err != nil {
+			t.Fatalf("GetCreateVolume: %v", err)
+		}
+		ids = append(ids, vol.VolumeID)
+	}
+	res, err := c.CreateGroupSnapshot(ids, "nightly", false, false, "")
+	if err != nil {
+		t.Fatalf("CreateGroupSnapshot: %v", err)
+	}
+	if len(res.Members) != 2 {
+		t.Fatalf("group snapshot members %+v", res.Members)
+	}
+	groups, err := c.ListGroupSnapshots(ids[:1])
+	if err != nil || len(groups) != 1 {
+		t.Fatalf("ListGroupSnapshots: %v %+v", err, groups)
+	}
+	if err := c.DeleteGroupSnapshot(res.GroupSnapshotID); err != nil {
+		t.Fatalf("DeleteGroupSnapshot: %v", err)
+	}
+	if groups, _ := c.ListGroupSnapshots(nil); len(groups) != 0 {
+		t.Fatalf("group snapshot was not deleted: %+v", groups)
+	}
+}
+
+func TestClientCredentialRotation(t *testing.T) {
+	srv := sdktest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.Username, srv.Password = "admin", "first"
+
+	password := "first"
+	provider := sdk.CredentialProviderFunc(func(ctx context.Context) (sdk.Credentials, error) {
+		return sdk.Credentials{Username: "admin", Password: password}, nil
+	})
+	c, err := NewClientWithProvider(srv.Host(), srv.Version, "tenant", "1", provider, srv.ClientOptions()...)
+	if err != nil {
+		t.Fatalf("NewClientWithProvider: %v", err)
+	}
+
+	srv.Password, password = "second", "second"
+	if _, err := c.ListVolumes(); err != nil {
+		t.Fatalf("ListVolumes after rotation: %v", err)
+	}
+}
+
+func TestParseEndpointWithoutCredentials(t *testing.T) {
+	var c Client
+	if err := parseEndpointString("https://10.1.1.1/json-rpc/12.5", &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.URL != "10.1.1.1" || c.Version != "12.5" || c.Login != "" {
+		t.Fatalf("unexpected parse result %+v", c)
+	}
+	if err := parseEndpointString("https://admin:p@ss@10.1.1.1/json-rpc/12.5", &c); err != nil || c.Password != "p@ss" || c.URL != "10.1.1.1" {
+		t.Fatalf("unexpected parse result %+v %v", c, err)
+	}
+}
+
+func TestListVolumesPagesAndGetVolume(t *testing.T) {
+	c, srv := newSimClient(t)
+	c.PageSize = 2
+
+	var ids []int64
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		vol, err := c.GetCreateVolume(sdk.CreateVolumeRequest{Name: name, AccountID: c.AccountID, TotalSize: GiB})
+		if err != nil {
+			t.Fatalf("GetCreateVolume: %v", err)
+		}
+		ids = append(ids, vol.VolumeID)
+	}
+	vols, err := c.ListVolumes()
+	if err != nil || len(vols) != 5 {
+		t.Fatalf("ListVolumes: %v %d", err, len(vols))
+	}
+	if srv.CallCount("ListVolumesForAccount") < 3 {
+		t.Fatalf("expected paged calls, got %d", srv.CallCount("ListVolumesForAccount"))
+	}
+
+	// A deleted volume must not be mistaken for the next one by ID.
+	if err := c.DeleteVolume(ids[1]); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := c.GetVolume(ids[1]); err == nil {
+		t.Fatalf("GetVolume returned deleted volume %+v", v)
+	}
+	if v, err := c.GetVolume(ids[2]); err != nil || v.Name != "c" {
+		t.Fatalf("GetVolume: %v %+v", err, v)
+	}
+}
+
+func TestConnectVolumeLogsInWithCHAP(t *testing.T) {
+	c, _ := newSimClient(t)
+	fake := iscsitest.New(t.TempDir())
+	c.Host = fake.Host()
+
+	vol, err := c.GetCreateVolume(sdk.CreateVolumeRequest{Name: "pvc-1", AccountID: c.AccountID, TotalSize: GiB})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.AddTarget(iscsitest.Target{IQN: vol.Iqn, Portals: []string{c.SVIP}, CHAPUser: c.TenantName, CHAPSecret: c.InitiatorSecret})
+
+	dev, err := c.ConnectVolume(vol.VolumeID)
+	if err != nil || dev != "/dev/sdb" {
+		t.Fatalf("ConnectVolume: %v %q", err, dev)
+	}
+	again, err := c.ConnectVolume(vol.VolumeID)
+	if err != nil || again != dev || fake.SessionCount() != 1 {
+		t.Fatalf("second ConnectVolume: %v %q, %d sessions", err, again, fake.SessionCount())
+	}
+	for _, call := range fake.Calls() {
+		if strings.Contains(call, c.InitiatorSecret) {
+			t.Fatalf("secret on the command line: %s", call)
+		}
+	}
+}
+
+func TestConnectVolumeMultipath(t *testing.T) {
+	c, _ := newSimClient(t)
+	fake := iscsitest.New(t.TempDir())
+	fake.Multipath = true
+	c.Host = fake.Host()
+	c.InitiatorIfaces = []string{"iface0", "iface1"}
+	c.Multipath = true
+
+	vol, err := c.GetCreateVolume(sdk.CreateVolumeRequest{Name: "pvc-1", AccountID: c.AccountID, TotalSize: GiB})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.AddTarget(iscsitest.Target{IQN: vol.Iqn, Portals: []string{c.SVIP}, CHAPUser: c.TenantName,
+		CHAPSecret: c.InitiatorSecret, WWID: vol.ScsiNAADeviceID})
+
+	dev, err := c.ConnectVolume(vol.VolumeID)
+	if err != nil || dev != "/dev/dm-0" || fake.SessionCount() != 2 {
+		t.Fatalf("ConnectVolume: %v %q, %d sessions", err, dev, fake.SessionCount())
+	}
+	if err := c.DisconnectVolume(vol.VolumeID); err != nil {
+		t.Fatalf("DisconnectVolume: %v", err)
+	}
+	if fake.SessionCount() != 0 || fake.MapCount() != 0 {
+		t.Fatalf("still attached: %q", fake.Events())
+	}
+}
+
+func TestConnectVolumeCleansUpFailedAttach(t *testing.T) {
+	c, _ := newSimClient(t)
+	fake := iscsitest.New(t.TempDir())
+	c.Host = fake.Host()
+	c.InitiatorIfaces = []string{"iface0", "iface1"}
+
+	vol, err := c.GetCreateVolume(sdk.CreateVolumeRequest{Name: "pvc-1", AccountID: c.AccountID, TotalSize: GiB})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.AddTarget(iscsitest.Target{IQN: vol.Iqn, Portals: []string{c.SVIP}, CHAPUser: c.TenantName, CHAPSecret: c.InitiatorSecret})
+	// The first path logs in, the second fails.
+	fake.Fail("-I iface1 --login", 1)
+
+	if dev, err := c.ConnectVolume(vol.VolumeID); err == nil {
+		t.Fatalf("ConnectVolume succeeded with %q", dev)
+	}
+	if fake.SessionCount() != 0 {
+		t.Fatalf("failed attach left %d sessions: %q", fake.SessionCount(), fake.Events())
+	}
+}
+
+func TestConnectVolumeKeepsConnectedPathOnFailedAttach(t *testing.T) {
+	c, _ := newSimClient(t)
+	fake := iscsitest.New(t.TempDir())
+	c.Host = fake.Host()
+	c.InitiatorIfaces = []string{"iface0"}
+
+	vol, err := c.GetCreateVolume(sdk.CreateVolumeRequest{Name: "pvc-1", AccountID: c.AccountID, TotalSize: GiB})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.AddTarget(iscsitest.Target{IQN: vol.Iqn, Portals: []string{c.SVIP}, CHAPUser: c.TenantName, CHAPSecret: c.InitiatorSecret})
+	dev, err := c.ConnectVolume(vol.VolumeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Adding a second path fails; the first one may be mounted.
+	c.InitiatorIfaces = []string{"iface0", "iface1"}
+	fake.Fail("-I iface1 --login", 1)
+	if _, err := c.ConnectVolume(vol.VolumeID); err == nil {
+		t.Fatal("ConnectVolume succeeded")
+	}
+	if fake.SessionCount() != 1 || slices.ContainsFunc(fake.Events(), func(e string) bool { return e == "delete "+strings.TrimPrefix(dev, "/dev/") }) {
+		t.Fatalf("failed attach removed the connected path %s: %q", dev, fake.Events())
+	}
+}
@@ -0,0 +1,263 @@
+package cloudops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+const (
+	// DefaultSnapshotExpiration is used by CreateSnapshot when no expiration is
+	// given, the same default as CreateGroupSnapshot.
+	DefaultSnapshotExpiration = 24 * time.Hour
+	// NoExpiration keeps a snapshot until it is deleted.
+	NoExpiration time.Duration = -1
+)
+
+// ErrSnapshotNotFound is returned for a snapshot ID the cluster does not know.
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// ErrVolumeInUse is returned by RollbackToSnapshot when RequireNoSessions is set
+// and initiators are logged in to the volume.
+var ErrVolumeInUse = errors.New("volume has active iSCSI sessions")
+
+// DuplicateSnapshotNameError is returned by GetSnapshotByName when more than one
+// snapshot of the volume has the name. Snapshot names are not unique on the
+// cluster; use the IDs to pick one.
+type DuplicateSnapshotNameError struct {
+	VolumeID    int64
+	Name        string
+	SnapshotIDs []int64
+}
+
+func (e *DuplicateSnapshotNameError) Error() string {
+	return fmt.Sprintf("volume %d has %d snapshots named %q: %v", e.VolumeID, len(e.SnapshotIDs), e.Name, e.SnapshotIDs)
+}
+
+// SnapshotOptions are the optional settings of CreateSnapshot and ModifySnapshot.
+type SnapshotOptions struct {
+	// Expiration is how long from now the cluster keeps the snapshot, and
+	// NoExpiration keeps it until deleted. Zero means DefaultSnapshotExpiration for
+	// CreateSnapshot and leaves the expiration unchanged for ModifySnapshot.
+	Expiration time.Duration
+	// EnableRemoteReplication replicates the snapshot to a paired cluster.
+	// ModifySnapshot leaves replication unchanged unless EnableRemoteReplication or
+	// DisableRemoteReplication is set; setting both is an error.
+	EnableRemoteReplication  bool
+	DisableRemoteReplication bool
+	SnapMirrorLabel          string
+	Attributes               map[string]interface{}
+}
+
+// RollbackOptions are the optional settings of RollbackToSnapshot.
+type RollbackOptions struct {
+	// SafetySnapshot saves the volume's current state as a snapshot before the
+	// rollback, named SafetySnapshotName (default "pre-rollback-<snapshotID>-<time>").
+	SafetySnapshot     bool
+	SafetySnapshotName string
+	// RequireNoSessions refuses the rollback with ErrVolumeInUse while any
+	// initiator is logged in to the volume, as reported by ListISCSISessions.
+	RequireNoSessions bool
+}
+
+// formatRetention renders d as the "HH:MM:SS" retention Element expects,
+// rounding up to whole seconds.
+func formatRetention(d time.Duration) string {
+	s := int64((d + time.Second - 1) / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", s/3600, s/60%60, s%60)
+}
+
+// CreateSnapshot snapshots a volume of the client's account.
+func (c *Client) CreateSnapshot(volumeID int64, name string, opts SnapshotOptions) (*sdk.Snapshot, error) {
+	if _, err := c.GetVolume(volumeID); err != nil {
+		return nil, err
+	}
+	req := sdk.CreateSnapshotRequest{
+		VolumeID:                volumeID,
+		Name:                    name,
+		EnableRemoteReplication: opts.EnableRemoteReplication,
+		SnapMirrorLabel:         opts.SnapMirrorLabel,
+	}
+	if opts.Attributes != nil {
+		req.Attributes = opts.Attributes
+	}
+	switch {
+	case opts.Expiration == 0:
+		req.Retention = formatRetention(DefaultSnapshotExpiration)
+	case opts.Expiration > 0:
+		req.Retention = formatRetention(opts.Expiration)
+	}
+
+	ctx := context.Background()
+	res, err := c.SFClient.CreateSnapshot(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Snapshot, nil
+}
+
+// ListSnapshots returns the snapshots of a volume of the client's account.
+func (c *Client) ListSnapshots(volumeID int64) ([]sdk.Snapshot, error) {
+	if _, err := c.GetVolume(volumeID); err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	res, err := c.SFClient.ListSnapshots(ctx, &sdk.ListSnapshotsRequest{VolumeID: volumeID})
+	if err != nil {
+		return nil, err
+	}
+	return res.Snapshots, nil
+}
+
+// GetSnapshot returns a snapshot if its volume belongs to the client's account.
+func (c *Client) GetSnapshot(snapshotID int64) (*sdk.Snapshot, error) {
+	ctx := context.Background()
+	res, err := c.SFClient.ListSnapshots(ctx, &sdk.ListSnapshotsRequest{SnapshotID: snapshotID})
+	if err != nil {
+		if sdk.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %d", ErrSnapshotNotFound, snapshotID)
+		}
+		return nil, err
+	}
+	for _, snap := range res.Snapshots {
+		if snap.SnapshotID != snapshotID {
+			continue
+		}
+		if _, err := c.GetVolume(snap.VolumeID); err != nil {
+			return nil, fmt.Errorf("snapshot %d: %w", snapshotID, err)
+		}
+		return &snap, nil
+	}
+	return nil, fmt.Errorf("%w: %d", ErrSnapshotNotFound, snapshotID)
+}
+
+// GetSnapshotByName returns the snapshot of the volume with the given name, or nil
+// if there is none. Several snapshots with the name return a
+// *DuplicateSnapshotNameError instead of picking one.
+func (c *Client) GetSnapshotByName(volumeID int64, name string) (*sdk.Snapshot, error) {
+	snapshots, err := c.ListSnapshots(volumeID)
+	if err != nil {
+		return nil, err
+	}
+	var found []sdk.Snapshot
+	for _, snap := range snapshots {
+		if snap.Name == name {
+			found = append(found, snap)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &found[0], nil
+	}
+	dup := &DuplicateSnapshotNameError{VolumeID: volumeID, Name: name}
+	for _, snap := range found {
+		dup.SnapshotIDs = append(dup.SnapshotIDs, snap.SnapshotID)
+	}
+	return nil, dup
+}
+
+// ModifySnapshot changes a snapshot's expiration, remote replication and
+// SnapMirror label. Expiration is counted from now, and NoExpiration removes the
+// expiration. Zero values leave the settings unchanged. The cluster cannot change
+// the attributes of a snapshot, so setting Attributes is an error.
+func (c *Client) ModifySnapshot(snapshotID int64, opts SnapshotOptions) (*sdk.Snapshot, error) {
+	if opts.EnableRemoteReplication && opts.DisableRemoteReplication {
+		return nil, errors.New("EnableRemoteReplication and DisableRemoteReplication are both set")
+	}
+	if opts.Attributes != nil {
+		return nil, errors.New("ModifySnapshot cannot change snapshot attributes")
+	}
+	if _, err := c.GetSnapshot(snapshotID); err != nil {
+		return nil, err
+	}
+	req := sdk.ModifySnapshotRequest{
+		SnapshotID:      snapshotID,
+		SnapMirrorLabel: opts.SnapMirrorLabel,
+	}
+	if opts.EnableRemoteReplication || opts.DisableRemoteReplication {
+		req.EnableRemoteReplication = &opts.EnableRemoteReplication
+	}
+	switch {
+	case opts.Expiration > 0:
+		req.ExpirationTime = time.Now().Add(opts.Expiration).UTC().Format(time.RFC3339)
+	case opts.Expiration < 0:
+		req.ExpirationTime = "null"
+	}
+
+	ctx := context.Background()
+	res, err := c.SFClient.ModifySnapshot(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Snapshot, nil
+}
+
+// DeleteSnapshot deletes a snapshot of a volume of the client's account. A
+// snapshot that no longer exists is not an error.
+func (c *Client) DeleteSnapshot(snapshotID int64) error {
+	if _, err := c.GetSnapshot(snapshotID); err != nil {
+		if errors.Is(err, ErrSnapshotNotFound) {
+			return nil
+		}
+		return err
+	}
+	ctx := context.Background()
+	_, err := c.SFClient.DeleteSnapshot(ctx, &sdk.DeleteSnapshotRequest{SnapshotID: snapshotID})
+	if err != nil {
+		if sdk.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// RollbackToSnapshot rolls a volume of the client's account back to one of its
+// snapshots. It returns the safety snapshot when opts.SafetySnapshot is set, else nil.
+func (c *Client) RollbackToSnapshot(volumeID, snapshotID int64, opts RollbackOptions) (*sdk.Snapshot, error) {
+	snap, err := c.GetSnapshot(snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	if snap.VolumeID != volumeID {
+		return nil, fmt.Errorf("snapshot %d belongs to volume %d, not %d", snapshotID, snap.VolumeID, volumeID)
+	}
+	if opts.RequireNoSessions {
+		sessions, err := c.ListISCSISessions()
+		if err != nil {
+			return nil, err
+		}
+		var initiators []string
+		for _, s := range sessions {
+			if s.VolumeID == volumeID {
+				initiators = append(initiators, s.InitiatorName)
+			}
+		}
+		if len(initiators) > 0 {
+			return nil, fmt.Errorf("volume %d: %w: %s", volumeID, ErrVolumeInUse, strings.Join(initiators, ", "))
+		}
+	}
+
+	req := sdk.RollbackToSnapshotRequest{VolumeID: volumeID, SnapshotID: snapshotID, SaveCurrentState: opts.SafetySnapshot}
+	if opts.SafetySnapshot {
+		req.Name = opts.SafetySnapshotName
+		if req.Name == "" {
+			req.Name = fmt.Sprintf("pre-rollback-%d-%s", snapshotID, time.Now().UTC().Format("20060102T150405Z"))
+		}
+	}
+	ctx := context.Background()
+	res, sdkErr := c.SFClient.RollbackToSnapshot(ctx, &req)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	if !opts.SafetySnapshot {
+		return nil, nil
+	}
+	return &res.Snapshot, nil
+}
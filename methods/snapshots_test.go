@@ -0,0 +1,149 @@
+package cloudops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func TestSnapshotLifecycle(t *testing.T) {
+	c, srv := newSimClient(t)
+	vol, err := c.GetCreateVolume(sdk.CreateVolumeRequest{Name: "pvc-1", AccountID: c.AccountID, TotalSize: GiB})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	snap, err := c.CreateSnapshot(vol.VolumeID, "daily", SnapshotOptions{Expiration: 90 * time.Minute})
+	if err != nil {
+		t.Fatalf("CreateSnapshot: %v", err)
+	}
+	for _, call := range srv.Calls() {
+		var req sdk.CreateSnapshotRequest
+		if call.Method == "CreateSnapshot" && (json.Unmarshal(call.Params, &req) != nil || req.Retention != "01:30:00") {
+			t.Fatalf("unexpected request %s", call.Params)
+		}
+	}
+	keep, err := c.CreateSnapshot(vol.VolumeID, "keep", SnapshotOptions{Expiration: NoExpiration})
+	if err != nil || keep.ExpirationTime != "" {
+		t.Fatalf("CreateSnapshot without expiration: %v %+v", err, keep)
+	}
+
+	modified, err := c.ModifySnapshot(snap.SnapshotID, SnapshotOptions{Expiration: 48 * time.Hour})
+	if err != nil {
+		t.Fatalf("ModifySnapshot: %v", err)
+	}
+	expires, err := time.Parse(time.RFC3339, modified.ExpirationTime)
+	if err != nil || expires.Before(start.Add(47*time.Hour)) || expires.After(time.Now().Add(49*time.Hour)) {
+		t.Fatalf("unexpected expiration %q", modified.ExpirationTime)
+	}
+
+	// Replication and expiration can be turned off again.
+	if modified, err = c.ModifySnapshot(snap.SnapshotID, SnapshotOptions{EnableRemoteReplication: true}); err != nil || !modified.EnableRemoteReplication {
+		t.Fatalf("enabling replication: %v %+v", err, modified)
+	}
+	modified, err = c.ModifySnapshot(snap.SnapshotID, SnapshotOptions{Expiration: NoExpiration, DisableRemoteReplication: true})
+	if err != nil || modified.EnableRemoteReplication || modified.ExpirationTime != "" {
+		t.Fatalf("clearing expiration and replication: %v %+v", err, modified)
+	}
+	if _, err := c.ModifySnapshot(snap.SnapshotID, SnapshotOptions{EnableRemoteReplication: true, DisableRemoteReplication: true}); err == nil {
+		t.Fatal("expected an error for enabling and disabling replication at once")
+	}
+	if _, err := c.ModifySnapshot(snap.SnapshotID, SnapshotOptions{Attributes: map[string]interface{}{"a": 1}}); err == nil {
+		t.Fatal("expected an error for attributes")
+	}
+
+	found, err := c.GetSnapshotByName(vol.VolumeID, "daily")
+	if err != nil || found == nil || found.SnapshotID != snap.SnapshotID {
+		t.Fatalf("GetSnapshotByName: %v %+v", err, found)
+	}
+	if missing, err := c.GetSnapshotByName(vol.VolumeID, "weekly"); err != nil || missing != nil {
+		t.Fatalf("GetSnapshotByName for a missing name: %v %+v", err, missing)
+	}
+	if _, err := c.CreateSnapshot(vol.VolumeID, "daily", SnapshotOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	var dup *DuplicateSnapshotNameError
+	if _, err := c.GetSnapshotByName(vol.VolumeID, "daily"); !errors.As(err, &dup) || len(dup.SnapshotIDs) != 2 {
+		t.Fatalf("expected a duplicate name error, got %v", err)
+	}
+
+	if err := c.DeleteSnapshot(keep.SnapshotID); err != nil {
+		t.Fatalf("DeleteSnapshot: %v", err)
+	}
+	if err := c.DeleteSnapshot(keep.SnapshotID); err != nil {
+		t.Fatalf("deleting twice: %v", err)
+	}
+	snapshots, err := c.ListSnapshots(vol.VolumeID)
+	if err != nil || len(snapshots) != 2 {
+		t.Fatalf("ListSnapshots: %v %d", err, len(snapshots))
+	}
+}
+
+func TestSnapshotsAreAccountScoped(t *testing.T) {
+	c, _ := newSimClient(t)
+	ctx := context.Background()
+	other, err := c.SFClient.AddAccount(ctx, &sdk.AddAccountRequest{Username: "other"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	vol, err := c.SFClient.CreateVolume(ctx, &sdk.CreateVolumeRequest{Name: "theirs", AccountID: other.AccountID, TotalSize: GiB})
+	if err != nil {
+		t.Fatal(err)
+	}
+	snap, err := c.SFClient.CreateSnapshot(ctx, &sdk.CreateSnapshotRequest{VolumeID: vol.VolumeID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.CreateSnapshot(vol.VolumeID, "x", SnapshotOptions{}); err == nil {
+		t.Fatal("snapshotted another account's volume")
+	}
+	if err := c.DeleteSnapshot(snap.SnapshotID); err == nil {
+		t.Fatal("deleted another account's snapshot")
+	}
+	if _, err := c.RollbackToSnapshot(vol.VolumeID, snap.SnapshotID, RollbackOptions{}); err == nil {
+		t.Fatal("rolled back another account's volume")
+	}
+}
+
+func TestRollbackToSnapshot(t *testing.T) {
+	c, srv := newSimClient(t)
+	vol, err := c.GetCreateVolume(sdk.CreateVolumeRequest{Name: "pvc-1", AccountID: c.AccountID, TotalSize: GiB})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := c.GetCreateVolume(sdk.CreateVolumeRequest{Name: "pvc-2", AccountID: c.AccountID, TotalSize: GiB})
+	if err != nil {
+		t.Fatal(err)
+	}
+	snap, err := c.CreateSnapshot(vol.VolumeID, "before", SnapshotOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.RollbackToSnapshot(other.VolumeID, snap.SnapshotID, RollbackOptions{}); err == nil {
+		t.Fatal("rolled back to another volume's snapshot")
+	}
+
+	session := srv.AddISCSISession(vol.VolumeID, "iqn.1993-08.org.debian:01:host1")
+	opts := RollbackOptions{SafetySnapshot: true, RequireNoSessions: true}
+	if _, err := c.RollbackToSnapshot(vol.VolumeID, snap.SnapshotID, opts); !errors.Is(err, ErrVolumeInUse) {
+		t.Fatalf("expected ErrVolumeInUse, got %v", err)
+	}
+	if srv.CallCount("RollbackToSnapshot") != 0 {
+		t.Fatal("rollback sent while the volume was in use")
+	}
+
+	srv.RemoveISCSISession(session)
+	safety, err := c.RollbackToSnapshot(vol.VolumeID, snap.SnapshotID, opts)
+	if err != nil || safety == nil || safety.VolumeID != vol.VolumeID || safety.SnapshotID == snap.SnapshotID {
+		t.Fatalf("RollbackToSnapshot: %v %+v", err, safety)
+	}
+	if none, err := c.RollbackToSnapshot(vol.VolumeID, snap.SnapshotID, RollbackOptions{}); err != nil || none != nil {
+		t.Fatalf("rollback without a safety snapshot: %v %+v", err, none)
+	}
+}
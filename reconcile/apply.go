@@ -0,0 +1,103 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	log "github.com/sirupsen/logrus"
+)
+
+// Reconciler plans and applies manifests against one cluster.
+type Reconciler struct {
+	// Purge purges deleted volumes right away instead of leaving them restorable
+	// until the cluster purges them. Element only removes accounts without volumes,
+	// so without Purge an account that is no longer in the manifest is reported as a
+	// conflict until the cluster has purged its deleted volumes.
+	Purge bool
+	// PageSize is the page size used to list volumes. Default sdk.DefaultPageSize.
+	PageSize int64
+
+	sf *sdk.SFClient
+}
+
+// New returns a Reconciler for the cluster behind sf.
+func New(sf *sdk.SFClient) *Reconciler {
+	return &Reconciler{sf: sf}
+}
+
+// Plan compares m with the cluster and returns the changes Apply would make. It
+// does not modify anything, so printing the plan with WriteDiff is a dry run.
+func (r *Reconciler) Plan(ctx context.Context, m *Manifest) (*Plan, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	live, err := r.load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading cluster state: %w", err)
+	}
+	p := &planner{
+		m:       m,
+		live:    live,
+		plan:    &Plan{Owner: m.Owner, Changes: []Change{}, ids: map[string]int64{}},
+		purge:   r.Purge,
+		deletes: map[Kind][]Change{},
+		skipped: map[string]bool{},
+		refs:    map[int64]string{},
+	}
+	p.build()
+	return p.plan, nil
+}
+
+// Apply makes the changes of p in order and stops at the first failure. Plans only
+// touch objects that carry the owner marker, and planning again after Apply, or
+// after a failed Apply, picks up where the cluster is, so reconciling is idempotent.
+func (r *Reconciler) Apply(ctx context.Context, p *Plan) error {
+	a := &applier{sf: r.sf, ids: map[string]int64{}}
+	for k, id := range p.ids {
+		a.ids[k] = id
+	}
+	for _, c := range p.Changes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := c.apply(ctx, a); err != nil {
+			return fmt.Errorf("%s %s %s: %w", c.Action, c.Kind, c.Name, err)
+		}
+		log.WithContext(ctx).Infof("reconcile: %s %s %s", c.Action, c.Kind, c.Name)
+	}
+	return nil
+}
+
+// Reconcile plans m and, unless dryRun is set, applies the plan.
+func (r *Reconciler) Reconcile(ctx context.Context, m *Manifest, dryRun bool) (*Plan, error) {
+	p, err := r.Plan(ctx, m)
+	if err != nil || dryRun {
+		return p, err
+	}
+	return p, r.Apply(ctx, p)
+}
+
+// applier carries the IDs of existing and newly created objects while a plan runs.
+type applier struct {
+	sf  *sdk.SFClient
+	ids map[string]int64
+}
+
+// volumeIDs resolves "account/volume" references to volume IDs.
+func (a *applier) volumeIDs(refs []string) []int64 {
+	ids := make([]int64, 0, len(refs))
+	for _, ref := range refs {
+		if id := a.ids[key(KindVolume, ref)]; id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func ignoreNotFound(err *sdk.SdkError) error {
+	if err == nil || sdk.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,172 @@
+// Package reconcile brings tenants on a cluster to the state described in a
+// manifest. A manifest lists accounts with their volumes, volume access groups with
+// their initiators, and snapshot schedules. Plan compares it with the cluster and
+// returns the creates, updates and deletes needed, which can be printed as a diff
+// for a dry run and then applied.
+//
+// Every object the reconciler creates carries the manifest owner in its attributes
+// under OwnerAttribute. Only objects with that marker are ever changed or deleted;
+// an unmarked object that clashes with the manifest is reported as a conflict.
+package reconcile
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// OwnerAttribute is the attribute key holding the owner of a managed object.
+const OwnerAttribute = "reconcile-owner"
+
+// GiB is the unit of Volume.SizeGiB.
+const GiB = 1 << 30
+
+// Manifest is the desired state of the objects belonging to Owner.
+type Manifest struct {
+	// Owner is stored in OwnerAttribute of every managed object. Manifests with
+	// different owners never touch each other's objects.
+	Owner        string        `yaml:"owner"`
+	Accounts     []Account     `yaml:"accounts"`
+	AccessGroups []AccessGroup `yaml:"accessGroups"`
+	Schedules    []Schedule    `yaml:"schedules"`
+}
+
+// Account is a tenant account and the volumes it owns.
+type Account struct {
+	Name    string   `yaml:"name"`
+	Volumes []Volume `yaml:"volumes"`
+}
+
+// Volume is a volume of an account. QoS and QoSPolicy are mutually exclusive; with
+// neither, the volume's QoS is left alone.
+type Volume struct {
+	Name       string `yaml:"name"`
+	SizeGiB    int64  `yaml:"sizeGiB"`
+	Enable512e bool   `yaml:"enable512e"`
+	QoS        *QoS   `yaml:"qos"`
+	// QoSPolicy is the name of an existing QoS policy.
+	QoSPolicy  string            `yaml:"qosPolicy"`
+	Attributes map[string]string `yaml:"attributes"`
+}
+
+// QoS sets volume IOPS limits. Zero values are left to the cluster.
+type QoS struct {
+	MinIOPS   int64 `yaml:"minIOPS"`
+	MaxIOPS   int64 `yaml:"maxIOPS"`
+	BurstIOPS int64 `yaml:"burstIOPS"`
+}
+
+// AccessGroup is a volume access group. Volumes are referenced as
+// "account/volume" and must be declared in the same manifest.
+type AccessGroup struct {
+	Name       string   `yaml:"name"`
+	Initiators []string `yaml:"initiators"`
+	Volumes    []string `yaml:"volumes"`
+}
+
+// Schedule is a recurring snapshot schedule for one or more manifest volumes.
+// Monthdays selects "Days Of Month" schedules, Weekdays "Days Of Week" schedules,
+// and neither a "Time Interval" schedule that runs every Hours:Minutes.
+type Schedule struct {
+	Name         string   `yaml:"name"`
+	Volumes      []string `yaml:"volumes"`
+	SnapshotName string   `yaml:"snapshotName"`
+	// Retention is how long snapshots are kept, as "HH:MM:SS". Empty keeps them until deleted.
+	Retention string  `yaml:"retention"`
+	Hours     int64   `yaml:"hours"`
+	Minutes   int64   `yaml:"minutes"`
+	Weekdays  int64   `yaml:"weekdays"`
+	Monthdays []int64 `yaml:"monthdays"`
+	Paused    bool    `yaml:"paused"`
+}
+
+// Load reads a YAML or JSON manifest from path.
+func Load(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse decodes and validates a YAML or JSON manifest.
+func Parse(data []byte) (*Manifest, error) {
+	var m Manifest
+	if err := yaml.UnmarshalStrict(data, &m); err != nil {
+		return nil, fmt.Errorf("parsing manifest: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Validate checks that names are unique and references resolve within the manifest.
+func (m *Manifest) Validate() error {
+	if m.Owner == "" {
+		return fmt.Errorf("manifest: owner is required")
+	}
+	accounts := map[string]bool{}
+	volumes := map[string]bool{}
+	for _, a := range m.Accounts {
+		if a.Name == "" || accounts[a.Name] {
+			return fmt.Errorf("manifest: account name %q is empty or repeated", a.Name)
+		}
+		accounts[a.Name] = true
+		for _, v := range a.Volumes {
+			ref := a.Name + "/" + v.Name
+			if v.Name == "" || volumes[ref] {
+				return fmt.Errorf("manifest: volume name %q is empty or repeated", ref)
+			}
+			volumes[ref] = true
+			if v.SizeGiB <= 0 {
+				return fmt.Errorf("manifest: volume %s needs a positive sizeGiB", ref)
+			}
+			if v.QoS != nil && v.QoSPolicy != "" {
+				return fmt.Errorf("manifest: volume %s sets both qos and qosPolicy", ref)
+			}
+			if _, ok := v.Attributes[OwnerAttribute]; ok {
+				return fmt.Errorf("manifest: volume %s must not set the %s attribute", ref, OwnerAttribute)
+			}
+		}
+	}
+	checkRefs := func(kind, name string, refs []string) error {
+		for _, ref := range refs {
+			if !volumes[ref] {
+				return fmt.Errorf("manifest: %s %s refers to unknown volume %q", kind, name, ref)
+			}
+		}
+		return nil
+	}
+	groups := map[string]bool{}
+	for _, g := range m.AccessGroups {
+		if g.Name == "" || groups[g.Name] {
+			return fmt.Errorf("manifest: access group name %q is empty or repeated", g.Name)
+		}
+		groups[g.Name] = true
+		if err := checkRefs("access group", g.Name, g.Volumes); err != nil {
+			return err
+		}
+		for _, iqn := range g.Initiators {
+			if !strings.HasPrefix(iqn, "iqn.") && !strings.HasPrefix(iqn, "eui.") && !strings.HasPrefix(iqn, "naa.") {
+				return fmt.Errorf("manifest: access group %s has invalid initiator %q", g.Name, iqn)
+			}
+		}
+	}
+	schedules := map[string]bool{}
+	for _, s := range m.Schedules {
+		if s.Name == "" || schedules[s.Name] {
+			return fmt.Errorf("manifest: schedule name %q is empty or repeated", s.Name)
+		}
+		schedules[s.Name] = true
+		if len(s.Volumes) == 0 {
+			return fmt.Errorf("manifest: schedule %s has no volumes", s.Name)
+		}
+		if err := checkRefs("schedule", s.Name, s.Volumes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,780 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+// Action is what a change does to an object.
+type Action string
+
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+// Kind is the type of object a change or conflict is about.
+type Kind string
+
+const (
+	KindAccount     Kind = "account"
+	KindVolume      Kind = "volume"
+	KindInitiator   Kind = "initiator"
+	KindAccessGroup Kind = "accessGroup"
+	KindSchedule    Kind = "schedule"
+)
+
+// Change is one step of a plan.
+type Change struct {
+	Action Action `json:"action"`
+	Kind   Kind   `json:"kind"`
+	// Name is the manifest name; volumes are named "account/volume".
+	Name string `json:"name"`
+	// ID is the cluster ID of an object that is updated or deleted.
+	ID int64 `json:"id,omitempty"`
+	// Details lists the settings of a new object or the "field: old -> new" changes of an update.
+	Details []string `json:"details,omitempty"`
+
+	apply func(ctx context.Context, a *applier) error
+}
+
+// Conflict is a manifest object the reconciler will not touch, such as a volume
+// that exists but carries no ownership marker, or one that would have to shrink.
+type Conflict struct {
+	Kind   Kind   `json:"kind"`
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Plan is the list of changes that brings the cluster to a manifest. Creates and
+// updates come first, from accounts down to schedules, followed by deletes in the
+// reverse order.
+type Plan struct {
+	Owner     string     `json:"owner"`
+	Changes   []Change   `json:"changes"`
+	Conflicts []Conflict `json:"conflicts,omitempty"`
+
+	// ids maps object keys to the cluster IDs known when the plan was made.
+	ids map[string]int64
+}
+
+// Empty reports whether the cluster already matches the manifest.
+func (p *Plan) Empty() bool { return len(p.Changes) == 0 }
+
+// WriteDiff writes the plan as a diff: "+" creates, "~" updates with one line per
+// changed field, "-" deletes and "!" conflicts.
+func (p *Plan) WriteDiff(w io.Writer) error {
+	var b strings.Builder
+	marks := map[Action]string{Create: "+", Update: "~", Delete: "-"}
+	for _, c := range p.Changes {
+		fmt.Fprintf(&b, "%s %s %s", marks[c.Action], c.Kind, c.Name)
+		if c.Action == Create && len(c.Details) > 0 {
+			fmt.Fprintf(&b, " (%s)", strings.Join(c.Details, ", "))
+		}
+		if c.Action == Delete && len(c.Details) > 0 {
+			fmt.Fprintf(&b, " [%s]", strings.Join(c.Details, ", "))
+		}
+		b.WriteString("\n")
+		if c.Action == Update {
+			for _, d := range c.Details {
+				fmt.Fprintf(&b, "    %s\n", d)
+			}
+		}
+	}
+	for _, c := range p.Conflicts {
+		fmt.Fprintf(&b, "! %s %s: %s\n", c.Kind, c.Name, c.Reason)
+	}
+	if b.Len() == 0 {
+		b.WriteString("no changes\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func key(kind Kind, name string) string { return string(kind) + ":" + name }
+
+// cluster is the live state a plan is computed from.
+type cluster struct {
+	accounts   []sdk.Account
+	volumes    []sdk.Volume
+	groups     []sdk.VolumeAccessGroup
+	initiators []sdk.Initiator
+	schedules  []sdk.Schedule
+	policies   []sdk.QoSPolicy
+}
+
+func (r *Reconciler) load(ctx context.Context) (*cluster, error) {
+	var c cluster
+	accounts, err := r.sf.ListAccounts(ctx, &sdk.ListAccountsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	c.accounts = accounts.Accounts
+	for v, err := range r.sf.AllVolumes(ctx, sdk.ListVolumesRequest{}, r.PageSize) {
+		if err != nil {
+			return nil, err
+		}
+		c.volumes = append(c.volumes, v)
+	}
+	groups, err := r.sf.ListVolumeAccessGroups(ctx, &sdk.ListVolumeAccessGroupsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	c.groups = groups.VolumeAccessGroups
+	initiators, err := r.sf.ListInitiators(ctx, &sdk.ListInitiatorsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	c.initiators = initiators.Initiators
+	schedules, err := r.sf.ListSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range schedules.Schedules {
+		if !s.ToBeDeleted {
+			c.schedules = append(c.schedules, s)
+		}
+	}
+	policies, err := r.sf.ListQoSPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.policies = policies.QosPolicies
+	return &c, nil
+}
+
+// planner builds a Plan; deletes are collected per kind and appended last.
+type planner struct {
+	m       *Manifest
+	live    *cluster
+	plan    *Plan
+	purge   bool
+	deletes map[Kind][]Change
+	// skipped holds manifest accounts, volumes and groups that are in conflict.
+	skipped map[string]bool
+	// refs names live volumes as "account/volume".
+	refs map[int64]string
+	// groups maps the manifest groups that will be applied to their live group,
+	// or nil for groups that will be created.
+	groups map[string]*sdk.VolumeAccessGroup
+}
+
+func (p *planner) owns(attrs interface{}) bool {
+	return attributes(attrs)[OwnerAttribute] == p.m.Owner
+}
+
+func (p *planner) add(c Change) { p.plan.Changes = append(p.plan.Changes, c) }
+
+func (p *planner) remove(c Change) {
+	c.Action = Delete
+	p.deletes[c.Kind] = append(p.deletes[c.Kind], c)
+}
+
+func (p *planner) conflict(kind Kind, name, format string, args ...interface{}) {
+	p.plan.Conflicts = append(p.plan.Conflicts, Conflict{Kind: kind, Name: name, Reason: fmt.Sprintf(format, args...)})
+	p.skipped[key(kind, name)] = true
+}
+
+func (p *planner) build() {
+	accountNames := map[int64]string{}
+	for _, a := range p.live.accounts {
+		accountNames[a.AccountID] = a.Username
+	}
+	for _, v := range p.live.volumes {
+		p.refs[v.VolumeID] = accountNames[v.AccountID] + "/" + v.Name
+	}
+	p.planAccounts()
+	p.planVolumes()
+	p.planInitiators()
+	p.planGroups()
+	p.planSchedules()
+	for _, kind := range []Kind{KindSchedule, KindAccessGroup, KindInitiator, KindVolume, KindAccount} {
+		p.plan.Changes = append(p.plan.Changes, p.deletes[kind]...)
+	}
+}
+
+func (p *planner) planAccounts() {
+	wanted := map[string]bool{}
+	for _, a := range p.m.Accounts {
+		wanted[a.Name] = true
+		i := slices.IndexFunc(p.live.accounts, func(la sdk.Account) bool { return la.Username == a.Name })
+		switch {
+		case i < 0:
+			name := a.Name
+			p.add(Change{Action: Create, Kind: KindAccount, Name: name, apply: func(ctx context.Context, ap *applier) error {
+				req := sdk.AddAccountRequest{Username: name, Attributes: p.marker(nil)}
+				res, err := ap.sf.AddAccount(ctx, &req)
+				if err != nil {
+					return err
+				}
+				ap.ids[key(KindAccount, name)] = res.AccountID
+				return nil
+			}})
+		case !p.owns(p.live.accounts[i].Attributes):
+			p.conflict(KindAccount, a.Name, "exists but is not managed by %s", p.m.Owner)
+		default:
+			p.plan.ids[key(KindAccount, a.Name)] = p.live.accounts[i].AccountID
+		}
+	}
+
+	for _, la := range p.live.accounts {
+		if wanted[la.Username] || !p.owns(la.Attributes) {
+			continue
+		}
+		// Removing an account requires all its volumes to be deleted and purged.
+		var vols []sdk.Volume
+		foreign := false
+		for _, v := range p.live.volumes {
+			if v.AccountID == la.AccountID {
+				vols = append(vols, v)
+				foreign = foreign || !p.owns(v.Attributes)
+			}
+		}
+		if foreign {
+			p.conflict(KindAccount, la.Username, "cannot be removed: it holds volumes not managed by %s", p.m.Owner)
+			continue
+		}
+		// Without purge the volumes stay restorable, so the account stays until the
+		// cluster purges them.
+		for _, v := range vols {
+			p.removeVolume(v)
+		}
+		if !p.purge && len(vols) > 0 {
+			p.conflict(KindAccount, la.Username, "cannot be removed until its %d volumes are purged; enable purge to purge them now", len(vols))
+			continue
+		}
+		id := la.AccountID
+		p.remove(Change{Kind: KindAccount, Name: la.Username, ID: id, apply: func(ctx context.Context, ap *applier) error {
+			_, err := ap.sf.RemoveAccount(ctx, &sdk.RemoveAccountRequest{AccountID: id})
+			return ignoreNotFound(err)
+		}})
+	}
+}
+
+// marker returns attrs plus the ownership marker.
+func (p *planner) marker(attrs map[string]string) map[string]interface{} {
+	out := map[string]interface{}{OwnerAttribute: p.m.Owner}
+	for k, v := range attrs {
+		out[k] = v
+	}
+	return out
+}
+
+func (p *planner) removeVolume(v sdk.Volume) {
+	id := v.VolumeID
+	deleted := v.Status == "deleted"
+	purge := p.purge
+	c := Change{Kind: KindVolume, Name: p.refs[id], ID: id}
+	switch {
+	case deleted && !purge:
+		return
+	case deleted:
+		c.Details = []string{"purge"}
+	case purge:
+		c.Details = []string{"delete", "purge"}
+	}
+	c.apply = func(ctx context.Context, ap *applier) error {
+		if !deleted {
+			if _, err := ap.sf.DeleteVolume(ctx, &sdk.DeleteVolumeRequest{VolumeID: id}); ignoreNotFound(err) != nil {
+				return err
+			}
+		}
+		if purge {
+			_, err := ap.sf.PurgeDeletedVolume(ctx, &sdk.PurgeDeletedVolumeRequest{VolumeID: id})
+			return ignoreNotFound(err)
+		}
+		return nil
+	}
+	p.remove(c)
+}
+
+func (p *planner) planVolumes() {
+	policies := map[string]int64{}
+	policyNames := map[int64]string{}
+	for _, pol := range p.live.policies {
+		policies[pol.Name] = pol.QosPolicyID
+		policyNames[pol.QosPolicyID] = pol.Name
+	}
+	kept := map[int64]bool{}
+	for _, a := range p.m.Accounts {
+		if p.skipped[key(KindAccount, a.Name)] {
+			continue
+		}
+		accountID := p.plan.ids[key(KindAccount, a.Name)]
+		for _, v := range a.Volumes {
+			ref := a.Name + "/" + v.Name
+			var policyID int64
+			if v.QoSPolicy != "" {
+				id, ok := policies[v.QoSPolicy]
+				if !ok {
+					p.conflict(KindVolume, ref, "QoS policy %q does not exist", v.QoSPolicy)
+					continue
+				}
+				policyID = id
+			}
+			var found, owned []sdk.Volume
+			if accountID != 0 {
+				for _, lv := range p.live.volumes {
+					if lv.AccountID == accountID && lv.Name == v.Name && lv.Status != "deleted" {
+						found = append(found, lv)
+						if p.owns(lv.Attributes) {
+							owned = append(owned, lv)
+						}
+					}
+				}
+			}
+			switch {
+			case len(owned) > 1:
+				p.conflict(KindVolume, ref, "%d managed volumes have this name", len(owned))
+				for _, lv := range owned {
+					kept[lv.VolumeID] = true
+				}
+			case len(owned) == 0 && len(found) > 0:
+				p.conflict(KindVolume, ref, "exists but is not managed by %s", p.m.Owner)
+			case len(owned) == 0:
+				p.createVolume(a.Name, v, policyID)
+			default:
+				kept[owned[0].VolumeID] = true
+				p.plan.ids[key(KindVolume, ref)] = owned[0].VolumeID
+				p.updateVolume(ref, v, owned[0], policyID, policyNames)
+			}
+		}
+	}
+	for _, lv := range p.live.volumes {
+		if kept[lv.VolumeID] || !p.owns(lv.Attributes) || lv.Status == "deleted" {
+			continue
+		}
+		// Volumes of removed accounts were already planned by planAccounts.
+		if slices.ContainsFunc(p.deletes[KindVolume], func(c Change) bool { return c.ID == lv.VolumeID }) {
+			continue
+		}
+		p.removeVolume(lv)
+	}
+}
+
+func (p *planner) createVolume(account string, v Volume, policyID int64) {
+	ref := account + "/" + v.Name
+	details := []string{fmt.Sprintf("size=%dGiB", v.SizeGiB)}
+	if v.Enable512e {
+		details = append(details, "512e")
+	}
+	req := sdk.CreateVolumeRequest{Name: v.Name, TotalSize: v.SizeGiB * GiB, Enable512e: v.Enable512e, Attributes: p.marker(v.Attributes)}
+	if v.QoS != nil {
+		req.Qos = &sdk.QoS{MinIOPS: v.QoS.MinIOPS, MaxIOPS: v.QoS.MaxIOPS, BurstIOPS: v.QoS.BurstIOPS}
+		details = append(details, fmt.Sprintf("qos=%d/%d/%d", v.QoS.MinIOPS, v.QoS.MaxIOPS, v.QoS.BurstIOPS))
+	}
+	if policyID != 0 {
+		req.QosPolicyID = policyID
+		details = append(details, "qosPolicy="+v.QoSPolicy)
+	}
+	p.add(Change{Action: Create, Kind: KindVolume, Name: ref, Details: details, apply: func(ctx context.Context, ap *applier) error {
+		r := req
+		r.AccountID = ap.ids[key(KindAccount, account)]
+		res, err := ap.sf.CreateVolume(ctx, &r)
+		if err != nil {
+			return err
+		}
+		ap.ids[key(KindVolume, ref)] = res.VolumeID
+		return nil
+	}})
+}
+
+func (p *planner) updateVolume(ref string, v Volume, lv sdk.Volume, policyID int64, policyNames map[int64]string) {
+	if size := v.SizeGiB * GiB; size < lv.TotalSize {
+		p.conflict(KindVolume, ref, "cannot shrink from %d to %d bytes", lv.TotalSize, size)
+		return
+	}
+	if v.Enable512e != lv.Enable512e {
+		p.conflict(KindVolume, ref, "enable512e cannot be changed on an existing volume")
+		return
+	}
+	req := sdk.ModifyVolumeRequest{VolumeID: lv.VolumeID}
+	var diffs []string
+	if size := v.SizeGiB * GiB; size > lv.TotalSize {
+		req.TotalSize = size
+		diffs = append(diffs, fmt.Sprintf("size: %d -> %d", lv.TotalSize, size))
+	}
+	switch {
+	case policyID != 0 && policyID != lv.QosPolicyID:
+		req.QosPolicyID = policyID
+		diffs = append(diffs, fmt.Sprintf("qosPolicy: %q -> %q", policyNames[lv.QosPolicyID], v.QoSPolicy))
+	case v.QoS != nil:
+		q := &sdk.QoS{}
+		field := func(name string, want, have int64, set *int64) {
+			if want != 0 && want != have {
+				*set = want
+				diffs = append(diffs, fmt.Sprintf("qos.%s: %d -> %d", name, have, want))
+			}
+		}
+		field("minIOPS", v.QoS.MinIOPS, lv.Qos.MinIOPS, &q.MinIOPS)
+		field("maxIOPS", v.QoS.MaxIOPS, lv.Qos.MaxIOPS, &q.MaxIOPS)
+		field("burstIOPS", v.QoS.BurstIOPS, lv.Qos.BurstIOPS, &q.BurstIOPS)
+		if lv.QosPolicyID != 0 {
+			*q = sdk.QoS{MinIOPS: v.QoS.MinIOPS, MaxIOPS: v.QoS.MaxIOPS, BurstIOPS: v.QoS.BurstIOPS}
+			diffs = append(diffs, fmt.Sprintf("qosPolicy: %q -> none", policyNames[lv.QosPolicyID]))
+		}
+		if *q != (sdk.QoS{}) {
+			req.Qos = q
+		}
+	}
+	want := p.marker(v.Attributes)
+	if d := diffAttributes(attributes(lv.Attributes), want); len(d) > 0 {
+		req.Attributes = want
+		diffs = append(diffs, d...)
+	}
+	if len(diffs) == 0 {
+		return
+	}
+	p.add(Change{Action: Update, Kind: KindVolume, Name: ref, ID: lv.VolumeID, Details: diffs, apply: func(ctx context.Context, ap *applier) error {
+		if _, err := ap.sf.ModifyVolume(ctx, &req); err != nil {
+			return err
+		}
+		return nil
+	}})
+}
+
+// wantedInitiators returns the sorted, lower-cased IQNs of g, the form the cluster stores.
+func (p *planner) wantedInitiators(g AccessGroup) []string {
+	out := make([]string, 0, len(g.Initiators))
+	for _, iqn := range g.Initiators {
+		out = append(out, strings.ToLower(iqn))
+	}
+	sort.Strings(out)
+	return slices.Compact(out)
+}
+
+// resolveGroups matches manifest groups to live ones. Initiators are only created
+// for groups that are not in conflict, so this runs before planInitiators.
+func (p *planner) resolveGroups() map[string]*sdk.VolumeAccessGroup {
+	resolved := map[string]*sdk.VolumeAccessGroup{}
+	for _, g := range p.m.AccessGroups {
+		var found, owned []*sdk.VolumeAccessGroup
+		for i := range p.live.groups {
+			if lg := &p.live.groups[i]; lg.Name == g.Name {
+				found = append(found, lg)
+				if p.owns(lg.Attributes) {
+					owned = append(owned, lg)
+				}
+			}
+		}
+		switch {
+		case len(owned) > 1:
+			p.conflict(KindAccessGroup, g.Name, "%d managed access groups have this name", len(owned))
+		case len(owned) == 0 && len(found) > 0:
+			p.conflict(KindAccessGroup, g.Name, "exists but is not managed by %s", p.m.Owner)
+		case len(owned) == 1:
+			resolved[g.Name] = owned[0]
+			p.plan.ids[key(KindAccessGroup, g.Name)] = owned[0].VolumeAccessGroupID
+		default:
+			resolved[g.Name] = nil
+		}
+	}
+	return resolved
+}
+
+func (p *planner) planInitiators() {
+	groups := p.resolveGroups()
+	wanted := map[string]bool{}
+	for _, g := range p.m.AccessGroups {
+		if _, ok := groups[g.Name]; !ok {
+			continue
+		}
+		for _, iqn := range p.wantedInitiators(g) {
+			wanted[iqn] = true
+		}
+	}
+	live := map[string]sdk.Initiator{}
+	for _, li := range p.live.initiators {
+		live[strings.ToLower(li.InitiatorName)] = li
+	}
+	var create []string
+	for iqn := range wanted {
+		if _, ok := live[iqn]; !ok {
+			create = append(create, iqn)
+		}
+	}
+	sort.Strings(create)
+	for _, iqn := range create {
+		p.add(Change{Action: Create, Kind: KindInitiator, Name: iqn, apply: func(ctx context.Context, ap *applier) error {
+			req := sdk.CreateInitiatorsRequest{Initiators: []sdk.CreateInitiator{{Name: iqn, Attributes: p.marker(nil)}}}
+			res, err := ap.sf.CreateInitiators(ctx, &req)
+			if err != nil {
+				return err
+			}
+			if len(res.Initiators) > 0 {
+				ap.ids[key(KindInitiator, iqn)] = res.Initiators[0].InitiatorID
+			}
+			return nil
+		}})
+	}
+	for _, li := range p.live.initiators {
+		if wanted[strings.ToLower(li.InitiatorName)] || !p.owns(li.Attributes) {
+			continue
+		}
+		id := li.InitiatorID
+		p.remove(Change{Kind: KindInitiator, Name: li.InitiatorName, ID: id, apply: func(ctx context.Context, ap *applier) error {
+			_, err := ap.sf.DeleteInitiators(ctx, &sdk.DeleteInitiatorsRequest{Initiators: []int64{id}})
+			return ignoreNotFound(err)
+		}})
+	}
+	p.groups = groups
+}
+
+func (p *planner) planGroups() {
+	for _, g := range p.m.AccessGroups {
+		lg, ok := p.groups[g.Name]
+		if !ok {
+			continue
+		}
+		initiators := p.wantedInitiators(g)
+		refs := slices.Clone(g.Volumes)
+		sort.Strings(refs)
+		refs = slices.Compact(refs)
+		name := g.Name
+		if lg == nil {
+			details := []string{fmt.Sprintf("initiators=%d", len(initiators)), fmt.Sprintf("volumes=%d", len(refs))}
+			p.add(Change{Action: Create, Kind: KindAccessGroup, Name: name, Details: details, apply: func(ctx context.Context, ap *applier) error {
+				req := sdk.CreateVolumeAccessGroupRequest{Name: name, Initiators: initiators, Volumes: ap.volumeIDs(refs), Attributes: p.marker(nil)}
+				res, err := ap.sf.CreateVolumeAccessGroup(ctx, &req)
+				if err != nil {
+					return err
+				}
+				ap.ids[key(KindAccessGroup, name)] = res.VolumeAccessGroupID
+				return nil
+			}})
+			continue
+		}
+
+		id := lg.VolumeAccessGroupID
+		var diffs, addInit, delInit, addVols []string
+		var delVols []int64
+		have := map[string]bool{}
+		for _, iqn := range lg.Initiators {
+			have[strings.ToLower(iqn)] = true
+			if !slices.Contains(initiators, strings.ToLower(iqn)) {
+				delInit = append(delInit, iqn)
+				diffs = append(diffs, "initiators: -"+iqn)
+			}
+		}
+		for _, iqn := range initiators {
+			if !have[iqn] {
+				addInit = append(addInit, iqn)
+				diffs = append(diffs, "initiators: +"+iqn)
+			}
+		}
+		haveVols := map[string]bool{}
+		for _, vid := range lg.Volumes {
+			ref, known := p.refs[vid]
+			if !known {
+				ref = fmt.Sprintf("#%d", vid)
+			}
+			haveVols[ref] = true
+			if !slices.Contains(refs, ref) {
+				delVols = append(delVols, vid)
+				diffs = append(diffs, "volumes: -"+ref)
+			}
+		}
+		for _, ref := range refs {
+			if !haveVols[ref] {
+				addVols = append(addVols, ref)
+				diffs = append(diffs, "volumes: +"+ref)
+			}
+		}
+		if len(diffs) == 0 {
+			continue
+		}
+		p.add(Change{Action: Update, Kind: KindAccessGroup, Name: name, ID: id, Details: diffs, apply: func(ctx context.Context, ap *applier) error {
+			if len(delVols) > 0 {
+				if _, err := ap.sf.RemoveVolumesFromVolumeAccessGroup(ctx, &sdk.RemoveVolumesFromVolumeAccessGroupRequest{VolumeAccessGroupID: id, Volumes: delVols}); err != nil {
+					return err
+				}
+			}
+			if len(delInit) > 0 {
+				if _, err := ap.sf.RemoveInitiatorsFromVolumeAccessGroup(ctx, &sdk.RemoveInitiatorsFromVolumeAccessGroupRequest{VolumeAccessGroupID: id, Initiators: delInit}); err != nil {
+					return err
+				}
+			}
+			if len(addInit) > 0 {
+				if _, err := ap.sf.AddInitiatorsToVolumeAccessGroup(ctx, &sdk.AddInitiatorsToVolumeAccessGroupRequest{VolumeAccessGroupID: id, Initiators: addInit}); err != nil {
+					return err
+				}
+			}
+			if len(addVols) > 0 {
+				if _, err := ap.sf.AddVolumesToVolumeAccessGroup(ctx, &sdk.AddVolumesToVolumeAccessGroupRequest{VolumeAccessGroupID: id, Volumes: ap.volumeIDs(addVols)}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}})
+	}
+
+	for _, lg := range p.live.groups {
+		if !p.owns(lg.Attributes) || slices.ContainsFunc(p.m.AccessGroups, func(g AccessGroup) bool { return g.Name == lg.Name }) {
+			continue
+		}
+		id := lg.VolumeAccessGroupID
+		p.remove(Change{Kind: KindAccessGroup, Name: lg.Name, ID: id, apply: func(ctx context.Context, ap *applier) error {
+			_, err := ap.sf.DeleteVolumeAccessGroup(ctx, &sdk.DeleteVolumeAccessGroupRequest{VolumeAccessGroupID: id})
+			return ignoreNotFound(err)
+		}})
+	}
+}
+
+// frequency is the schedule attribute Element's UI uses to tell schedule types apart.
+func frequency(s Schedule) string {
+	switch {
+	case len(s.Monthdays) > 0:
+		return "Days Of Month"
+	case s.Weekdays != 0:
+		return "Days Of Week"
+	default:
+		return "Time Interval"
+	}
+}
+
+func (p *planner) planSchedules() {
+	for _, s := range p.m.Schedules {
+		var found, owned []*sdk.Schedule
+		for i := range p.live.schedules {
+			if ls := &p.live.schedules[i]; ls.ScheduleName == s.Name {
+				found = append(found, ls)
+				if p.owns(ls.Attributes) {
+					owned = append(owned, ls)
+				}
+			}
+		}
+		refs := slices.Clone(s.Volumes)
+		sort.Strings(refs)
+		refs = slices.Compact(refs)
+		attrs := p.marker(map[string]string{"frequency": frequency(s)})
+		build := func(ap *applier) (sdk.ScheduleInfo, []int64) {
+			ids := ap.volumeIDs(refs)
+			return sdk.ScheduleInfo{Name: s.SnapshotName, Volumes: ids, Retention: s.Retention}, ids
+		}
+
+		switch {
+		case len(owned) > 1:
+			p.conflict(KindSchedule, s.Name, "%d managed schedules have this name", len(owned))
+		case len(owned) == 0 && len(found) > 0:
+			p.conflict(KindSchedule, s.Name, "exists but is not managed by %s", p.m.Owner)
+		case len(owned) == 0:
+			s := s
+			details := []string{"frequency=" + frequency(s), fmt.Sprintf("volumes=%d", len(refs))}
+			p.add(Change{Action: Create, Kind: KindSchedule, Name: s.Name, Details: details, apply: func(ctx context.Context, ap *applier) error {
+				info, _ := build(ap)
+				req := sdk.CreateScheduleRequest{
+					ScheduleName: s.Name, ScheduleType: "Snapshot", Attributes: attrs, ScheduleInfo: info,
+					Hours: s.Hours, Minutes: s.Minutes, Weekdays: s.Weekdays, Monthdays: s.Monthdays,
+					Recurring: true, Paused: s.Paused,
+				}
+				res, err := ap.sf.CreateSchedule(ctx, &req)
+				if err != nil {
+					return err
+				}
+				ap.ids[key(KindSchedule, s.Name)] = res.ScheduleID
+				return nil
+			}})
+		default:
+			ls := owned[0]
+			var diffs []string
+			diff := func(field string, have, want interface{}) {
+				if fmt.Sprint(have) != fmt.Sprint(want) {
+					diffs = append(diffs, fmt.Sprintf("%s: %v -> %v", field, have, want))
+				}
+			}
+			var haveRefs []string
+			for _, id := range append([]int64{ls.ScheduleInfo.VolumeID}, ls.ScheduleInfo.Volumes...) {
+				if id != 0 {
+					haveRefs = append(haveRefs, p.refs[id])
+				}
+			}
+			sort.Strings(haveRefs)
+			diff("hours", ls.Hours, s.Hours)
+			diff("minutes", ls.Minutes, s.Minutes)
+			diff("weekdays", ls.Weekdays, s.Weekdays)
+			diff("monthdays", ls.Monthdays, s.Monthdays)
+			diff("paused", ls.Paused, s.Paused)
+			diff("snapshotName", ls.ScheduleInfo.Name, s.SnapshotName)
+			diff("retention", ls.ScheduleInfo.Retention, s.Retention)
+			diff("volumes", haveRefs, refs)
+			diffs = append(diffs, diffAttributes(attributes(ls.Attributes), attrs)...)
+			if len(diffs) == 0 {
+				continue
+			}
+			id := ls.ScheduleID
+			s := s
+			p.add(Change{Action: Update, Kind: KindSchedule, Name: s.Name, ID: id, Details: diffs, apply: func(ctx context.Context, ap *applier) error {
+				info, _ := build(ap)
+				req := sdk.ModifyScheduleRequest{
+					ScheduleID: id, ScheduleName: s.Name, ScheduleType: "Snapshot", Attributes: attrs, ScheduleInfo: info,
+					Hours: s.Hours, Minutes: s.Minutes, Weekdays: s.Weekdays, Monthdays: s.Monthdays,
+					Recurring: true, Paused: s.Paused,
+				}
+				if _, err := ap.sf.ModifySchedule(ctx, &req); err != nil {
+					return err
+				}
+				return nil
+			}})
+		}
+	}
+
+	for _, ls := range p.live.schedules {
+		if !p.owns(ls.Attributes) || slices.ContainsFunc(p.m.Schedules, func(s Schedule) bool { return s.Name == ls.ScheduleName }) {
+			continue
+		}
+		ls := ls
+		p.remove(Change{Kind: KindSchedule, Name: ls.ScheduleName, ID: ls.ScheduleID, apply: func(ctx context.Context, ap *applier) error {
+			// Element has no DeleteSchedule; a schedule is removed by marking it toBeDeleted.
+			req := sdk.ModifyScheduleRequest{
+				ScheduleID: ls.ScheduleID, ScheduleName: ls.ScheduleName, ScheduleType: ls.ScheduleType,
+				Attributes: ls.Attributes, ScheduleInfo: ls.ScheduleInfo, Hours: ls.Hours, Minutes: ls.Minutes,
+				ToBeDeleted: true,
+			}
+			_, err := ap.sf.ModifySchedule(ctx, &req)
+			return ignoreNotFound(err)
+		}})
+	}
+}
+
+// attributes returns the attribute map of a cluster object, or nil.
+func attributes(attrs interface{}) map[string]interface{} {
+	m, _ := attrs.(map[string]interface{})
+	return m
+}
+
+// diffAttributes lists the "attributes.key: old -> new" differences between have and want.
+func diffAttributes(have, want map[string]interface{}) []string {
+	var diffs []string
+	keys := map[string]bool{}
+	for k := range have {
+		keys[k] = true
+	}
+	for k := range want {
+		keys[k] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	for _, k := range sorted {
+		h, hok := have[k]
+		w, wok := want[k]
+		switch {
+		case !hok:
+			diffs = append(diffs, fmt.Sprintf("attributes.%s: -> %v", k, w))
+		case !wok:
+			diffs = append(diffs, fmt.Sprintf("attributes.%s: %v ->", k, h))
+		case fmt.Sprint(h) != fmt.Sprint(w):
+			diffs = append(diffs, fmt.Sprintf("attributes.%s: %v -> %v", k, h, w))
+		}
+	}
+	return diffs
+}
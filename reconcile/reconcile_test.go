@@ -0,0 +1,267 @@
+package reconcile
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+const manifestYAML = `
+owner: team-a
+accounts:
+  - name: tenant1
+    volumes:
+      - name: db
+        sizeGiB: 10
+        enable512e: true
+        qos: {minIOPS: 100, maxIOPS: 1000, burstIOPS: 2000}
+        attributes: {app: postgres}
+      - name: logs
+        sizeGiB: 2
+accessGroups:
+  - name: host1
+    initiators: [iqn.1993-08.org.debian:01:host1]
+    volumes: [tenant1/db, tenant1/logs]
+schedules:
+  - name: nightly
+    volumes: [tenant1/db]
+    snapshotName: nightly
+    retention: "72:00:00"
+    hours: 24
+`
+
+func newReconciler(t *testing.T) (*Reconciler, *sdktest.Server, *sdk.SFClient) {
+	t.Helper()
+	srv := sdktest.NewServer()
+	t.Cleanup(srv.Close)
+	sf, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(sf), srv, sf
+}
+
+func mustParse(t *testing.T, s string) *Manifest {
+	t.Helper()
+	m, err := Parse([]byte(s))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	return m
+}
+
+func diff(t *testing.T, p *Plan) string {
+	t.Helper()
+	var b strings.Builder
+	if err := p.WriteDiff(&b); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestReconcileCreatesAndIsIdempotent(t *testing.T) {
+	r, srv, sf := newReconciler(t)
+	ctx := context.Background()
+	m := mustParse(t, manifestYAML)
+
+	plan, err := r.Reconcile(ctx, m, true)
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	out := diff(t, plan)
+	for _, want := range []string{"+ account tenant1", "+ volume tenant1/db (size=10GiB, 512e, qos=100/1000/2000)", "+ initiator iqn.1993-08.org.debian:01:host1", "+ accessGroup host1", "+ schedule nightly"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("dry run diff is missing %q:\n%s", want, out)
+		}
+	}
+	if srv.CallCount("AddAccount")+srv.CallCount("CreateVolume") != 0 {
+		t.Fatal("dry run changed the cluster")
+	}
+
+	if _, err := r.Reconcile(ctx, m, false); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	again, err := r.Plan(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !again.Empty() || len(again.Conflicts) > 0 {
+		t.Fatalf("second plan is not empty:\n%s", diff(t, again))
+	}
+
+	groups, _ := sf.ListVolumeAccessGroups(ctx, &sdk.ListVolumeAccessGroupsRequest{})
+	if len(groups.VolumeAccessGroups) != 1 || len(groups.VolumeAccessGroups[0].Volumes) != 2 {
+		t.Fatalf("unexpected access groups %+v", groups.VolumeAccessGroups)
+	}
+	scheds, _ := sf.ListSchedules(ctx)
+	if len(scheds.Schedules) != 1 || len(scheds.Schedules[0].ScheduleInfo.Volumes) != 1 {
+		t.Fatalf("unexpected schedules %+v", scheds.Schedules)
+	}
+}
+
+func TestReconcileUpdatesAndDeletes(t *testing.T) {
+	r, _, sf := newReconciler(t)
+	ctx := context.Background()
+	if _, err := r.Reconcile(ctx, mustParse(t, manifestYAML), false); err != nil {
+		t.Fatal(err)
+	}
+
+	changed := strings.NewReplacer("sizeGiB: 10", "sizeGiB: 20", "maxIOPS: 1000", "maxIOPS: 1500", ", tenant1/logs]", "]").Replace(manifestYAML)
+	changed = strings.Replace(changed, "      - name: logs\n        sizeGiB: 2\n", "", 1)
+	m := mustParse(t, changed)
+	plan, err := r.Plan(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := diff(t, plan)
+	for _, want := range []string{"~ volume tenant1/db", "    size: 10737418240 -> 21474836480", "    qos.maxIOPS: 1000 -> 1500", "~ accessGroup host1", "    volumes: -tenant1/logs", "- volume tenant1/logs"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("diff is missing %q:\n%s", want, out)
+		}
+	}
+	if err := r.Apply(ctx, plan); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if again, _ := r.Plan(ctx, m); !again.Empty() {
+		t.Fatalf("plan after update is not empty:\n%s", diff(t, again))
+	}
+
+	// With purge, an empty manifest for the owner removes everything, purging the
+	// account's volumes.
+	r.Purge = true
+	empty := mustParse(t, "owner: team-a\n")
+	if _, err := r.Reconcile(ctx, empty, false); err != nil {
+		t.Fatalf("Reconcile empty: %v", err)
+	}
+	accounts, _ := sf.ListAccounts(ctx, &sdk.ListAccountsRequest{})
+	inits, _ := sf.ListInitiators(ctx, &sdk.ListInitiatorsRequest{})
+	scheds, _ := sf.ListSchedules(ctx)
+	if len(accounts.Accounts) != 0 || len(inits.Initiators) != 0 || len(scheds.Schedules) != 0 {
+		t.Fatalf("objects left behind: %+v %+v %+v", accounts.Accounts, inits.Initiators, scheds.Schedules)
+	}
+}
+
+func TestReconcileRemovesAccountWithoutPurge(t *testing.T) {
+	r, _, sf := newReconciler(t)
+	ctx := context.Background()
+	if _, err := r.Reconcile(ctx, mustParse(t, manifestYAML), false); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without purge the volumes are deleted but stay restorable, and the account stays.
+	empty := mustParse(t, "owner: team-a\n")
+	plan, err := r.Reconcile(ctx, empty, false)
+	if err != nil {
+		t.Fatalf("Reconcile empty: %v", err)
+	}
+	if len(plan.Conflicts) != 1 || plan.Conflicts[0].Kind != KindAccount || !strings.Contains(plan.Conflicts[0].Reason, "purged") {
+		t.Fatalf("expected an account conflict, got %+v", plan.Conflicts)
+	}
+	for _, c := range plan.Changes {
+		if c.Kind == KindAccount || slices.Contains(c.Details, "purge") {
+			t.Fatalf("unexpected change %+v", c)
+		}
+	}
+	deleted, _ := sf.ListDeletedVolumes(ctx, &sdk.ListDeletedVolumesRequest{})
+	accounts, _ := sf.ListAccounts(ctx, &sdk.ListAccountsRequest{})
+	if len(deleted.Volumes) != 2 || len(accounts.Accounts) != 1 {
+		t.Fatalf("%d deleted volumes, %d accounts", len(deleted.Volumes), len(accounts.Accounts))
+	}
+
+	// Once the cluster has purged the volumes, the account goes.
+	for _, v := range deleted.Volumes {
+		if _, err := sf.PurgeDeletedVolume(ctx, &sdk.PurgeDeletedVolumeRequest{VolumeID: v.VolumeID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	plan, err = r.Reconcile(ctx, empty, false)
+	if err != nil || len(plan.Conflicts) != 0 {
+		t.Fatalf("Reconcile after purge: %v %+v", err, plan.Conflicts)
+	}
+	if accounts, _ := sf.ListAccounts(ctx, &sdk.ListAccountsRequest{}); len(accounts.Accounts) != 0 {
+		t.Fatalf("account left behind: %+v", accounts.Accounts)
+	}
+}
+
+func TestReconcileLeavesUnmanagedObjects(t *testing.T) {
+	r, _, sf := newReconciler(t)
+	ctx := context.Background()
+
+	// Objects created outside the reconciler, one of them with a manifest name.
+	acct, err := sf.AddAccount(ctx, &sdk.AddAccountRequest{Username: "tenant1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := sf.AddAccount(ctx, &sdk.AddAccountRequest{Username: "legacy"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	vol, err := sf.CreateVolume(ctx, &sdk.CreateVolumeRequest{Name: "old", AccountID: other.AccountID, TotalSize: 1 << 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, planErr := r.Reconcile(ctx, mustParse(t, manifestYAML), false)
+	if planErr != nil {
+		t.Fatalf("Reconcile: %v", planErr)
+	}
+	if len(plan.Conflicts) != 1 || plan.Conflicts[0].Kind != KindAccount || plan.Conflicts[0].Name != "tenant1" {
+		t.Fatalf("expected an account conflict, got %+v", plan.Conflicts)
+	}
+	for _, c := range plan.Changes {
+		if c.Kind == KindVolume || c.Kind == KindAccount || c.Action == Delete {
+			t.Fatalf("unexpected change %+v", c)
+		}
+	}
+	if _, err := sf.GetAccountByID(ctx, &sdk.GetAccountByIDRequest{AccountID: acct.AccountID}); err != nil {
+		t.Fatalf("unmanaged account was touched: %v", err)
+	}
+	vols, _ := sf.ListVolumes(ctx, &sdk.ListVolumesRequest{VolumeIDs: []int64{vol.VolumeID}})
+	if len(vols.Volumes) != 1 || vols.Volumes[0].Status != "active" {
+		t.Fatalf("unmanaged volume was touched: %+v", vols.Volumes)
+	}
+
+	// The other owner's plan for an empty manifest deletes nothing of team-a's.
+	plan, planErr = r.Plan(ctx, mustParse(t, "owner: team-b\n"))
+	if planErr != nil || !plan.Empty() {
+		t.Fatalf("team-b plan should be empty: %v\n%s", planErr, diff(t, plan))
+	}
+}
+
+func TestReconcileConflicts(t *testing.T) {
+	r, _, _ := newReconciler(t)
+	ctx := context.Background()
+	if _, err := r.Reconcile(ctx, mustParse(t, manifestYAML), false); err != nil {
+		t.Fatal(err)
+	}
+	shrunk := strings.Replace(manifestYAML, "sizeGiB: 10", "sizeGiB: 5", 1)
+	plan, err := r.Plan(ctx, mustParse(t, shrunk))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Conflicts) != 1 || !strings.Contains(plan.Conflicts[0].Reason, "shrink") || !plan.Empty() {
+		t.Fatalf("expected a shrink conflict and no changes:\n%s", diff(t, plan))
+	}
+}
+
+func TestParseValidates(t *testing.T) {
+	for name, doc := range map[string]string{
+		"no owner":       "accounts: [{name: a}]",
+		"unknown field":  "owner: x\nacounts: []",
+		"bad reference":  "owner: x\naccessGroups: [{name: g, volumes: [a/missing]}]",
+		"qos and policy": "owner: x\naccounts: [{name: a, volumes: [{name: v, sizeGiB: 1, qos: {maxIOPS: 1}, qosPolicy: gold}]}]",
+		"owner attr":     "owner: x\naccounts: [{name: a, volumes: [{name: v, sizeGiB: 1, attributes: {reconcile-owner: y}}]}]",
+	} {
+		if _, err := Parse([]byte(doc)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	// JSON is accepted as well.
+	if _, err := Parse([]byte(`{"owner": "x", "accounts": [{"name": "a", "volumes": [{"name": "v", "sizeGiB": 1}]}]}`)); err != nil {
+		t.Fatalf("JSON manifest: %v", err)
+	}
+}
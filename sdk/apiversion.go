@@ -0,0 +1,207 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// AutoVersion, passed to Connect as the version, negotiates the endpoint version:
+// the highest version in GetAPI's supportedVersions that is not newer than
+// MaxAPIVersion (or the limit set with WithMaxAPIVersion).
+const AutoVersion = "auto"
+
+// MaxAPIVersion is the newest endpoint version negotiation picks by default.
+const MaxAPIVersion = "12.9"
+
+// ErrUnsupportedVersion matches errors for methods and request fields that the
+// connected endpoint version does not have.
+var ErrUnsupportedVersion = errors.New("sdk: not supported by the cluster API version")
+
+// VersionError is the error carried (as SdkError.Err) by a call that was refused
+// because the method or one of the request fields needs a newer API version than
+// the client is connected with. Get it with errors.As.
+type VersionError struct {
+	Method string
+	// Field is the JSON name of the request member, or empty if the method itself
+	// is not supported.
+	Field string
+	// Required is the first API version with the method or field.
+	Required string
+	// Connected is the endpoint version the client uses.
+	Connected string
+}
+
+func (e *VersionError) Error() string {
+	what := e.Method
+	if e.Field != "" {
+		what = fmt.Sprintf("%s parameter %q", e.Method, e.Field)
+	}
+	return fmt.Sprintf("%s requires API version %s, connected with %s", what, e.Required, e.Connected)
+}
+
+// Is makes a VersionError match ErrUnsupportedVersion.
+func (e *VersionError) Is(target error) bool { return target == ErrUnsupportedVersion }
+
+// IsUnsupportedVersion reports whether err was returned for a method or request field
+// that the connected API version does not have.
+func IsUnsupportedVersion(err error) bool { return errors.Is(err, ErrUnsupportedVersion) }
+
+// versionedMember is a request member that needs a newer API version than its method.
+type versionedMember struct {
+	Field string
+	JSON  string
+	Since string
+}
+
+// WithMaxAPIVersion caps the version that Connect negotiates with AutoVersion, for
+// example to keep an application on the endpoint it was tested with.
+func WithMaxAPIVersion(version string) ClientOption {
+	return func(sfClient *SFClient) error {
+		if _, err := parseVersion(version); err != nil {
+			return err
+		}
+		sfClient.maxAPIVersion = version
+		return nil
+	}
+}
+
+// APIVersion returns the endpoint version the client is connected with, or "" before
+// Connect.
+func (sfClient *SFClient) APIVersion() string {
+	return sfClient.apiVersion
+}
+
+// ClusterVersion returns the cluster's current API version as reported by GetAPI
+// during Connect, which can be newer than APIVersion.
+func (sfClient *SFClient) ClusterVersion() string {
+	return sfClient.clusterVersion
+}
+
+// Supports reports whether the connected endpoint version has method. It is true for
+// methods without a known minimum version and before Connect.
+func (sfClient *SFClient) Supports(method string) bool {
+	return sfClient.checkVersion(method, nil) == nil
+}
+
+// negotiateVersion picks the highest of supported that is not above the client's limit.
+func (sfClient *SFClient) negotiateVersion(supported []string) (string, error) {
+	limit := sfClient.maxAPIVersion
+	if limit == "" {
+		limit = MaxAPIVersion
+	}
+	best := ""
+	for _, v := range supported {
+		if _, err := parseVersion(v); err != nil {
+			continue
+		}
+		if CompareVersions(v, limit) <= 0 && (best == "" || CompareVersions(v, best) > 0) {
+			best = v
+		}
+	}
+	if best == "" {
+		return "", fmt.Errorf("no supported API version up to %s among %v", limit, supported)
+	}
+	return best, nil
+}
+
+// checkVersion returns an error if method, or a member set in params, needs a newer
+// version than the connected endpoint. Calls made before Connect are not checked.
+func (sfClient *SFClient) checkVersion(method string, params interface{}) *SdkError {
+	connected := sfClient.apiVersion
+	if connected == "" {
+		return nil
+	}
+	if since, ok := methodSince[method]; ok && CompareVersions(connected, since) < 0 {
+		return versionError(&VersionError{Method: method, Required: since, Connected: connected})
+	}
+	for _, m := range requestSince[method] {
+		if CompareVersions(connected, m.Since) >= 0 || !memberSet(params, m) {
+			continue
+		}
+		return versionError(&VersionError{Method: method, Field: m.JSON, Required: m.Since, Connected: connected})
+	}
+	return nil
+}
+
+func versionError(e *VersionError) *SdkError {
+	return &SdkError{Code: "sdk.version", Detail: e.Error(), Method: e.Method, Err: e}
+}
+
+// memberSet reports whether params, a request struct (or pointer to one) or a map
+// keyed by JSON name, carries a non-zero value for m. A dotted member is set when
+// any element of the list it is in sets it.
+func memberSet(params interface{}, m versionedMember) bool {
+	return pathSet(reflect.ValueOf(params), strings.Split(m.Field, "."), strings.Split(m.JSON, "."))
+}
+
+func pathSet(v reflect.Value, fields, keys []string) bool {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+	if len(fields) == 0 {
+		return v.IsValid() && !v.IsZero()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		f := v.FieldByName(fields[0])
+		return f.IsValid() && pathSet(f, fields[1:], keys[1:])
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return false
+		}
+		e := v.MapIndex(reflect.ValueOf(keys[0]).Convert(v.Type().Key()))
+		if len(fields) == 1 {
+			return e.IsValid()
+		}
+		return e.IsValid() && pathSet(e, fields[1:], keys[1:])
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if pathSet(v.Index(i), fields, keys) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CompareVersions compares two dotted API versions such as "12.5" and "9.0"
+// numerically, returning -1, 0 or 1. Versions that do not parse compare as 0.0.
+func CompareVersions(a, b string) int {
+	pa, _ := parseVersion(a)
+	pb, _ := parseVersion(b)
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func parseVersion(v string) ([]int, error) {
+	parts := strings.Split(v, ".")
+	out := make([]int, len(parts))
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid API version %q", v)
+		}
+		out[i] = n
+	}
+	return out, nil
+}
@@ -0,0 +1,143 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+func connect(t *testing.T, srv *sdktest.Server, version string, opts ...sdk.ClientOption) *sdk.SFClient {
+	t.Helper()
+	sf, err := sdk.NewSFClient(append(srv.ClientOptions(), opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sdkErr := sf.Connect(context.Background(), srv.Host(), version, srv.Username, srv.Password); sdkErr != nil {
+		t.Fatal(sdkErr)
+	}
+	return sf
+}
+
+func TestConnectNegotiatesVersion(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+	srv.Version = "12.7"
+
+	sf := connect(t, srv, sdk.AutoVersion)
+	if sf.APIVersion() != "12.7" || sf.ClusterVersion() != "12.7" {
+		t.Errorf("connected with %s to cluster %s", sf.APIVersion(), sf.ClusterVersion())
+	}
+	if _, err := sf.ListAccounts(context.Background(), &sdk.ListAccountsRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	calls := srv.Calls()
+	if calls[0].Method != "GetAPI" || calls[0].APIVersion != "1.0" || calls[len(calls)-1].APIVersion != "12.7" {
+		t.Errorf("calls = %+v", calls)
+	}
+
+	capped := connect(t, srv, "", sdk.WithMaxAPIVersion("12.3"))
+	if capped.APIVersion() != "12.3" || capped.ClusterVersion() != "12.7" {
+		t.Errorf("capped client connected with %s to cluster %s", capped.APIVersion(), capped.ClusterVersion())
+	}
+
+	fixed := connect(t, srv, "11.0")
+	if fixed.APIVersion() != "11.0" || fixed.ClusterVersion() != "12.7" {
+		t.Errorf("fixed client connected with %s to cluster %s", fixed.APIVersion(), fixed.ClusterVersion())
+	}
+
+	if _, err := sdk.NewSFClient(sdk.WithMaxAPIVersion("latest")); err == nil {
+		t.Error("WithMaxAPIVersion accepted an invalid version")
+	}
+}
+
+func TestConnectUnknownVersion(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+	srv.Version = "11.8"
+
+	sf, err := sdk.NewSFClient(srv.ClientOptions()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdkErr := sf.Connect(context.Background(), srv.Host(), "12.5", srv.Username, srv.Password)
+	if sdkErr == nil || sdkErr.Name != "xUnknownAPIVersion" {
+		t.Fatalf("Connect to a newer endpoint = %v, want xUnknownAPIVersion", sdkErr)
+	}
+}
+
+func TestVersionGating(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+	srv.Version = "11.8"
+	sf := connect(t, srv, sdk.AutoVersion)
+	ctx := context.Background()
+
+	before := len(srv.Calls())
+	_, sdkErr := sf.ListIdpConfigurations(ctx, &sdk.ListIdpConfigurationsRequest{})
+	var verr *sdk.VersionError
+	if !errors.As(sdkErr, &verr) || verr.Method != "ListIdpConfigurations" || verr.Required != "12.0" || verr.Connected != "11.8" || verr.Field != "" {
+		t.Fatalf("err = %v, want a VersionError", sdkErr)
+	}
+	if !sdk.IsUnsupportedVersion(sdkErr) || sf.Supports("ListIdpConfigurations") || !sf.Supports("ListAccounts") {
+		t.Error("ListIdpConfigurations reported as supported")
+	}
+
+	acct, sdkErr := sf.AddAccount(ctx, &sdk.AddAccountRequest{Username: "tenant"})
+	if sdkErr != nil {
+		t.Fatal(sdkErr)
+	}
+	req := &sdk.CreateVolumeRequest{Name: "v", AccountID: acct.AccountID, TotalSize: 1 << 30, ProtectionScheme: "doubleHelix"}
+	_, sdkErr = sf.CreateVolume(ctx, req)
+	if !errors.As(sdkErr, &verr) || verr.Field != "protectionScheme" || verr.Required != "12.5" {
+		t.Fatalf("err = %v, want a VersionError for protectionScheme", sdkErr)
+	}
+	_, sdkErr = sf.MakeSFCall(ctx, "CreateVolume", 1, map[string]interface{}{"name": "v", "protectionScheme": "doubleHelix"}, &sdk.CreateVolumeResult{})
+	if !sdk.IsUnsupportedVersion(sdkErr) {
+		t.Fatalf("map params err = %v, want a VersionError", sdkErr)
+	}
+	if n := len(srv.Calls()) - before; n != 1 {
+		t.Errorf("%d calls reached the cluster, want only AddAccount", n)
+	}
+
+	req.ProtectionScheme = ""
+	if _, sdkErr := sf.CreateVolume(ctx, req); sdkErr != nil {
+		t.Fatal(sdkErr)
+	}
+}
+
+func TestVersionGatingChapAlgorithm(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+	srv.Version = "12.5"
+	sf := connect(t, srv, sdk.AutoVersion)
+	ctx := context.Background()
+
+	before := len(srv.Calls())
+	_, sdkErr := sf.AddAccount(ctx, &sdk.AddAccountRequest{Username: "tenant", ChapAlgorithm: "SHA3-256"})
+	var verr *sdk.VersionError
+	if !errors.Is(sdkErr, sdk.ErrUnsupportedVersion) || !errors.As(sdkErr, &verr) || verr.Field != "chapAlgorithm" || verr.Required != "12.7" || verr.Connected != "12.5" {
+		t.Fatalf("AddAccount err = %v, want a VersionError for chapAlgorithm", sdkErr)
+	}
+	// Members of list elements are checked too.
+	_, sdkErr = sf.CreateInitiators(ctx, &sdk.CreateInitiatorsRequest{Initiators: []sdk.CreateInitiator{
+		{Name: "iqn.1998-01.com.vmware:esx1"},
+		{Name: "iqn.1998-01.com.vmware:esx2", ChapAlgorithm: "SHA-256"},
+	}})
+	if !errors.As(sdkErr, &verr) || verr.Field != "initiators.chapAlgorithm" {
+		t.Fatalf("CreateInitiators err = %v, want a VersionError for initiators.chapAlgorithm", sdkErr)
+	}
+	_, sdkErr = sf.MakeSFCall(ctx, "ModifyInitiators", 1, map[string]interface{}{"initiators": []interface{}{map[string]interface{}{"initiatorID": 1, "chapAlgorithm": "SHA-256"}}}, &sdk.ModifyInitiatorsResult{})
+	if !sdk.IsUnsupportedVersion(sdkErr) {
+		t.Fatalf("map params err = %v, want a VersionError", sdkErr)
+	}
+	if n := len(srv.Calls()) - before; n != 0 {
+		t.Errorf("%d calls reached the cluster", n)
+	}
+
+	if _, sdkErr := sf.CreateInitiators(ctx, &sdk.CreateInitiatorsRequest{Initiators: []sdk.CreateInitiator{{Name: "iqn.1998-01.com.vmware:esx1"}}}); sdkErr != nil {
+		t.Fatal(sdkErr)
+	}
+}
@@ -2,14 +2,15 @@ package sdk
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 )
 
 // WaitForAsyncResult polls the GetAsyncResult API until the job completes.
-// It returns the final result or an error if the job fails or times out.
+// It returns the final result, whose Result holds the job's output, or an
+// *AsyncJobError if the job fails, or the error of ctx.
+// CloneManager waits for clones with typed results instead.
 func (sfClient *SFClient) WaitForAsyncResult(ctx context.Context, asyncHandle int64) (*GetAsyncResultResult, error) {
 	ticker := time.NewTicker(500 * time.Millisecond)
 	defer ticker.Stop()
@@ -34,13 +35,13 @@ func (sfClient *SFClient) WaitForAsyncResult(ctx context.Context, asyncHandle in
 				continue
 			}
 
+			if res.Error != nil {
+				return res, &AsyncJobError{AsyncHandle: asyncHandle, Name: res.Error.Name, Message: res.Error.Message}
+			}
 			if res.Status == "complete" {
 				log.WithContext(ctx).Debugf("AsyncHandle %d complete", asyncHandle)
 				return res, nil
 			}
-			if res.Status == "error" {
-				return res, fmt.Errorf("async job failed: %v", res.Result)
-			}
 
 			log.WithContext(ctx).Debugf("AsyncHandle %d running...", asyncHandle)
 		}
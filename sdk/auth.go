@@ -0,0 +1,307 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Authenticator adds credentials to every API request. Connect uses BasicAuth with
+// the username and password it is given unless WithAuthenticator supplied another one.
+type Authenticator interface {
+	// Authenticate sets the credentials on req. sfClient may be used to make the API
+	// calls the authenticator needs, such as creating or renewing a session.
+	Authenticate(ctx context.Context, sfClient *SFClient, req *http.Request) error
+}
+
+// invalidator is implemented by authenticators that cache credentials which the
+// cluster may reject, so a 401 response discards them before the next call.
+type invalidator interface {
+	Invalidate()
+}
+
+// WithAuthenticator authenticates requests with auth instead of HTTP Basic. The
+// username and password passed to Connect are then ignored and may be empty.
+func WithAuthenticator(auth Authenticator) ClientOption {
+	return func(sfClient *SFClient) error {
+		if auth == nil {
+			return errors.New("nil Authenticator")
+		}
+		sfClient.authenticator = auth
+		return nil
+	}
+}
+
+// SetAuthenticator replaces the authenticator of an existing client. A nil value
+// returns to the Basic credentials given to Connect.
+func (sfClient *SFClient) SetAuthenticator(auth Authenticator) {
+	sfClient.authenticator = auth
+}
+
+type authContextKey struct{}
+
+// withAuthenticator makes calls on ctx use auth regardless of the client's
+// authenticator. Session authenticators use it to bootstrap and renew themselves.
+func withAuthenticator(ctx context.Context, auth Authenticator) context.Context {
+	return context.WithValue(ctx, authContextKey{}, auth)
+}
+
+// authenticatorFor returns the authenticator for a call on ctx.
+func (sfClient *SFClient) authenticatorFor(ctx context.Context) Authenticator {
+	if auth, ok := ctx.Value(authContextKey{}).(Authenticator); ok {
+		return auth
+	}
+	if sfClient.authenticator != nil {
+		return sfClient.authenticator
+	}
+	if sfClient.basic != nil {
+		return sfClient.basic
+	}
+	return nil
+}
+
+// BasicAuth sends a static username and password with every request.
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+func (a *BasicAuth) Authenticate(ctx context.Context, sfClient *SFClient, req *http.Request) error {
+	req.Header.Set("Authorization", "Basic "+makeBasicAuthHeader(a.Username, a.Password))
+	return nil
+}
+
+// BasicAuthFunc looks up a username and password for each request, so the password
+// does not have to stay in memory between calls.
+type BasicAuthFunc func(ctx context.Context) (username, password string, err error)
+
+func (f BasicAuthFunc) Authenticate(ctx context.Context, sfClient *SFClient, req *http.Request) error {
+	username, password, err := f(ctx)
+	if err != nil {
+		return fmt.Errorf("looking up credentials: %w", err)
+	}
+	req.Header.Set("Authorization", "Basic "+makeBasicAuthHeader(username, password))
+	return nil
+}
+
+// tokenAuth sends a fixed session token; used to renew and delete a session with itself.
+type tokenAuth struct {
+	header, value string
+}
+
+func (a tokenAuth) Authenticate(ctx context.Context, sfClient *SFClient, req *http.Request) error {
+	req.Header.Set(a.header, a.value)
+	return nil
+}
+
+// SessionLogin creates a new auth session.
+type SessionLogin func(ctx context.Context, sfClient *SFClient) (*AuthSessionInfo, error)
+
+// SessionAuth authenticates with an auth session token instead of a password. The
+// session is created on first use, renewed with UpdateAuthSession before it idles
+// out, and replaced with a new one before its finalTimeout. Only Login needs
+// credentials, and only when a new session is due.
+type SessionAuth struct {
+	// Login creates a session. See NewAdminSession and NewIdpSession.
+	Login SessionLogin
+	// Header carries the token. Default "Authorization".
+	Header string
+	// Scheme prefixes the token in Header. Default "Bearer"; set to "-" to send the bare token.
+	Scheme string
+	// RenewBefore is how long before a timeout the session is renewed or replaced. Default 1 minute.
+	RenewBefore time.Duration
+
+	mu          sync.Mutex
+	session     *AuthSessionInfo
+	idle        time.Duration
+	lastAccess  time.Time
+	finalExpiry time.Time
+	now         func() time.Time
+}
+
+// NewAdminSession returns a SessionAuth whose sessions are created with CreateAuthSession
+// for the given cluster admins. bootstrap authenticates the CreateAuthSession call itself,
+// typically a BasicAuthFunc that reads the admin password from a secret on demand. A nil
+// bootstrap uses the username and password given to Connect.
+func NewAdminSession(username string, clusterAdminIDs []int64, expiration time.Duration, bootstrap Authenticator) *SessionAuth {
+	return &SessionAuth{Login: func(ctx context.Context, sfClient *SFClient) (*AuthSessionInfo, error) {
+		auth, err := bootstrapFor(sfClient, bootstrap)
+		if err != nil {
+			return nil, err
+		}
+		req := &CreateAuthSessionRequest{Username: username, ClusterAdminIDs: clusterAdminIDs, DesiredExpirationDuration: formatSessionDuration(expiration)}
+		res, sdkErr := sfClient.CreateAuthSession(withAuthenticator(ctx, auth), req)
+		if sdkErr != nil {
+			return nil, sdkErr
+		}
+		return &res.Session, nil
+	}}
+}
+
+// NewIdpSession returns a SessionAuth whose sessions are created with CreateIdpAuthSession
+// from the SAML attribute statements of an IdP assertion. bootstrap authenticates the
+// CreateIdpAuthSession call, or the credentials given to Connect if it is nil.
+func NewIdpSession(username string, samlAttributeStatements []string, expiration time.Duration, bootstrap Authenticator) *SessionAuth {
+	return &SessionAuth{Login: func(ctx context.Context, sfClient *SFClient) (*AuthSessionInfo, error) {
+		auth, err := bootstrapFor(sfClient, bootstrap)
+		if err != nil {
+			return nil, err
+		}
+		req := &CreateIdpAuthSessionRequest{Username: username, SamlAttributeStatements: samlAttributeStatements, DesiredExpirationDuration: formatSessionDuration(expiration)}
+		res, sdkErr := sfClient.CreateIdpAuthSession(withAuthenticator(ctx, auth), req)
+		if sdkErr != nil {
+			return nil, sdkErr
+		}
+		return &res.Session, nil
+	}}
+}
+
+// bootstrapFor returns the authenticator for a session login: bootstrap, or the Basic
+// credentials given to Connect. Falling back to the client's authenticator would be
+// the session itself, which cannot authenticate its own login.
+func bootstrapFor(sfClient *SFClient, bootstrap Authenticator) (Authenticator, error) {
+	if bootstrap != nil {
+		return bootstrap, nil
+	}
+	if sfClient.basic != nil && sfClient.basic.Username != "" {
+		return sfClient.basic, nil
+	}
+	return nil, errors.New("session login needs a bootstrap authenticator or the username and password given to Connect")
+}
+
+// formatSessionDuration renders d as the "HH:MM:SS" duration Element expects. Zero
+// leaves the expiration to the cluster.
+func formatSessionDuration(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	s := int64(d / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", s/3600, s/60%60, s%60)
+}
+
+func (a *SessionAuth) Authenticate(ctx context.Context, sfClient *SFClient, req *http.Request) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err := a.ensure(ctx, sfClient); err != nil {
+		return err
+	}
+	header, value := a.credential()
+	req.Header.Set(header, value)
+	// Every authenticated call resets the inactivity timeout on the cluster.
+	if a.idle > 0 {
+		a.lastAccess = a.clock().Add(a.idle)
+	}
+	return nil
+}
+
+// Session returns the current session, or nil before the first call.
+func (a *SessionAuth) Session() *AuthSessionInfo {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.session == nil {
+		return nil
+	}
+	s := *a.session
+	return &s
+}
+
+// Invalidate drops the current session so the next call logs in again.
+func (a *SessionAuth) Invalidate() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.session = nil
+}
+
+// Logout deletes the current session on the cluster.
+func (a *SessionAuth) Logout(ctx context.Context, sfClient *SFClient) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.session == nil {
+		return nil
+	}
+	header, value := a.credential()
+	id := a.session.SessionID
+	a.session = nil
+	if _, err := sfClient.DeleteAuthSession(withAuthenticator(ctx, tokenAuth{header, value}), &DeleteAuthSessionRequest{SessionID: id}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (a *SessionAuth) clock() time.Time {
+	if a.now != nil {
+		return a.now()
+	}
+	return time.Now()
+}
+
+func (a *SessionAuth) credential() (string, string) {
+	header, scheme := a.Header, a.Scheme
+	if header == "" {
+		header = "Authorization"
+	}
+	switch scheme {
+	case "":
+		return header, "Bearer " + a.session.SessionID
+	case "-":
+		return header, a.session.SessionID
+	}
+	return header, scheme + " " + a.session.SessionID
+}
+
+// ensure logs in or renews the session as needed. a.mu is held.
+func (a *SessionAuth) ensure(ctx context.Context, sfClient *SFClient) error {
+	now := a.clock()
+	renewBefore := a.RenewBefore
+	if renewBefore <= 0 {
+		renewBefore = time.Minute
+	}
+	if a.session != nil && !a.finalExpiry.IsZero() && now.Add(renewBefore).After(a.finalExpiry) {
+		log.WithContext(ctx).Debugf("auth session %s reaches its final timeout, creating a new one", a.session.SessionID)
+		a.session = nil
+	}
+	if a.session != nil && !a.lastAccess.IsZero() && now.Add(renewBefore).After(a.lastAccess) {
+		header, value := a.credential()
+		res, err := sfClient.UpdateAuthSession(withAuthenticator(ctx, tokenAuth{header, value}), &UpdateAuthSessionRequest{SessionID: a.session.SessionID})
+		if err != nil {
+			log.WithContext(ctx).Warnf("renewing auth session failed, creating a new one: %v", err)
+			a.session = nil
+		} else {
+			a.setSession(&res.Session, now)
+		}
+	}
+	if a.session == nil {
+		if a.Login == nil {
+			return errors.New("SessionAuth has no Login function")
+		}
+		session, err := a.Login(ctx, sfClient)
+		if err != nil {
+			return fmt.Errorf("creating auth session: %w", err)
+		}
+		a.setSession(session, now)
+	}
+	return nil
+}
+
+func (a *SessionAuth) setSession(session *AuthSessionInfo, now time.Time) {
+	a.session = session
+	a.finalExpiry = parseSessionTime(session.FinalTimeout)
+	a.lastAccess = parseSessionTime(session.LastAccessTimeout)
+	a.idle = 0
+	if !a.lastAccess.IsZero() {
+		a.idle = a.lastAccess.Sub(now)
+	}
+}
+
+func parseSessionTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
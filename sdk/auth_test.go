@@ -0,0 +1,138 @@
+package sdk_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+func newSessionClient(t *testing.T, srv *sdktest.Server, auth *sdk.SessionAuth) *sdk.SFClient {
+	t.Helper()
+	sf, err := sdk.NewSFClient(append(srv.ClientOptions(), sdk.WithAuthenticator(auth))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sf.Connect(context.Background(), srv.Host(), srv.Version, "", ""); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	return sf
+}
+
+func TestSessionAuth(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+	srv.Username, srv.Password = "admin", "adminpw"
+
+	lookups := 0
+	bootstrap := sdk.BasicAuthFunc(func(ctx context.Context) (string, string, error) {
+		lookups++
+		return "admin", "adminpw", nil
+	})
+	auth := sdk.NewAdminSession("reconciler", []int64{1}, time.Hour, bootstrap)
+	sf := newSessionClient(t, srv, auth)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := sf.ListVolumes(ctx, &sdk.ListVolumesRequest{}); err != nil {
+			t.Fatalf("ListVolumes: %v", err)
+		}
+	}
+	if lookups != 1 || srv.CallCount("CreateAuthSession") != 1 {
+		t.Fatalf("expected one login, got %d lookups and %d sessions", lookups, srv.CallCount("CreateAuthSession"))
+	}
+	if auth.Session() == nil || auth.Session().Username != "reconciler" {
+		t.Fatalf("unexpected session %+v", auth.Session())
+	}
+
+	if err := auth.Logout(ctx, sf); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+	if _, err := sf.ListVolumes(ctx, &sdk.ListVolumesRequest{}); err != nil {
+		t.Fatalf("ListVolumes after logout: %v", err)
+	}
+	if srv.CallCount("CreateAuthSession") != 2 {
+		t.Fatal("expected a new session after logout")
+	}
+}
+
+func TestSessionAuthRenewal(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+	srv.SessionIdleTimeout = 10 * time.Minute
+
+	auth := sdk.NewAdminSession("reconciler", []int64{1}, time.Hour, &sdk.BasicAuth{})
+	// Renewing 20 minutes ahead of a 10 minute idle timeout renews on every call.
+	auth.RenewBefore = 20 * time.Minute
+	sf := newSessionClient(t, srv, auth)
+	if _, err := sf.ListVolumes(context.Background(), &sdk.ListVolumesRequest{}); err != nil {
+		t.Fatalf("ListVolumes: %v", err)
+	}
+	if srv.CallCount("UpdateAuthSession") == 0 || srv.CallCount("CreateAuthSession") != 1 {
+		t.Fatalf("expected renewal, got %d updates and %d logins", srv.CallCount("UpdateAuthSession"), srv.CallCount("CreateAuthSession"))
+	}
+
+	// Within RenewBefore of the final timeout a new session replaces the old one.
+	auth.RenewBefore = 2 * time.Hour
+	if _, err := sf.ListVolumes(context.Background(), &sdk.ListVolumesRequest{}); err != nil {
+		t.Fatalf("ListVolumes: %v", err)
+	}
+	if srv.CallCount("CreateAuthSession") != 2 {
+		t.Fatalf("expected a replacement session, got %d logins", srv.CallCount("CreateAuthSession"))
+	}
+}
+
+func TestSessionAuthRejectedLogin(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+	srv.Username, srv.Password = "admin", "adminpw"
+
+	auth := sdk.NewIdpSession("user@example.com", []string{"group=admins"}, 0, &sdk.BasicAuth{Username: "admin", Password: "wrong"})
+	sf, _ := sdk.NewSFClient(append(srv.ClientOptions(), sdk.WithAuthenticator(auth))...)
+	err := sf.Connect(context.Background(), srv.Host(), srv.Version, "", "")
+	if !sdk.IsPermissionDenied(err) {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+	if err.HTTPStatus != 0 || err.Method != "GetAPI" {
+		t.Fatalf("the login failure should be reported on the original call: %+v", err)
+	}
+}
+
+func TestSessionAuthWithoutBootstrap(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+	srv.Username, srv.Password = "admin", "adminpw"
+
+	// Without a bootstrap the login uses the credentials given to Connect.
+	auth := sdk.NewAdminSession("reconciler", []int64{1}, time.Hour, nil)
+	sf, _ := sdk.NewSFClient(append(srv.ClientOptions(), sdk.WithAuthenticator(auth))...)
+	done := make(chan *sdk.SdkError, 1)
+	go func() { done <- sf.Connect(context.Background(), srv.Host(), srv.Version, "admin", "adminpw") }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Connect: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Connect deadlocked")
+	}
+	if auth.Session() == nil || srv.CallCount("CreateAuthSession") != 1 {
+		t.Fatalf("expected a session, got %+v", auth.Session())
+	}
+
+	// Without credentials either, the login fails instead of blocking.
+	auth = sdk.NewIdpSession("user@example.com", []string{"group=admins"}, 0, nil)
+	sf, _ = sdk.NewSFClient(append(srv.ClientOptions(), sdk.WithAuthenticator(auth))...)
+	go func() { done <- sf.Connect(context.Background(), srv.Host(), srv.Version, "", "") }()
+	select {
+	case err := <-done:
+		if err == nil || !strings.Contains(err.Error(), "bootstrap") {
+			t.Fatalf("expected a missing bootstrap error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Connect deadlocked")
+	}
+}
@@ -3,51 +3,101 @@ package sdk
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
-	"io/ioutil"
+	"io"
 	"net/http"
-	"os"
+	"net/http/httptrace"
+
+	log "github.com/sirupsen/logrus"
 )
 
-//Connect just really sets based info and then does an API call to see if it works
-//its all stateless after the initial call
+// Connect just really sets based info and then does an API call to see if it works
+// its all stateless after the initial call
+//
+// With version "" or AutoVersion, Connect asks the cluster for its supported versions
+// and uses the highest one the SDK knows (see WithMaxAPIVersion). Methods and request
+// fields that need a newer version than the one connected with are then refused with
+// a VersionError instead of being sent.
 func (sfClient *SFClient) Connect(ctx context.Context, host string, version string, uid string, password string) *SdkError {
+	negotiate := version == "" || version == AutoVersion
+	if negotiate {
+		// Every cluster serves GetAPI on the oldest endpoint.
+		version = "1.0"
+	}
 	sfClient.baseUrl = fmt.Sprintf("https://%s/json-rpc/%s", host, version)
-	var entry BaseRequest
-
-	entry.Id = 1
-	entry.Method = "GetAPI"
-	entry.Parameters = nil
+	sfClient.apiVersion, sfClient.clusterVersion = "", ""
 	var res GetAPIResult
-	sfClient.userId = uid
-	sfClient.password = password
+	sfClient.basic = &BasicAuth{Username: uid, Password: password}
 	_, sdkError := sfClient.MakeSFCall(ctx, "GetAPI", 1, nil, &res)
-	return sdkError
+	if sdkError != nil {
+		return sdkError
+	}
+	if negotiate {
+		chosen, err := sfClient.negotiateVersion(res.SupportedVersions)
+		if err != nil {
+			return &SdkError{Code: "sdk.version", Detail: err.Error(), Method: "GetAPI", Err: ErrUnsupportedVersion}
+		}
+		version = chosen
+		sfClient.baseUrl = fmt.Sprintf("https://%s/json-rpc/%s", host, version)
+	}
+	sfClient.apiVersion, sfClient.clusterVersion = version, res.CurrentVersion
+	return nil
 }
 
 func (sfClient *SFClient) MakeSFCall(ctx context.Context, method string, id int32, params interface{}, res interface{}) (BaseResponse, *SdkError) {
 	log.WithContext(ctx).Debugf("Starting call %s", method)
-	var entry BaseRequest
-	var returnError *SdkError = nil
+	response, err := sfClient.invoke(ctx, id, method, params, res)
+	log.WithContext(ctx).Debugf("Ending call %s", method)
+	// IMPORTANT: Return nil SdkError explicitly if successful, otherwise it returns a nil pointer typed as *SdkError which is NOT nil interface{}
+	if err == nil {
+		return response, nil
+	}
+	return response, err
+}
 
-	client := mkHttpClient()
-	entry.Id = id
-	entry.Method = method
-	entry.Parameters = params
+// callOutcome describes a single HTTP exchange with the cluster.
+type callOutcome struct {
+	response BaseResponse
+	err      *SdkError
+	// status is the HTTP status code, or 0 if no response was received.
+	status int
+	// transportErr is the error returned by the HTTP client, if any.
+	transportErr error
+	// delivered is false when the request body was never fully written to the
+	// connection, which means the cluster cannot have acted on it.
+	delivered bool
+}
+
+func (sfClient *SFClient) doCall(ctx context.Context, entry BaseRequest, res interface{}) callOutcome {
+	var out callOutcome
 
+	client, err := sfClient.client()
+	if err != nil {
+		out.err = &SdkError{Code: fmt.Sprintf("%s.tls", NetworkError), Detail: err.Error(), Method: entry.Method, Err: err}
+		return out
+	}
 	bits, _ := json.Marshal(entry)
-	//inputStr := string(bits)
-	req, reqerr := http.NewRequest("POST", sfClient.baseUrl, bytes.NewReader(bits))
+	trace := &httptrace.ClientTrace{
+		WroteRequest: func(info httptrace.WroteRequestInfo) {
+			if info.Err == nil {
+				out.delivered = true
+			}
+		},
+	}
+	req, reqerr := http.NewRequestWithContext(httptrace.WithClientTrace(ctx, trace), "POST", sfClient.baseUrl, bytes.NewReader(bits))
 	if reqerr != nil {
-		fmt.Println(reqerr.Error())
-		os.Exit(1)
+		out.err = &SdkError{Code: fmt.Sprintf("%s.request", NetworkError), Detail: reqerr.Error(), Method: entry.Method, Err: reqerr}
+		return out
 	}
 
-	req.Header.Add("Authorization", "Basic "+makeBasicAuthHeader(sfClient.userId, sfClient.password))
+	if auth := sfClient.authenticatorFor(ctx); auth != nil {
+		if autherr := auth.Authenticate(ctx, sfClient, req); autherr != nil {
+			out.err = &SdkError{Code: fmt.Sprintf("%s.auth", NetworkError), Detail: autherr.Error(), Method: entry.Method, Err: autherr}
+			return out
+		}
+	}
 	req.Header.Add("Content", "Application/json")
 	resp, err := client.Do(req)
 	if err != nil {
@@ -55,50 +105,54 @@ func (sfClient *SFClient) MakeSFCall(ctx context.Context, method string, id int3
 		var errorData SdkError
 		errorData.Code = fmt.Sprintf("%s.unkown", SfapiError)
 		errorData.Detail = err.Error()
-		returnError = &errorData
-	} else if resp.StatusCode != 200 {
+		errorData.Method = entry.Method
+		errorData.Err = err
+		out.err = &errorData
+		out.transportErr = err
+		return out
+	}
+	defer resp.Body.Close()
+	out.status = resp.StatusCode
+	if resp.StatusCode != 200 {
 		log.Printf("Status code %d\n", resp.StatusCode)
 		var errorData SdkError
 		errorData.Code = fmt.Sprintf("%s.%d", NetworkError, resp.StatusCode)
 		errorData.Detail = resp.Status
-		returnError = &errorData
-
-	}
-	var result BaseResponse
-	if returnError == nil {
-		defer resp.Body.Close()
-		body, _ := ioutil.ReadAll(resp.Body)
-
-		json.Unmarshal(body, &result)
-		// Check for method-level errors first (like list access denied)
-		if result.Error.Code != 0 {
-			var errorData SdkError
-			errorData.Code = fmt.Sprintf("%s.%d", SfapiError, result.Error.Code)
-			errorData.Detail = fmt.Sprintf("%d:%s", result.Error.Code, result.Error.Message)
-			returnError = &errorData
-		} else {
-			// Success block
-			tmpResults, reqerr := json.Marshal(result.Result)
-			if reqerr == nil {
-				json.Unmarshal(tmpResults, &res)
+		errorData.Method = entry.Method
+		errorData.HTTPStatus = resp.StatusCode
+		out.err = &errorData
+		if resp.StatusCode == http.StatusUnauthorized {
+			if inv, ok := sfClient.authenticatorFor(ctx).(invalidator); ok {
+				inv.Invalidate()
 			}
 		}
+		return out
 	}
-	log.WithContext(ctx).Debugf("Ending call %s", method)
-	// IMPORTANT: Return nil SdkError explicitly if successful, otherwise it returns a nil pointer typed as *SdkError which is NOT nil interface{}
-	if returnError == nil {
-		return result, nil
+
+	body, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(body, &out.response)
+	// Check for method-level errors first (like list access denied)
+	if out.response.Error.Code != 0 {
+		var errorData SdkError
+		errorData.Code = fmt.Sprintf("%s.%d", SfapiError, out.response.Error.Code)
+		errorData.Detail = fmt.Sprintf("%d:%s", out.response.Error.Code, out.response.Error.Message)
+		errorData.Method = entry.Method
+		errorData.Name = out.response.Error.Name
+		errorData.APICode = out.response.Error.Code
+		errorData.Message = out.response.Error.Message
+		errorData.HTTPStatus = resp.StatusCode
+		out.err = &errorData
+	} else {
+		// Success block
+		tmpResults, reqerr := json.Marshal(out.response.Result)
+		if reqerr == nil {
+			json.Unmarshal(tmpResults, &res)
+		}
 	}
-	return result, returnError
+	return out
 }
 
-
 func makeBasicAuthHeader(username, password string) string {
 	auth := username + ":" + password
 	return base64.StdEncoding.EncodeToString([]byte(auth))
 }
-func mkHttpClient() *http.Client {
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	client := &http.Client{}
-	return client
-}
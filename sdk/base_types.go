@@ -1,6 +1,10 @@
 package sdk
 
-import "fmt"
+import (
+	"fmt"
+	"net/http"
+	"sync"
+)
 
 type BaseRequest struct {
 	Method     string      `json:"method"`
@@ -11,9 +15,25 @@ type BaseRequest struct {
 const SfapiError = "sfapi"
 const NetworkError = "http"
 
+// SdkError is returned by every API call. Code and Detail keep their historic
+// "sfapi.500" / "500:message" format; the remaining fields carry the structured
+// error so callers can use errors.Is with ErrNotFound and friends, or errors.As
+// to get at the Element error name.
 type SdkError struct {
 	Code   string
 	Detail string
+	// Method is the API method that failed.
+	Method string
+	// Name is the Element error name, such as "xUnknownAccount". Empty for transport errors.
+	Name string
+	// APICode is the JSON-RPC error code returned by the cluster.
+	APICode int32
+	// Message is the error message returned by the cluster.
+	Message string
+	// HTTPStatus is the HTTP status code, or 0 if no response was received.
+	HTTPStatus int
+	// Err is the underlying transport error, if any.
+	Err error
 }
 
 func (this *SdkError) Error() string {
@@ -24,6 +44,14 @@ func (this *SdkError) Error() string {
 
 }
 
+// Unwrap returns the underlying transport error, if any.
+func (this *SdkError) Unwrap() error {
+	if this == nil {
+		return nil
+	}
+	return this.Err
+}
+
 //error returned from the SF API
 type SFAPIError struct {
 	Code    int32  `json:"code"`
@@ -36,9 +64,25 @@ type BaseResponse struct {
 	Error  SFAPIError  `json:"error"`
 }
 type SFClient struct {
-	userId   string
-	password string
-	baseUrl  string
+	baseUrl string
+	// basic holds the credentials given to Connect; authenticator, when set, replaces them.
+	basic         *BasicAuth
+	authenticator Authenticator
+
+	transport  transportConfig
+	httpClient *http.Client
+	initOnce   sync.Once
+	initErr    error
+
+	retryPolicy  *RetryPolicy
+	cassette     *Cassette
+	interceptors []Interceptor
+
+	// apiVersion is the endpoint version in baseUrl; clusterVersion is GetAPI's
+	// currentVersion. Both are set by Connect.
+	apiVersion     string
+	clusterVersion string
+	maxAPIVersion  string
 }
 
 //a client that has nothing but stubs that return an error
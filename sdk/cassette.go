@@ -0,0 +1,341 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Redacted replaces scrubbed values in recorded fixtures.
+const Redacted = "REDACTED"
+
+// DefaultScrubKeys are JSON keys whose values are never written to a cassette. Keys are
+// matched case-insensitively as substrings anywhere in request parameters and response
+// results, so "password", "initiatorSecret", "targetSecret" and the "sessionID" of an
+// auth session are all covered. Only string values are replaced; numbers such as an
+// iSCSI sessionID or passwordCreatedTimestamp are kept so that replays still decode.
+var DefaultScrubKeys = []string{"password", "secret", "passphrase", "token", "privatekey", "sessionid"}
+
+// CassetteMode selects whether a Cassette records live traffic or replays a fixture.
+type CassetteMode int
+
+const (
+	// CassetteRecord forwards calls to the cluster and appends each exchange to the fixture.
+	CassetteRecord CassetteMode = iota
+	// CassetteReplay answers calls from the fixture without touching the network.
+	CassetteReplay
+)
+
+// Interaction is one recorded JSON-RPC exchange.
+type Interaction struct {
+	// APIVersion is the endpoint version from the request URL, such as "12.5".
+	APIVersion string `json:"apiVersion"`
+	// Request is the BaseRequest sent by the client, with secrets scrubbed.
+	Request BaseRequest `json:"request"`
+	// Status is the HTTP status code of the response.
+	Status int `json:"status"`
+	// Response is the response body, with secrets scrubbed. It is usually a BaseResponse.
+	Response json.RawMessage `json:"response"`
+}
+
+// CassetteMatcher reports whether a recorded interaction answers the actual request.
+// Both requests have already been scrubbed.
+type CassetteMatcher func(recorded, actual *BaseRequest) bool
+
+// MatchMethod matches interactions on the method name alone.
+func MatchMethod(recorded, actual *BaseRequest) bool {
+	return recorded.Method == actual.Method
+}
+
+// MatchMethodAndParams matches on the method name and the decoded parameters. It is the default.
+func MatchMethodAndParams(recorded, actual *BaseRequest) bool {
+	if recorded.Method != actual.Method {
+		return false
+	}
+	return reflect.DeepEqual(normalizeJSON(recorded.Parameters), normalizeJSON(actual.Parameters))
+}
+
+// Cassette records JSON-RPC traffic to a fixture file or replays it. Attach it to a
+// client with WithCassette. A recording cassette rewrites its file after every call,
+// so a fixture survives a test run that is interrupted.
+type Cassette struct {
+	// Path is the fixture file.
+	Path string
+	// Mode is CassetteRecord or CassetteReplay.
+	Mode CassetteMode
+	// Match selects the interaction to replay. Defaults to MatchMethodAndParams.
+	Match CassetteMatcher
+	// ScrubKeys overrides DefaultScrubKeys.
+	ScrubKeys []string
+	// Repeat lets replay reuse the last matching interaction once all matches have been played,
+	// which suits polling loops such as GetAsyncResult.
+	Repeat bool
+
+	mu           sync.Mutex
+	interactions []Interaction
+	played       []bool
+}
+
+type cassetteFile struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// NewRecorder returns a cassette that records to path, replacing any existing fixture.
+func NewRecorder(path string) *Cassette {
+	return &Cassette{Path: path, Mode: CassetteRecord}
+}
+
+// LoadCassette reads the fixture at path for replay.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette %s: %w", path, err)
+	}
+	var f cassetteFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parsing cassette %s: %w", path, err)
+	}
+	return &Cassette{
+		Path:         path,
+		Mode:         CassetteReplay,
+		interactions: f.Interactions,
+		played:       make([]bool, len(f.Interactions)),
+	}, nil
+}
+
+// WithCassette routes the client's HTTP traffic through c.
+func WithCassette(c *Cassette) ClientOption {
+	return func(sfClient *SFClient) error {
+		if c == nil {
+			return fmt.Errorf("nil Cassette")
+		}
+		sfClient.cassette = c
+		return nil
+	}
+}
+
+// Interactions returns a copy of the recorded or loaded interactions.
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// Unplayed returns the loaded interactions that have not been replayed yet, so a test
+// can check that the client made every call the fixture expects.
+func (c *Cassette) Unplayed() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var out []Interaction
+	for i, in := range c.interactions {
+		if !c.played[i] {
+			out = append(out, in)
+		}
+	}
+	return out
+}
+
+// CassetteMissError is returned when replay finds no interaction for a request.
+type CassetteMissError struct {
+	Path    string
+	Request BaseRequest
+}
+
+func (e *CassetteMissError) Error() string {
+	params, _ := json.Marshal(e.Request.Parameters)
+	return fmt.Sprintf("cassette %s has no interaction for %s %s", e.Path, e.Request.Method, params)
+}
+
+// wrap returns a copy of httpClient whose transport goes through the cassette.
+func (c *Cassette) wrap(httpClient *http.Client) *http.Client {
+	out := *httpClient
+	next := out.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	out.Transport = &cassetteTransport{cassette: c, next: next}
+	return &out
+}
+
+type cassetteTransport struct {
+	cassette *Cassette
+	next     http.RoundTripper
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c := t.cassette
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	var entry BaseRequest
+	if err := json.Unmarshal(body, &entry); err != nil {
+		return nil, fmt.Errorf("cassette: decoding request: %w", err)
+	}
+	entry.Parameters = c.scrub(entry.Parameters)
+
+	if c.Mode == CassetteReplay {
+		in, err := c.replay(&entry)
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Status, http.StatusText(in.Status)),
+			StatusCode:    in.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": {"application/json"}},
+			Body:          io.NopCloser(bytes.NewReader(in.Response)),
+			ContentLength: int64(len(in.Response)),
+			Request:       req,
+		}, nil
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	recorded := json.RawMessage(respBody)
+	var decoded interface{}
+	if json.Unmarshal(respBody, &decoded) == nil {
+		recorded, _ = json.Marshal(c.scrub(decoded))
+	} else {
+		// Keep non-JSON bodies, such as HTML error pages, as a JSON string.
+		recorded, _ = json.Marshal(string(respBody))
+	}
+	if err := c.record(Interaction{
+		APIVersion: path.Base(req.URL.Path),
+		Request:    entry,
+		Status:     resp.StatusCode,
+		Response:   recorded,
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Cassette) record(in Interaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interactions = append(c.interactions, in)
+	data, err := json.MarshalIndent(cassetteFile{Interactions: c.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(c.Path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("writing cassette %s: %w", c.Path, err)
+	}
+	return nil
+}
+
+// replay returns the first unplayed matching interaction, falling back to the last
+// match when Repeat is set. The response id is rewritten to the request's id.
+func (c *Cassette) replay(entry *BaseRequest) (Interaction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	match := c.Match
+	if match == nil {
+		match = MatchMethodAndParams
+	}
+	last := -1
+	for i := range c.interactions {
+		if !match(&c.interactions[i].Request, entry) {
+			continue
+		}
+		if !c.played[i] {
+			c.played[i] = true
+			return c.withID(c.interactions[i], entry.Id), nil
+		}
+		last = i
+	}
+	if c.Repeat && last >= 0 {
+		return c.withID(c.interactions[last], entry.Id), nil
+	}
+	return Interaction{}, &CassetteMissError{Path: c.Path, Request: *entry}
+}
+
+func (c *Cassette) withID(in Interaction, id int32) Interaction {
+	var resp map[string]interface{}
+	if json.Unmarshal(in.Response, &resp) == nil {
+		if _, ok := resp["id"]; ok {
+			resp["id"] = id
+			in.Response, _ = json.Marshal(resp)
+		}
+	}
+	return in
+}
+
+// scrub returns a copy of v with the values of secret keys replaced by Redacted.
+func (c *Cassette) scrub(v interface{}) interface{} {
+	keys := c.ScrubKeys
+	if keys == nil {
+		keys = DefaultScrubKeys
+	}
+	return scrubValue(normalizeJSON(v), keys)
+}
+
+func scrubValue(v interface{}, keys []string) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			if str, ok := val.(string); ok && str != "" && isSecretKey(k, keys) {
+				out[k] = Redacted
+			} else {
+				out[k] = scrubValue(val, keys)
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, val := range t {
+			out[i] = scrubValue(val, keys)
+		}
+		return out
+	}
+	return v
+}
+
+func isSecretKey(key string, keys []string) bool {
+	lower := strings.ToLower(key)
+	for _, k := range keys {
+		if strings.Contains(lower, strings.ToLower(k)) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeJSON converts v to the generic form produced by json.Unmarshal so that
+// typed request structs and decoded fixtures compare equal.
+func normalizeJSON(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out interface{}
+	if json.Unmarshal(data, &out) != nil {
+		return v
+	}
+	return out
+}
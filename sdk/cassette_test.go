@@ -0,0 +1,207 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newAccountServer answers GetAPI and AddAccount, echoing secrets like a cluster does.
+func newAccountServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int32                  `json:"id"`
+			Method string                 `json:"method"`
+			Params map[string]interface{} `json:"params"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		var result interface{} = map[string]interface{}{"currentVersion": "12.5"}
+		if req.Method == "AddAccount" {
+			result = map[string]interface{}{"accountID": 7, "account": map[string]interface{}{
+				"accountID": 7, "username": req.Params["username"], "initiatorSecret": req.Params["initiatorSecret"],
+			}}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": req.ID, "result": result})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	srv := newAccountServer(t)
+	host := strings.TrimPrefix(srv.URL, "https://")
+	fixture := filepath.Join(t.TempDir(), "accounts.json")
+	ctx := context.Background()
+	req := &AddAccountRequest{Username: "tenant1", InitiatorSecret: "s3cr3t-chap-pw"}
+
+	rec, err := NewSFClient(WithHTTPClient(srv.Client()), WithCassette(NewRecorder(fixture)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Connect(ctx, host, "12.5", "admin", "adminpw"); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	if _, err := rec.AddAccount(ctx, req); err != nil {
+		t.Fatalf("AddAccount: %v", err)
+	}
+
+	data, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"s3cr3t-chap-pw", "adminpw"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("fixture contains %q:\n%s", secret, data)
+		}
+	}
+
+	cas, err := LoadCassette(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+	replay, err := NewSFClient(WithCassette(cas), WithRetryPolicy(fastRetryPolicy()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := replay.Connect(ctx, host, "12.5", "admin", "other"); err != nil {
+		t.Fatalf("replayed Connect: %v", err)
+	}
+	// Secrets are scrubbed before matching, so a different secret still matches.
+	res, sdkErr := replay.AddAccount(ctx, &AddAccountRequest{Username: "tenant1", InitiatorSecret: "another-secret"})
+	if sdkErr != nil {
+		t.Fatalf("replayed AddAccount: %v", sdkErr)
+	}
+	if res.AccountID != 7 || res.Account.InitiatorSecret != Redacted {
+		t.Fatalf("unexpected replayed result %+v", res)
+	}
+	if n := len(cas.Unplayed()); n != 0 {
+		t.Fatalf("%d interactions not replayed", n)
+	}
+
+	_, sdkErr = replay.AddAccount(ctx, &AddAccountRequest{Username: "tenant2"})
+	var missErr *CassetteMissError
+	if !errors.As(sdkErr, &missErr) || missErr.Request.Method != "AddAccount" {
+		t.Fatalf("expected a cassette miss, got %v", sdkErr)
+	}
+}
+
+func TestCassetteMatchMethod(t *testing.T) {
+	srv := newAccountServer(t)
+	host := strings.TrimPrefix(srv.URL, "https://")
+	fixture := filepath.Join(t.TempDir(), "accounts.json")
+	ctx := context.Background()
+
+	rec, _ := NewSFClient(WithHTTPClient(srv.Client()), WithCassette(NewRecorder(fixture)))
+	rec.Connect(ctx, host, "12.5", "admin", "admin")
+	rec.AddAccount(ctx, &AddAccountRequest{Username: "tenant1"})
+
+	cas, err := LoadCassette(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cas.Match = MatchMethod
+	cas.Repeat = true
+	replay, _ := NewSFClient(WithCassette(cas))
+	replay.Connect(ctx, host, "12.5", "admin", "admin")
+	for _, name := range []string{"tenant2", "tenant3"} {
+		if _, err := replay.AddAccount(ctx, &AddAccountRequest{Username: name}); err != nil {
+			t.Fatalf("AddAccount(%s): %v", name, err)
+		}
+	}
+	if got := cas.Interactions()[0].APIVersion; got != "12.5" {
+		t.Fatalf("APIVersion %q, want 12.5", got)
+	}
+}
+
+func TestCassetteScrubsAuthSessions(t *testing.T) {
+	const sessionID = "6b7a0f9e-3c1d-4e57-9a2b-0c8d5f1e2a34"
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int32  `json:"id"`
+			Method string `json:"method"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		var result interface{} = map[string]interface{}{"currentVersion": "12.5"}
+		switch req.Method {
+		case "CreateAuthSession", "CreateIdpAuthSession":
+			result = map[string]interface{}{"session": map[string]interface{}{
+				"sessionID": sessionID, "username": "admin", "clusterAdminIDs": []int64{1},
+			}}
+		case "ListISCSISessions":
+			result = map[string]interface{}{"sessions": []interface{}{map[string]interface{}{"sessionID": 42, "accountID": 7}}}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": req.ID, "result": result})
+	}))
+	t.Cleanup(srv.Close)
+	host := strings.TrimPrefix(srv.URL, "https://")
+	fixture := filepath.Join(t.TempDir(), "sessions.json")
+	ctx := context.Background()
+
+	rec, err := NewSFClient(WithHTTPClient(srv.Client()), WithCassette(NewRecorder(fixture)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Connect(ctx, host, "12.5", "admin", "adminpw"); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	if _, err := rec.CreateAuthSession(ctx, &CreateAuthSessionRequest{Username: "admin", ClusterAdminIDs: []int64{1}}); err != nil {
+		t.Fatalf("CreateAuthSession: %v", err)
+	}
+	if _, err := rec.CreateIdpAuthSession(ctx, &CreateIdpAuthSessionRequest{Username: "admin", SamlAttributeStatements: []string{"a"}}); err != nil {
+		t.Fatalf("CreateIdpAuthSession: %v", err)
+	}
+	if _, err := rec.ListISCSISessions(ctx); err != nil {
+		t.Fatalf("ListISCSISessions: %v", err)
+	}
+
+	data, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), sessionID) {
+		t.Fatalf("fixture contains the auth session ID:\n%s", data)
+	}
+
+	cas, err := LoadCassette(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+	replay, err := NewSFClient(WithCassette(cas))
+	if err != nil {
+		t.Fatal(err)
+	}
+	replay.Connect(ctx, host, "12.5", "admin", "adminpw")
+	for _, create := range []func() (*CreateAuthSessionResult, *SdkError){
+		func() (*CreateAuthSessionResult, *SdkError) {
+			return replay.CreateAuthSession(ctx, &CreateAuthSessionRequest{Username: "admin", ClusterAdminIDs: []int64{1}})
+		},
+		func() (*CreateAuthSessionResult, *SdkError) {
+			return replay.CreateIdpAuthSession(ctx, &CreateIdpAuthSessionRequest{Username: "admin", SamlAttributeStatements: []string{"a"}})
+		},
+	} {
+		res, err := create()
+		if err != nil {
+			t.Fatalf("replayed session: %v", err)
+		}
+		if res.Session.SessionID != Redacted {
+			t.Fatalf("replayed session ID %q, want %q", res.Session.SessionID, Redacted)
+		}
+	}
+	// A numeric sessionID is not a credential and must still decode.
+	sessions, sdkErr := replay.ListISCSISessions(ctx)
+	if sdkErr != nil {
+		t.Fatalf("replayed ListISCSISessions: %v", sdkErr)
+	}
+	if len(sessions.Sessions) != 1 || sessions.Sessions[0].SessionID != 42 {
+		t.Fatalf("unexpected replayed sessions %+v", sessions.Sessions)
+	}
+}
@@ -0,0 +1,370 @@
+package sdk
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultMaxConcurrentClones is the cluster-wide cap of a CloneManager when
+// MaxConcurrent is not set and GetLimits does not report cloneJobsPerClusterMax.
+const DefaultMaxConcurrentClones = 8
+
+// AsyncJobError is a job that completed with an error, as reported by
+// GetAsyncResult or ListAsyncResults.
+type AsyncJobError struct {
+	AsyncHandle int64
+	Name        string
+	Message     string
+}
+
+func (e *AsyncJobError) Error() string {
+	return fmt.Sprintf("async job %d failed: %s: %s", e.AsyncHandle, e.Name, e.Message)
+}
+
+// CloneResult is the outcome of a clone or copy run by a CloneManager.
+type CloneResult struct {
+	AsyncHandle int64
+	// CloneID identifies a CloneVolume or CopyVolume job, GroupCloneID a
+	// CloneMultipleVolumes job.
+	CloneID      int64
+	GroupCloneID int64
+	// VolumeID is the new volume of a CloneVolume, or the destination of a CopyVolume.
+	VolumeID int64
+	// Members pairs each source volume of a CloneMultipleVolumes with its clone.
+	Members []GroupCloneVolumeMember
+	Message string
+}
+
+// CloneJobs returns the clone and copy jobs running on the cluster.
+func (sfClient *SFClient) CloneJobs(ctx context.Context) ([]CloneJob, error) {
+	res, sdkErr := sfClient.ListCloneJobs(ctx)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	return res.CloneJobs, nil
+}
+
+// CloneManager runs CloneVolume, CloneMultipleVolumes and CopyVolume jobs to
+// completion within the cluster's clone limits. Requests that would exceed a limit
+// wait in a queue until running jobs finish. Cancelling the context of a running
+// request cancels its job on the cluster, which removes the volumes it was creating.
+//
+// The limits only count jobs started through the manager. A start the cluster refuses
+// with xMaxSimultaneousClonesPerVolumeExceeded, because of clones started elsewhere,
+// is retried after PollInterval.
+type CloneManager struct {
+	// MaxPerVolume caps the running jobs that read from one source volume. Default is
+	// cloneJobsPerVolumeMax from GetLimits.
+	MaxPerVolume int64
+	// MaxConcurrent caps the running jobs on the cluster, counting each member of a
+	// group clone. Default is cloneJobsPerClusterMax from GetLimits, or
+	// DefaultMaxConcurrentClones on clusters that do not report it.
+	MaxConcurrent int64
+	// PollInterval is the wait between GetAsyncResult calls. Default 2 seconds.
+	PollInterval time.Duration
+	// MaxPollErrors is how many GetAsyncResult calls in a row may fail before a wait
+	// gives up; the job keeps running on the cluster. Default 5.
+	MaxPollErrors int
+
+	sfClient *SFClient
+	mu       sync.Mutex
+	// perVolume and perCluster are the limits from GetLimits, once loaded.
+	perVolume  int64
+	perCluster int64
+	running    int64
+	sources    map[int64]int64
+	queue      []*cloneWaiter
+}
+
+// cloneWaiter is a queued request; ready is closed once its slots are taken.
+type cloneWaiter struct {
+	sources []int64
+	ready   chan struct{}
+}
+
+// NewCloneManager returns a clone manager for sfClient.
+func NewCloneManager(sfClient *SFClient) *CloneManager {
+	return &CloneManager{sfClient: sfClient, sources: map[int64]int64{}}
+}
+
+// CloneVolume clones a volume, or one of its snapshots, and waits for the clone.
+func (m *CloneManager) CloneVolume(ctx context.Context, req *CloneVolumeRequest) (*CloneResult, error) {
+	return m.run(ctx, []int64{req.VolumeID}, func(ctx context.Context) (*CloneResult, *SdkError) {
+		res, err := m.sfClient.CloneVolume(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return &CloneResult{AsyncHandle: res.AsyncHandle, CloneID: res.CloneID, VolumeID: res.VolumeID}, nil
+	})
+}
+
+// CloneMultipleVolumes clones a group of volumes and waits for all clones. Every
+// member counts against the limits of its source volume and of the cluster.
+func (m *CloneManager) CloneMultipleVolumes(ctx context.Context, req *CloneMultipleVolumesRequest) (*CloneResult, error) {
+	var sources []int64
+	for _, v := range req.Volumes {
+		sources = append(sources, v.VolumeID)
+	}
+	return m.run(ctx, sources, func(ctx context.Context) (*CloneResult, *SdkError) {
+		res, err := m.sfClient.CloneMultipleVolumes(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return &CloneResult{AsyncHandle: res.AsyncHandle, GroupCloneID: res.GroupCloneID, Members: res.Members}, nil
+	})
+}
+
+// CopyVolume overwrites an existing volume with the contents of another and waits
+// for the copy.
+func (m *CloneManager) CopyVolume(ctx context.Context, req *CopyVolumeRequest) (*CloneResult, error) {
+	return m.run(ctx, []int64{req.VolumeID}, func(ctx context.Context) (*CloneResult, *SdkError) {
+		res, err := m.sfClient.CopyVolume(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return &CloneResult{AsyncHandle: res.AsyncHandle, CloneID: res.CloneID, VolumeID: req.DstVolumeID}, nil
+	})
+}
+
+// Running returns the number of jobs the manager is running, counting each member of
+// a group clone.
+func (m *CloneManager) Running() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.running
+}
+
+// Queued returns the number of requests waiting for a free slot.
+func (m *CloneManager) Queued() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.queue)
+}
+
+func (m *CloneManager) run(ctx context.Context, sources []int64, start func(context.Context) (*CloneResult, *SdkError)) (*CloneResult, error) {
+	if err := m.loadLimits(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.acquire(ctx, sources); err != nil {
+		return nil, err
+	}
+	defer m.release(sources)
+
+	poll := durationOr(m.PollInterval, 2*time.Second)
+	var res *CloneResult
+	for {
+		var sdkErr *SdkError
+		res, sdkErr = start(ctx)
+		if sdkErr == nil {
+			break
+		}
+		if sdkErr.Name != "xMaxSimultaneousClonesPerVolumeExceeded" {
+			return nil, sdkErr
+		}
+		log.WithContext(ctx).Debugf("Clone of volumes %v refused by the per-volume limit, retrying in %s", sources, poll)
+		if err := sleepCtx(ctx, poll); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.wait(ctx, res, poll); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// wait polls the job's async handle until it completes and fills in res from the result.
+func (m *CloneManager) wait(ctx context.Context, res *CloneResult, poll time.Duration) error {
+	maxErrors := m.MaxPollErrors
+	if maxErrors <= 0 {
+		maxErrors = 5
+	}
+	failures := 0
+	for {
+		status, sdkErr := m.sfClient.GetAsyncResult(ctx, &GetAsyncResultRequest{AsyncHandle: res.AsyncHandle})
+		if ctx.Err() != nil {
+			return m.cancel(ctx, res)
+		}
+		if sdkErr != nil {
+			failures++
+			if IsNotFound(sdkErr) || failures >= maxErrors {
+				return fmt.Errorf("waiting for async handle %d: %w", res.AsyncHandle, sdkErr)
+			}
+			log.WithContext(ctx).Warnf("GetAsyncResult for handle %d failed (%d of %d), retrying in %s: %v", res.AsyncHandle, failures, maxErrors, poll, sdkErr)
+		} else {
+			failures = 0
+			if status.Error != nil {
+				return &AsyncJobError{AsyncHandle: res.AsyncHandle, Name: status.Error.Name, Message: status.Error.Message}
+			}
+			if status.Status == "complete" {
+				return decodeCloneResult(status.Result, res)
+			}
+		}
+		if err := sleepCtx(ctx, poll); err != nil {
+			return m.cancel(ctx, res)
+		}
+	}
+}
+
+func decodeCloneResult(data RawResult, res *CloneResult) error {
+	var out CloneAsyncResult
+	if len(data.Raw()) > 0 {
+		if err := data.Decode(&out); err != nil {
+			return fmt.Errorf("decoding result of async handle %d: %w", res.AsyncHandle, err)
+		}
+	}
+	res.Message = out.Message
+	// The start response already has the IDs; the result only fills gaps. CopyVolume
+	// reports the source as volumeID and the destination as dstVolumeID.
+	switch {
+	case out.DstVolumeID != 0:
+		res.VolumeID = out.DstVolumeID
+	case res.VolumeID == 0:
+		res.VolumeID = out.VolumeID
+	}
+	if len(res.Members) == 0 {
+		res.Members = out.Members
+	}
+	return nil
+}
+
+// cancel stops the job of a request whose context is done. A job that has already
+// finished is not an error.
+func (m *CloneManager) cancel(ctx context.Context, res *CloneResult) error {
+	cctx, done := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+	defer done()
+	var sdkErr *SdkError
+	if res.GroupCloneID != 0 {
+		_, sdkErr = m.sfClient.CancelGroupClone(cctx, &CancelGroupCloneRequest{GroupCloneID: res.GroupCloneID})
+	} else {
+		_, sdkErr = m.sfClient.CancelClone(cctx, &CancelCloneRequest{CloneID: res.CloneID})
+	}
+	if sdkErr != nil && !IsNotFound(sdkErr) {
+		return errors.Join(ctx.Err(), fmt.Errorf("cancelling async handle %d: %w", res.AsyncHandle, sdkErr))
+	}
+	return ctx.Err()
+}
+
+// loadLimits reads the clone limits from GetLimits the first time they are needed,
+// unless MaxPerVolume and MaxConcurrent are both set.
+func (m *CloneManager) loadLimits(ctx context.Context) error {
+	m.mu.Lock()
+	loaded := m.perVolume > 0 || (m.MaxPerVolume > 0 && m.MaxConcurrent > 0)
+	m.mu.Unlock()
+	if loaded {
+		return nil
+	}
+	limits, sdkErr := m.sfClient.GetLimits(ctx)
+	if sdkErr != nil {
+		return sdkErr
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.perVolume = limits.CloneJobsPerVolumeMax
+	// Element has allowed two clones per volume since its first release.
+	if m.perVolume <= 0 {
+		m.perVolume = 2
+	}
+	m.perCluster = limits.CloneJobsPerClusterMax
+	if m.perCluster <= 0 {
+		m.perCluster = DefaultMaxConcurrentClones
+	}
+	return nil
+}
+
+// limitsLocked returns the per-volume and cluster-wide limits in effect.
+func (m *CloneManager) limitsLocked() (perVolume, perCluster int64) {
+	perVolume, perCluster = m.MaxPerVolume, m.MaxConcurrent
+	if perVolume <= 0 {
+		perVolume = cmp.Or(m.perVolume, 2)
+	}
+	if perCluster <= 0 {
+		perCluster = cmp.Or(m.perCluster, DefaultMaxConcurrentClones)
+	}
+	return perVolume, perCluster
+}
+
+// acquire queues a request and waits until its sources and the cluster have free slots.
+func (m *CloneManager) acquire(ctx context.Context, sources []int64) error {
+	w := &cloneWaiter{sources: sources, ready: make(chan struct{})}
+	m.mu.Lock()
+	m.queue = append(m.queue, w)
+	m.dispatchLocked()
+	m.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	select {
+	case <-w.ready:
+		m.releaseLocked(sources)
+	default:
+		m.queue = slices.DeleteFunc(m.queue, func(q *cloneWaiter) bool { return q == w })
+		m.dispatchLocked()
+	}
+	return ctx.Err()
+}
+
+func (m *CloneManager) release(sources []int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.releaseLocked(sources)
+}
+
+func (m *CloneManager) releaseLocked(sources []int64) {
+	m.running -= int64(len(sources))
+	for _, id := range sources {
+		if m.sources[id]--; m.sources[id] <= 0 {
+			delete(m.sources, id)
+		}
+	}
+	m.dispatchLocked()
+}
+
+// dispatchLocked starts queued requests in order. A request waiting for a source
+// volume is skipped, but one waiting for the cluster-wide limit holds back the
+// requests behind it, so large group clones are not starved by single clones.
+func (m *CloneManager) dispatchLocked() {
+	_, maxConcurrent := m.limitsLocked()
+	for i := 0; i < len(m.queue); {
+		w := m.queue[i]
+		// A request larger than the cluster-wide limit runs alone.
+		if m.running > 0 && m.running+int64(len(w.sources)) > maxConcurrent {
+			return
+		}
+		if !m.sourcesFreeLocked(w.sources) {
+			i++
+			continue
+		}
+		m.running += int64(len(w.sources))
+		for _, id := range w.sources {
+			m.sources[id]++
+		}
+		m.queue = slices.Delete(m.queue, i, i+1)
+		close(w.ready)
+	}
+}
+
+func (m *CloneManager) sourcesFreeLocked(sources []int64) bool {
+	maxPerVolume, _ := m.limitsLocked()
+	want := map[int64]int64{}
+	for _, id := range sources {
+		want[id]++
+	}
+	for id, n := range want {
+		if m.sources[id] > 0 && m.sources[id]+n > maxPerVolume {
+			return false
+		}
+	}
+	return true
+}
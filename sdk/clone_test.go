@@ -0,0 +1,201 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+// newCloneServer returns a simulator whose clones run until advance is called.
+func newCloneServer(t *testing.T) (*sdktest.Server, *sdk.SFClient, *sdk.CloneManager, func()) {
+	t.Helper()
+	srv, sf := newSimulator(t)
+	var offset atomic.Int64
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv.SetClock(func() time.Time { return start.Add(time.Duration(offset.Load())) })
+	srv.AsyncDelay = time.Hour
+	m := sdk.NewCloneManager(sf)
+	m.PollInterval = 5 * time.Millisecond
+	return srv, sf, m, func() { offset.Add(int64(time.Hour)) }
+}
+
+func TestCloneManagerResults(t *testing.T) {
+	srv, sf := newSimulator(t)
+	ctx := context.Background()
+	_, vols := createVolumes(t, sf, "src", "other", "dst")
+	m := sdk.NewCloneManager(sf)
+	m.PollInterval = 5 * time.Millisecond
+
+	clone, err := m.CloneVolume(ctx, &sdk.CloneVolumeRequest{VolumeID: vols[0], Name: "clone"})
+	if err != nil {
+		t.Fatalf("CloneVolume: %v", err)
+	}
+	list, sdkErr := sf.ListVolumes(ctx, &sdk.ListVolumesRequest{VolumeIDs: []int64{clone.VolumeID}})
+	if sdkErr != nil || len(list.Volumes) != 1 || list.Volumes[0].Name != "clone" || clone.CloneID == 0 || clone.Message == "" {
+		t.Fatalf("unexpected clone %+v: %v %+v", clone, sdkErr, list)
+	}
+
+	group, err := m.CloneMultipleVolumes(ctx, &sdk.CloneMultipleVolumesRequest{Volumes: []sdk.CloneMultipleVolumeParams{{VolumeID: vols[0]}, {VolumeID: vols[1]}}})
+	if err != nil || group.GroupCloneID == 0 || len(group.Members) != 2 || group.Members[1].SrcVolumeID != vols[1] {
+		t.Fatalf("CloneMultipleVolumes: %v %+v", err, group)
+	}
+
+	copied, err := m.CopyVolume(ctx, &sdk.CopyVolumeRequest{VolumeID: vols[0], DstVolumeID: vols[2]})
+	if err != nil || copied.VolumeID != vols[2] {
+		t.Fatalf("CopyVolume: %v %+v", err, copied)
+	}
+	if srv.CallCount("GetLimits") != 1 || m.Running() != 0 {
+		t.Fatalf("GetLimits called %d times, %d still running", srv.CallCount("GetLimits"), m.Running())
+	}
+}
+
+func TestCloneManagerQueuesWithinLimits(t *testing.T) {
+	srv, sf, m, advance := newCloneServer(t)
+	_, vols := createVolumes(t, sf, "a", "b")
+	ctx := context.Background()
+
+	// Three clones of a with the simulator's limit of two per volume, then one of b
+	// that may pass the queued clone of a.
+	results := make(chan *sdk.CloneResult, 4)
+	errs := make(chan error, 4)
+	clone := func(src int64) {
+		res, err := m.CloneVolume(ctx, &sdk.CloneVolumeRequest{VolumeID: src, Name: "clone"})
+		results <- res
+		errs <- err
+	}
+	for range 3 {
+		go clone(vols[0])
+	}
+	waitFor(t, "the per-volume limit", func() bool { return m.Running() == 2 && m.Queued() == 1 })
+	go clone(vols[1])
+	// Running counts a slot as soon as it is taken, before the call goes out.
+	waitFor(t, "the clone of b", func() bool { return m.Running() == 3 && srv.CallCount("CloneVolume") == 3 })
+	if m.Queued() != 1 {
+		t.Fatalf("%d clones queued, want 1", m.Queued())
+	}
+
+	advance()
+	seen := map[int64]bool{}
+	for i := range 4 {
+		if i == 3 {
+			// The queued clone of a starts once the first ones finish.
+			waitFor(t, "the queued clone", func() bool { return srv.CallCount("CloneVolume") == 4 })
+			advance()
+		}
+		if err := <-errs; err != nil {
+			t.Fatalf("CloneVolume: %v", err)
+		}
+		res := <-results
+		if res.VolumeID == 0 || seen[res.VolumeID] {
+			t.Fatalf("unexpected result %+v", res)
+		}
+		seen[res.VolumeID] = true
+	}
+	if jobs, err := sf.CloneJobs(ctx); err != nil || len(jobs) != 0 {
+		t.Fatalf("jobs left running: %v %+v", err, jobs)
+	}
+}
+
+func TestCloneManagerClusterLimit(t *testing.T) {
+	srv, sf, m, advance := newCloneServer(t)
+	srv.Limits.CloneJobsPerClusterMax = 2
+	_, vols := createVolumes(t, sf, "a", "b", "c")
+	ctx := context.Background()
+
+	errs := make(chan error, 2)
+	go func() {
+		_, err := m.CloneMultipleVolumes(ctx, &sdk.CloneMultipleVolumesRequest{Volumes: []sdk.CloneMultipleVolumeParams{{VolumeID: vols[0]}, {VolumeID: vols[1]}}})
+		errs <- err
+	}()
+	waitFor(t, "the group clone", func() bool { return m.Running() == 2 })
+	go func() {
+		_, err := m.CloneVolume(ctx, &sdk.CloneVolumeRequest{VolumeID: vols[2], Name: "c-clone"})
+		errs <- err
+	}()
+	waitFor(t, "the queued clone", func() bool { return m.Queued() == 1 })
+
+	jobs, err := sf.CloneJobs(ctx)
+	if err != nil || len(jobs) != 2 || jobs[0].GroupCloneID == 0 || jobs[0].AsyncHandle != jobs[1].AsyncHandle {
+		t.Fatalf("CloneJobs: %v %+v", err, jobs)
+	}
+	if srv.CallCount("CloneVolume") != 0 {
+		t.Fatal("clone started beyond the cluster limit")
+	}
+	advance()
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "the clone of c", func() bool { return srv.CallCount("CloneVolume") == 1 })
+	advance()
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCloneManagerCancel(t *testing.T) {
+	srv, sf, m, _ := newCloneServer(t)
+	_, vols := createVolumes(t, sf, "a", "b")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		_, err := m.CloneMultipleVolumes(ctx, &sdk.CloneMultipleVolumesRequest{Volumes: []sdk.CloneMultipleVolumeParams{{VolumeID: vols[0]}, {VolumeID: vols[1]}}})
+		errs <- err
+	}()
+	waitFor(t, "the group clone", func() bool { return srv.CallCount("GetAsyncResult") > 0 })
+	cancel()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	list, err := sf.ListVolumes(context.Background(), &sdk.ListVolumesRequest{})
+	if err != nil || len(list.Volumes) != 2 || srv.CallCount("CancelGroupClone") != 1 || m.Running() != 0 {
+		t.Fatalf("group clone not cancelled: %v, %d volumes", err, len(list.Volumes))
+	}
+
+	// A clone cancelled by someone else fails with the cluster's error.
+	go func() {
+		_, err := m.CloneVolume(context.Background(), &sdk.CloneVolumeRequest{VolumeID: vols[0], Name: "clone"})
+		errs <- err
+	}()
+	var jobs []sdk.CloneJob
+	waitFor(t, "the clone", func() bool { jobs, _ = sf.CloneJobs(context.Background()); return len(jobs) == 1 })
+	if _, err := sf.CancelClone(context.Background(), &sdk.CancelCloneRequest{CloneID: jobs[0].CloneID}); err != nil {
+		t.Fatal(err)
+	}
+	var jobErr *sdk.AsyncJobError
+	if err := <-errs; !errors.As(err, &jobErr) || jobErr.Name != "xCloneCanceled" {
+		t.Fatalf("expected xCloneCanceled, got %v", err)
+	}
+
+	// A request cancelled while queued never starts.
+	m.MaxConcurrent = 1
+	go m.CloneVolume(context.Background(), &sdk.CloneVolumeRequest{VolumeID: vols[0], Name: "running"})
+	waitFor(t, "the running clone", func() bool { return m.Running() == 1 })
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		_, err := m.CloneVolume(ctx, &sdk.CloneVolumeRequest{VolumeID: vols[1], Name: "queued"})
+		errs <- err
+	}()
+	waitFor(t, "the queued clone", func() bool { return m.Queued() == 1 })
+	cancel()
+	if err := <-errs; !errors.Is(err, context.Canceled) || m.Queued() != 0 || srv.CallCount("CloneVolume") != 2 {
+		t.Fatalf("queued clone: %v, %d queued, %d started", err, m.Queued(), srv.CallCount("CloneVolume"))
+	}
+}
+
+func TestCloneManagerGivesUpPolling(t *testing.T) {
+	srv, sf, m, _ := newCloneServer(t)
+	m.MaxPollErrors = 3
+	_, vols := createVolumes(t, sf, "a")
+	srv.InjectError("GetAsyncResult", "xDBConnectionLoss", "lost", 10)
+
+	_, err := m.CloneVolume(context.Background(), &sdk.CloneVolumeRequest{VolumeID: vols[0], Name: "clone"})
+	if err == nil || srv.CallCount("GetAsyncResult") != 3 || m.Running() != 0 {
+		t.Fatalf("expected the wait to give up after 3 polls, got %v after %d", err, srv.CallCount("GetAsyncResult"))
+	}
+}
@@ -0,0 +1,326 @@
+package sdk
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default environment variables read by EnvCredentials.
+const (
+	DefaultUsernameEnv = "SOLIDFIRE_USERNAME"
+	DefaultPasswordEnv = "SOLIDFIRE_PASSWORD"
+)
+
+// Credentials are a cluster admin username and password.
+type Credentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// CredentialProvider resolves credentials when they are needed. Providers are asked
+// again for every request (or every new auth session), so rotated secrets are picked
+// up without restarting the process.
+type CredentialProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialProviderFunc adapts a function to CredentialProvider.
+type CredentialProviderFunc func(ctx context.Context) (Credentials, error)
+
+func (f CredentialProviderFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// WithCredentialProvider authenticates each request with HTTP Basic credentials
+// from p. The username and password passed to Connect are then ignored.
+func WithCredentialProvider(p CredentialProvider) ClientOption {
+	return WithAuthenticator(CredentialAuth(p))
+}
+
+// CredentialAuth returns an Authenticator that sends Basic credentials from p.
+// It is also a suitable bootstrap for NewAdminSession and NewIdpSession.
+func CredentialAuth(p CredentialProvider) Authenticator {
+	return &credentialAuth{provider: p}
+}
+
+type credentialAuth struct {
+	provider CredentialProvider
+}
+
+func (a *credentialAuth) Authenticate(ctx context.Context, sfClient *SFClient, req *http.Request) error {
+	creds, err := a.provider.Credentials(ctx)
+	if err != nil {
+		return fmt.Errorf("looking up credentials: %w", err)
+	}
+	req.Header.Set("Authorization", "Basic "+makeBasicAuthHeader(creds.Username, creds.Password))
+	return nil
+}
+
+// Invalidate forwards a rejected login to providers that cache credentials.
+func (a *credentialAuth) Invalidate() {
+	if inv, ok := a.provider.(invalidator); ok {
+		inv.Invalidate()
+	}
+}
+
+// StaticCredentials always returns the same credentials.
+func StaticCredentials(username, password string) CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context) (Credentials, error) {
+		return Credentials{Username: username, Password: password}, nil
+	})
+}
+
+// EnvCredentials reads credentials from environment variables. Empty names default
+// to SOLIDFIRE_USERNAME and SOLIDFIRE_PASSWORD.
+func EnvCredentials(usernameVar, passwordVar string) CredentialProvider {
+	if usernameVar == "" {
+		usernameVar = DefaultUsernameEnv
+	}
+	if passwordVar == "" {
+		passwordVar = DefaultPasswordEnv
+	}
+	return CredentialProviderFunc(func(ctx context.Context) (Credentials, error) {
+		creds := Credentials{Username: os.Getenv(usernameVar), Password: os.Getenv(passwordVar)}
+		if creds.Username == "" || creds.Password == "" {
+			return Credentials{}, fmt.Errorf("%s and %s must both be set", usernameVar, passwordVar)
+		}
+		return creds, nil
+	})
+}
+
+// FileCredentials reads the username and password from two files, such as the keys of
+// a mounted Kubernetes secret. The files are read on every lookup, so a rotated secret
+// takes effect immediately. Files readable by other users or writable by the group are
+// rejected; mount secrets with defaultMode 0400 or 0440.
+func FileCredentials(usernameFile, passwordFile string) CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context) (Credentials, error) {
+		username, err := readSecretFile(usernameFile)
+		if err != nil {
+			return Credentials{}, err
+		}
+		password, err := readSecretFile(passwordFile)
+		if err != nil {
+			return Credentials{}, err
+		}
+		return Credentials{Username: username, Password: password}, nil
+	})
+}
+
+// SecretDirCredentials reads the "username" and "password" files in dir.
+func SecretDirCredentials(dir string) CredentialProvider {
+	return FileCredentials(dir+string(os.PathSeparator)+"username", dir+string(os.PathSeparator)+"password")
+}
+
+// readSecretFile returns the trimmed content of path after checking its permissions.
+func readSecretFile(path string) (string, error) {
+	if err := checkSecretPermissions(path); err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading credentials: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// checkSecretPermissions rejects files that other users can read or the group can write.
+// The check follows symlinks, which is how Kubernetes mounts secret keys.
+func checkSecretPermissions(path string) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("reading credentials: %w", err)
+	}
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	if mode := fi.Mode().Perm(); mode&0o027 != 0 {
+		return fmt.Errorf("credentials file %s has mode %04o; it must not be readable by others or writable by the group", path, mode)
+	}
+	return nil
+}
+
+// NetrcCredentials looks up host in a netrc-style file ("machine <host> login <user>
+// password <pass>", with an optional "default" entry). An empty path means ~/.netrc.
+// The file is re-read on every lookup and must not be accessible by other users.
+func NetrcCredentials(path, host string) CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context) (Credentials, error) {
+		p := path
+		if p == "" {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return Credentials{}, err
+			}
+			p = home + string(os.PathSeparator) + ".netrc"
+		}
+		if err := checkSecretPermissions(p); err != nil {
+			return Credentials{}, err
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return Credentials{}, fmt.Errorf("reading credentials: %w", err)
+		}
+		creds, ok := parseNetrc(data, host)
+		if !ok {
+			return Credentials{}, fmt.Errorf("no netrc entry for %s in %s", host, p)
+		}
+		return creds, nil
+	})
+}
+
+// parseNetrc returns the entry for host, falling back to the "default" entry.
+func parseNetrc(data []byte, host string) (Credentials, bool) {
+	var tokens []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		tokens = append(tokens, strings.Fields(line)...)
+	}
+
+	var match, fallback *Credentials
+	var current *Credentials
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i] {
+		case "machine":
+			current = nil
+			if i+1 < len(tokens) {
+				i++
+				if tokens[i] == host && match == nil {
+					match = &Credentials{}
+					current = match
+				}
+			}
+		case "default":
+			current = nil
+			if fallback == nil {
+				fallback = &Credentials{}
+				current = fallback
+			}
+		case "login", "password", "account":
+			if i+1 >= len(tokens) {
+				break
+			}
+			i++
+			if current == nil {
+				continue
+			}
+			if tokens[i-1] == "login" {
+				current.Username = tokens[i]
+			} else if tokens[i-1] == "password" {
+				current.Password = tokens[i]
+			}
+		case "macdef":
+			// Macro definitions run to the end of the file in this simplified reader.
+			i = len(tokens)
+		}
+	}
+	if match != nil {
+		return *match, true
+	}
+	if fallback != nil {
+		return *fallback, true
+	}
+	return Credentials{}, false
+}
+
+// ExecCredentials runs an external plugin that prints credentials as JSON on stdout:
+//
+//	{"username": "admin", "password": "...", "expiration": "2024-05-01T12:00:00Z"}
+//
+// The result is cached until its expiration, or for TTL when the plugin does not
+// report one. Credentials never appear on a command line.
+type ExecCredentials struct {
+	// Command is the plugin executable.
+	Command string
+	// Args are passed to the plugin.
+	Args []string
+	// Env is added to the plugin's environment.
+	Env []string
+	// TTL caches results without an expiration. Default 5 minutes; negative disables caching.
+	TTL time.Duration
+	// Timeout bounds a single plugin run. Default 30 seconds.
+	Timeout time.Duration
+
+	mu      sync.Mutex
+	cached  *Credentials
+	expires time.Time
+}
+
+type execCredentialsOutput struct {
+	Credentials
+	Expiration string `json:"expiration"`
+}
+
+func (e *ExecCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.cached != nil && time.Now().Before(e.expires) {
+		return *e.cached, nil
+	}
+
+	timeout := durationOr(e.Timeout, 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, e.Command, e.Args...)
+	cmd.Env = append(os.Environ(), e.Env...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return Credentials{}, fmt.Errorf("credential plugin %s: %w: %s", e.Command, err, strings.TrimSpace(stderr.String()))
+	}
+	var out execCredentialsOutput
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return Credentials{}, fmt.Errorf("credential plugin %s: decoding output: %w", e.Command, err)
+	}
+	if out.Username == "" || out.Password == "" {
+		return Credentials{}, errors.New("credential plugin " + e.Command + " returned no username or password")
+	}
+
+	e.cached, e.expires = &out.Credentials, time.Time{}
+	if out.Expiration != "" {
+		t, err := time.Parse(time.RFC3339, out.Expiration)
+		if err != nil {
+			return Credentials{}, fmt.Errorf("credential plugin %s: invalid expiration: %w", e.Command, err)
+		}
+		e.expires = t
+	} else if e.TTL >= 0 {
+		e.expires = time.Now().Add(durationOr(e.TTL, 5*time.Minute))
+	}
+	return out.Credentials, nil
+}
+
+// Invalidate drops the cached result so the plugin runs again on the next lookup.
+func (e *ExecCredentials) Invalidate() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cached = nil
+}
+
+// ChainCredentials returns the credentials of the first provider that succeeds.
+func ChainCredentials(providers ...CredentialProvider) CredentialProvider {
+	return CredentialProviderFunc(func(ctx context.Context) (Credentials, error) {
+		var errs []error
+		for _, p := range providers {
+			creds, err := p.Credentials(ctx)
+			if err == nil {
+				return creds, nil
+			}
+			errs = append(errs, err)
+		}
+		return Credentials{}, fmt.Errorf("no credential provider succeeded: %w", errors.Join(errs...))
+	})
+}
@@ -0,0 +1,110 @@
+package sdk
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func writeSecret(t *testing.T, path, content string, mode os.FileMode) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), mode); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileCredentialsRotation(t *testing.T) {
+	dir := t.TempDir()
+	writeSecret(t, filepath.Join(dir, "username"), "admin\n", 0o400)
+	writeSecret(t, filepath.Join(dir, "password"), "first\n", 0o600)
+	p := SecretDirCredentials(dir)
+
+	creds, err := p.Credentials(context.Background())
+	if err != nil || creds != (Credentials{"admin", "first"}) {
+		t.Fatalf("Credentials: %v %+v", err, creds)
+	}
+	writeSecret(t, filepath.Join(dir, "password"), "second", 0o600)
+	if creds, _ := p.Credentials(context.Background()); creds.Password != "second" {
+		t.Fatalf("rotated password not picked up: %+v", creds)
+	}
+}
+
+func TestFileCredentialsPermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not enforced on Windows")
+	}
+	dir := t.TempDir()
+	writeSecret(t, filepath.Join(dir, "username"), "admin", 0o400)
+	writeSecret(t, filepath.Join(dir, "password"), "secret", 0o644)
+	if _, err := SecretDirCredentials(dir).Credentials(context.Background()); err == nil {
+		t.Fatal("expected a world readable password file to be rejected")
+	}
+}
+
+func TestNetrcCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "netrc")
+	writeSecret(t, path, `# lab clusters
+machine 10.1.1.1 login admin password one
+machine 10.2.2.2
+  login ops
+  password two
+default login guest password three
+`, 0o600)
+
+	for host, want := range map[string]Credentials{
+		"10.1.1.1": {"admin", "one"},
+		"10.2.2.2": {"ops", "two"},
+		"10.9.9.9": {"guest", "three"},
+	} {
+		got, err := NetrcCredentials(path, host).Credentials(context.Background())
+		if err != nil || got != want {
+			t.Errorf("%s: got %+v %v, want %+v", host, got, err, want)
+		}
+	}
+}
+
+func TestExecCredentials(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script plugin")
+	}
+	dir := t.TempDir()
+	plugin := filepath.Join(dir, "plugin.sh")
+	counter := filepath.Join(dir, "runs")
+	script := "#!/bin/sh\necho x >> " + counter + "\necho '{\"username\":\"admin\",\"password\":\"from-plugin\"}'\n"
+	if err := os.WriteFile(plugin, []byte(script), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	p := &ExecCredentials{Command: plugin}
+	for i := 0; i < 2; i++ {
+		creds, err := p.Credentials(context.Background())
+		if err != nil || creds.Password != "from-plugin" {
+			t.Fatalf("Credentials: %v %+v", err, creds)
+		}
+	}
+	p.Invalidate()
+	p.Credentials(context.Background())
+	runs, _ := os.ReadFile(counter)
+	if n := len(runs) / 2; n != 2 {
+		t.Fatalf("plugin ran %d times, want 2 (cached once, rerun after Invalidate)", n)
+	}
+}
+
+func TestEnvAndChainCredentials(t *testing.T) {
+	t.Setenv(DefaultUsernameEnv, "")
+	t.Setenv(DefaultPasswordEnv, "")
+	p := ChainCredentials(EnvCredentials("", ""), StaticCredentials("admin", "static"))
+	if creds, err := p.Credentials(context.Background()); err != nil || creds.Password != "static" {
+		t.Fatalf("fallback: %v %+v", err, creds)
+	}
+	t.Setenv(DefaultUsernameEnv, "admin")
+	t.Setenv(DefaultPasswordEnv, "from-env")
+	if creds, err := p.Credentials(context.Background()); err != nil || creds.Password != "from-env" {
+		t.Fatalf("env: %v %+v", err, creds)
+	}
+}
@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors matched by SdkError.Is, for use with errors.Is.
+var (
+	// ErrNotFound matches errors for objects that do not exist, such as xUnknownAccount or xVolumeIDDoesNotExist.
+	ErrNotFound = errors.New("sdk: object not found")
+	// ErrAlreadyExists matches errors for objects that already exist, such as xDuplicateUsername.
+	ErrAlreadyExists = errors.New("sdk: object already exists")
+	// ErrPermissionDenied matches authorization failures, including HTTP 401 and 403.
+	ErrPermissionDenied = errors.New("sdk: permission denied")
+	// ErrBusy matches transient cluster conditions such as xClusterBusy and HTTP 503.
+	ErrBusy = errors.New("sdk: cluster busy")
+)
+
+// notFoundExceptions start with "xUnknown" but describe a bad request, not a missing object.
+var notFoundExceptions = map[string]bool{
+	"xUnknownParameter":  true,
+	"xUnknownAPIMethod":  true,
+	"xUnknownRPCMethod":  true,
+	"xUnknownAPIVersion": true,
+}
+
+var permissionDeniedNames = map[string]bool{
+	"xPermissionDenied":     true,
+	"xNotAuthorized":        true,
+	"xAuthenticationFailed": true,
+}
+
+// Is lets errors.Is match an SdkError against the sentinel errors above.
+func (this *SdkError) Is(target error) bool {
+	if this == nil {
+		return false
+	}
+	switch target {
+	case ErrNotFound:
+		return isNotFoundName(this.Name)
+	case ErrAlreadyExists:
+		return isAlreadyExistsName(this.Name)
+	case ErrPermissionDenied:
+		return permissionDeniedNames[this.Name] ||
+			this.HTTPStatus == http.StatusUnauthorized || this.HTTPStatus == http.StatusForbidden
+	case ErrBusy:
+		if this.HTTPStatus == http.StatusServiceUnavailable {
+			return true
+		}
+		for _, name := range DefaultRetryableErrorNames {
+			if this.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isNotFoundName(name string) bool {
+	if notFoundExceptions[name] {
+		return false
+	}
+	return strings.HasPrefix(name, "xUnknown") || strings.HasSuffix(name, "DoesNotExist") || strings.HasSuffix(name, "NotFound")
+}
+
+func isAlreadyExistsName(name string) bool {
+	if strings.HasSuffix(name, "DoesNotExist") {
+		return false
+	}
+	return strings.HasPrefix(name, "xDuplicate") || strings.HasSuffix(name, "Exists")
+}
+
+// IsNotFound reports whether err is an SdkError for an object that does not exist.
+func IsNotFound(err error) bool { return errors.Is(err, ErrNotFound) }
+
+// IsAlreadyExists reports whether err is an SdkError for an object that already exists.
+func IsAlreadyExists(err error) bool { return errors.Is(err, ErrAlreadyExists) }
+
+// IsPermissionDenied reports whether err is an SdkError for a rejected or unauthorized call.
+func IsPermissionDenied(err error) bool { return errors.Is(err, ErrPermissionDenied) }
+
+// IsBusy reports whether err is an SdkError for a transient cluster condition.
+func IsBusy(err error) bool { return errors.Is(err, ErrBusy) }
+
+// ErrorName returns the Element error name carried by err, or "" if err is not an SdkError.
+func ErrorName(err error) string {
+	var sdkErr *SdkError
+	if errors.As(err, &sdkErr) && sdkErr != nil {
+		return sdkErr.Name
+	}
+	return ""
+}
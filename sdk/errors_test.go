@@ -0,0 +1,65 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSdkErrorSentinels(t *testing.T) {
+	cases := []struct {
+		err  *SdkError
+		want error
+	}{
+		{&SdkError{Name: "xUnknownAccount"}, ErrNotFound},
+		{&SdkError{Name: "xVolumeIDDoesNotExist"}, ErrNotFound},
+		{&SdkError{Name: "xDuplicateUsername"}, ErrAlreadyExists},
+		{&SdkError{Name: "xPermissionDenied"}, ErrPermissionDenied},
+		{&SdkError{HTTPStatus: http.StatusUnauthorized}, ErrPermissionDenied},
+		{&SdkError{Name: "xClusterBusy"}, ErrBusy},
+	}
+	for _, c := range cases {
+		if !errors.Is(c.err, c.want) {
+			t.Errorf("%+v does not match %v", c.err, c.want)
+		}
+		wrapped := fmt.Errorf("wrapped: %w", c.err)
+		if !errors.Is(wrapped, c.want) {
+			t.Errorf("wrapped %+v does not match %v", c.err, c.want)
+		}
+	}
+	if IsNotFound(&SdkError{Name: "xUnknownParameter"}) {
+		t.Error("xUnknownParameter must not be treated as not found")
+	}
+	if IsAlreadyExists(&SdkError{Name: "xVolumeIDDoesNotExist"}) {
+		t.Error("DoesNotExist must not be treated as already exists")
+	}
+	var nilErr *SdkError
+	if IsNotFound(nilErr) {
+		t.Error("nil SdkError must not match")
+	}
+}
+
+func TestSdkErrorFromResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":1,"error":{"code":500,"name":"xVolumeIDDoesNotExist","message":"Volume 5 does not exist."}}`))
+	}))
+	defer srv.Close()
+	sf := &SFClient{baseUrl: srv.URL}
+	_, sdkErr := sf.DeleteVolume(context.Background(), &DeleteVolumeRequest{VolumeID: 5})
+	if !IsNotFound(sdkErr) {
+		t.Fatalf("expected not found, got %v", sdkErr)
+	}
+	if sdkErr.Method != "DeleteVolume" || sdkErr.Name != "xVolumeIDDoesNotExist" || sdkErr.APICode != 500 || sdkErr.HTTPStatus != 200 {
+		t.Errorf("unexpected structured fields: %+v", sdkErr)
+	}
+	// The legacy fields keep their format for existing callers.
+	if sdkErr.Code != "sfapi.500" || sdkErr.Detail != "500:Volume 5 does not exist." {
+		t.Errorf("legacy fields changed: %q %q", sdkErr.Code, sdkErr.Detail)
+	}
+	if ErrorName(fmt.Errorf("ctx: %w", sdkErr)) != "xVolumeIDDoesNotExist" {
+		t.Error("ErrorName did not unwrap")
+	}
+}
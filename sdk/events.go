@@ -0,0 +1,324 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// CheckpointStore persists the ID of the last event an EventStream has handled, so a
+// restarted stream resumes right after it. Load returns 0 when nothing was saved.
+type CheckpointStore interface {
+	Load(ctx context.Context) (int64, error)
+	Save(ctx context.Context, eventID int64) error
+}
+
+// MemoryCheckpoint keeps the checkpoint in memory. It is the default and does not
+// survive restarts.
+type MemoryCheckpoint struct {
+	mu      sync.Mutex
+	eventID int64
+}
+
+func (m *MemoryCheckpoint) Load(ctx context.Context) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.eventID, nil
+}
+
+func (m *MemoryCheckpoint) Save(ctx context.Context, eventID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.eventID = eventID
+	return nil
+}
+
+// FileCheckpoint stores the checkpoint as JSON in Path. Each save writes a temporary
+// file and renames it over Path, so a crash never leaves a torn checkpoint.
+type FileCheckpoint struct {
+	Path string
+}
+
+type checkpointFile struct {
+	EventID int64 `json:"eventID"`
+}
+
+func (f FileCheckpoint) Load(ctx context.Context) (int64, error) {
+	data, err := os.ReadFile(f.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("reading checkpoint: %w", err)
+	}
+	var cp checkpointFile
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return 0, fmt.Errorf("parsing checkpoint %s: %w", f.Path, err)
+	}
+	return cp.EventID, nil
+}
+
+func (f FileCheckpoint) Save(ctx context.Context, eventID int64) error {
+	data, _ := json.Marshal(checkpointFile{EventID: eventID})
+	if err := writeFileAtomic(f.Path, append(data, '\n')); err != nil {
+		return fmt.Errorf("writing checkpoint: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames it over
+// path, so a crash never leaves a torn file.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// EventStream follows the cluster event log. It pages through ListEvents by EventID,
+// delivers matching events in order on a channel and records its position in
+// Checkpoint, so a restarted stream continues without gaps or duplicates.
+//
+// By default an event counts as handled once the consumer has received it from the
+// channel. Set ManualCommit and call Commit after an event has been shipped elsewhere
+// to get at-least-once delivery across crashes instead.
+type EventStream struct {
+	// Checkpoint stores the last handled EventID. Default is a MemoryCheckpoint.
+	Checkpoint CheckpointStore
+	// PageSize is the maxEvents of each ListEvents call. Default 1000.
+	PageSize int64
+	// PollInterval is the wait after the stream has caught up or a call failed. Default 10 seconds.
+	PollInterval time.Duration
+	// SkipBacklog starts a stream without a checkpoint after the newest existing event
+	// instead of at the oldest one.
+	SkipBacklog bool
+	// ManualCommit leaves checkpointing to Commit.
+	ManualCommit bool
+	// EventQueueType selects the "standard" (default) or "vvol" event queue.
+	EventQueueType string
+
+	// EventTypes, NodeIDs, DriveIDs and ServiceIDs restrict the delivered events; an
+	// empty list matches everything. DriveIDs matches any drive an event refers to.
+	EventTypes []string
+	NodeIDs    []int64
+	DriveIDs   []int64
+	ServiceIDs []int64
+	// Filter, if set, must also return true for an event to be delivered.
+	Filter func(*EventInfo) bool
+
+	sfClient *SFClient
+	mu       sync.Mutex
+	err      error
+	started  bool
+	// delivered, committed and saved are the last EventIDs sent on the channel,
+	// passed to Commit and written to Checkpoint.
+	delivered, committed, saved int64
+}
+
+// NewEventStream returns a stream over sfClient's event log that checkpoints to store.
+// A nil store keeps the checkpoint in memory.
+func NewEventStream(sfClient *SFClient, store CheckpointStore) *EventStream {
+	return &EventStream{sfClient: sfClient, Checkpoint: store}
+}
+
+// Matches reports whether event passes the stream's filters.
+func (s *EventStream) Matches(event *EventInfo) bool {
+	if len(s.EventTypes) > 0 && !slices.Contains(s.EventTypes, event.EventInfoType) {
+		return false
+	}
+	if len(s.NodeIDs) > 0 && !slices.Contains(s.NodeIDs, event.NodeID) {
+		return false
+	}
+	if len(s.ServiceIDs) > 0 && !slices.Contains(s.ServiceIDs, event.ServiceID) {
+		return false
+	}
+	if len(s.DriveIDs) > 0 && !slices.Contains(s.DriveIDs, event.DriveID) &&
+		!slices.ContainsFunc(event.DriveIDs, func(id int64) bool { return slices.Contains(s.DriveIDs, id) }) {
+		return false
+	}
+	return s.Filter == nil || s.Filter(event)
+}
+
+// Events starts the stream and returns its channel. The channel is closed when ctx
+// is done or the checkpoint store fails; Err then reports why. Failed ListEvents calls
+// are logged and retried after PollInterval. A stream can be started only once; wait
+// for the channel to close before starting another stream on the same Checkpoint.
+func (s *EventStream) Events(ctx context.Context) <-chan EventInfo {
+	out := make(chan EventInfo)
+	s.mu.Lock()
+	if s.started {
+		s.mu.Unlock()
+		close(out)
+		return out
+	}
+	s.started = true
+	if s.Checkpoint == nil {
+		s.Checkpoint = &MemoryCheckpoint{}
+	}
+	s.mu.Unlock()
+
+	go func() {
+		defer close(out)
+		err := s.run(ctx, out)
+		s.mu.Lock()
+		s.err = err
+		s.mu.Unlock()
+	}()
+	return out
+}
+
+// Err returns the error that closed the events channel: ctx.Err() after cancellation,
+// or the checkpoint error that stopped the stream.
+func (s *EventStream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Commit records eventID as handled. It is only needed with ManualCommit, where the
+// stream does not move its checkpoint past a delivered event until it is committed.
+func (s *EventStream) Commit(ctx context.Context, eventID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.started {
+		return errors.New("EventStream has not been started")
+	}
+	if eventID > s.committed {
+		s.committed = eventID
+	}
+	return s.saveLocked(ctx, eventID)
+}
+
+// save checkpoints eventID. With ManualCommit, positions reached by skipping filtered
+// events are only saved while no delivered event is waiting for Commit.
+func (s *EventStream) save(ctx context.Context, eventID int64, skipped bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if skipped && s.ManualCommit && s.committed < s.delivered {
+		return nil
+	}
+	return s.saveLocked(ctx, eventID)
+}
+
+func (s *EventStream) saveLocked(ctx context.Context, eventID int64) error {
+	if eventID <= s.saved {
+		return nil
+	}
+	if err := s.Checkpoint.Save(ctx, eventID); err != nil {
+		return err
+	}
+	s.saved = eventID
+	return nil
+}
+
+func (s *EventStream) run(ctx context.Context, out chan<- EventInfo) error {
+	last, err := s.Checkpoint.Load(ctx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.saved, s.committed, s.delivered = last, last, last
+	s.mu.Unlock()
+	pageSize := s.PageSize
+	if pageSize <= 0 {
+		pageSize = 1000
+	}
+	poll := durationOr(s.PollInterval, 10*time.Second)
+	deliver := !(s.SkipBacklog && last == 0)
+
+	for {
+		events, err := s.page(ctx, last+1, pageSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.WithContext(ctx).Warnf("ListEvents from event %d failed, retrying in %s: %v", last+1, poll, err)
+			if err := sleepCtx(ctx, poll); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, e := range events {
+			// The position only ever moves forward, so events at or below it are
+			// ignored rather than delivered twice.
+			if e.EventID <= last {
+				continue
+			}
+			last = e.EventID
+			if !deliver || !s.Matches(&e) {
+				continue
+			}
+			s.mu.Lock()
+			s.delivered = last
+			s.mu.Unlock()
+			select {
+			case out <- e:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			if !s.ManualCommit {
+				if err := s.save(ctx, last, false); err != nil {
+					return err
+				}
+			}
+		}
+		// Record the events skipped by the filters (or the backlog) once per page.
+		if len(events) > 0 {
+			if err := s.save(ctx, last, true); err != nil {
+				return err
+			}
+		}
+		if int64(len(events)) == pageSize {
+			continue
+		}
+		deliver = true
+		if err := sleepCtx(ctx, poll); err != nil {
+			return err
+		}
+	}
+}
+
+// page returns up to pageSize events starting at start, sorted by EventID.
+func (s *EventStream) page(ctx context.Context, start, pageSize int64) ([]EventInfo, error) {
+	req := &ListEventsRequest{StartEventID: start, MaxEvents: pageSize, EventQueueType: s.EventQueueType}
+	res, sdkErr := s.sfClient.ListEvents(ctx, req)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	events := res.Events
+	sort.Slice(events, func(i, j int) bool { return events[i].EventID < events[j].EventID })
+	return events, nil
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
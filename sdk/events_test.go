@@ -0,0 +1,148 @@
+package sdk_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+// receive reads n events from ch, failing the test if they do not arrive in time.
+func receive(t *testing.T, ch <-chan sdk.EventInfo, n int) []int64 {
+	t.Helper()
+	var ids []int64
+	for len(ids) < n {
+		select {
+		case e, ok := <-ch:
+			if !ok {
+				t.Fatalf("stream closed after %v", ids)
+			}
+			ids = append(ids, e.EventID)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out after %v", ids)
+		}
+	}
+	return ids
+}
+
+func expectNone(t *testing.T, ch <-chan sdk.EventInfo) {
+	t.Helper()
+	select {
+	case e := <-ch:
+		t.Fatalf("unexpected event %+v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestEventStreamFiltersAndPages(t *testing.T) {
+	srv, sf := newSimulator(t)
+	for i := 0; i < 5; i++ {
+		srv.AddEvent(sdk.EventInfo{EventInfoType: "driveEvent", NodeID: 1, DriveIDs: []int64{int64(10 + i)}})
+		srv.AddEvent(sdk.EventInfo{EventInfoType: "serviceEvent", NodeID: 2, ServiceID: 7})
+	}
+
+	s := sdk.NewEventStream(sf, nil)
+	s.PageSize, s.PollInterval = 3, 10*time.Millisecond
+	s.EventTypes = []string{"driveEvent"}
+	s.DriveIDs = []int64{11, 13}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := s.Events(ctx)
+
+	if got := receive(t, events, 2); got[0] != 3 || got[1] != 7 {
+		t.Fatalf("got events %v, want [3 7]", got)
+	}
+	expectNone(t, events)
+	id := srv.AddEvent(sdk.EventInfo{EventInfoType: "driveEvent", DriveIDs: []int64{13}})
+	if got := receive(t, events, 1); got[0] != id {
+		t.Fatalf("got %v, want new event %d", got, id)
+	}
+	cancel()
+	for range events {
+	}
+	if s.Err() != context.Canceled {
+		t.Fatalf("Err() = %v", s.Err())
+	}
+}
+
+func TestEventStreamResumesFromCheckpoint(t *testing.T) {
+	srv, sf := newSimulator(t)
+	for i := 0; i < 4; i++ {
+		srv.AddEvent(sdk.EventInfo{EventInfoType: "apiEvent"})
+	}
+	store := sdk.FileCheckpoint{Path: filepath.Join(t.TempDir(), "events.json")}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := sdk.NewEventStream(sf, store)
+	s.PollInterval = 10 * time.Millisecond
+	events := s.Events(ctx)
+	first := receive(t, events, 3)
+	cancel()
+	// Events received until the channel closes are checkpointed like the others.
+	for e := range events {
+		first = append(first, e.EventID)
+	}
+
+	srv.AddEvent(sdk.EventInfo{EventInfoType: "apiEvent"})
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	s = sdk.NewEventStream(sf, store)
+	s.PollInterval = 10 * time.Millisecond
+	events = s.Events(ctx)
+	second := receive(t, events, 5-len(first))
+	expectNone(t, events)
+
+	all := append(first, second...)
+	for i, id := range all {
+		if id != int64(i+1) {
+			t.Fatalf("events across restart = %v, want 1..5 without gaps or duplicates", all)
+		}
+	}
+}
+
+func TestEventStreamSkipBacklog(t *testing.T) {
+	srv, sf := newSimulator(t)
+	for i := 0; i < 3; i++ {
+		srv.AddEvent(sdk.EventInfo{EventInfoType: "apiEvent"})
+	}
+	s := sdk.NewEventStream(sf, nil)
+	s.PollInterval, s.SkipBacklog = 10*time.Millisecond, true
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := s.Events(ctx)
+	expectNone(t, events)
+	id := srv.AddEvent(sdk.EventInfo{EventInfoType: "apiEvent"})
+	if got := receive(t, events, 1); got[0] != id {
+		t.Fatalf("got %v, want only the new event %d", got, id)
+	}
+}
+
+func TestEventStreamManualCommit(t *testing.T) {
+	srv, sf := newSimulator(t)
+	srv.AddEvent(sdk.EventInfo{EventInfoType: "apiEvent"})
+	srv.AddEvent(sdk.EventInfo{EventInfoType: "apiEvent"})
+	store := &sdk.MemoryCheckpoint{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := sdk.NewEventStream(sf, store)
+	s.PollInterval, s.ManualCommit = 10*time.Millisecond, true
+	events := s.Events(ctx)
+	got := receive(t, events, 2)
+	if err := s.Commit(ctx, got[0]); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	for range events {
+	}
+
+	// Event 2 was received but never committed, so it is delivered again.
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	s = sdk.NewEventStream(sf, store)
+	s.PollInterval, s.ManualCommit = 10*time.Millisecond, true
+	if again := receive(t, s.Events(ctx), 1); again[0] != 2 {
+		t.Fatalf("redelivered %v, want [2]", again)
+	}
+}
@@ -0,0 +1,5 @@
+package sdk
+
+// The generated_*.go files are rendered from the API descriptor in api/element.json.
+// Edit the descriptor, not the generated files.
+//go:generate go run ../cmd/sdkgen -api api/element.json -out .
@@ -0,0 +1,448 @@
+// Code generated by sdkgen from api/element.json. DO NOT EDIT.
+
+package sdk
+
+import "context"
+
+// NodeApi holds the methods served by the per-node API. It is implemented by NodeClient.
+type NodeApi interface {
+	// The CheckPingOnVlan API method provides the ability to test IP address(s) reachability on a not-yet-configured VLAN.
+	// This API creates a temporary vlan interface, uses it to ping the provided list of host IP addresses, removes the VLAN interface.
+	// If the vlan interface already exits TestPing should be used.
+	CheckPingOnVlan(ctx context.Context, req *CheckPingOnVlanRequest) (*CheckPingOnVlanResult, *SdkError)
+
+	// CheckProposedCluster validates that creating a cluster from a given set of nodes is likely to succeed.  Any problems with the proposed cluster are returned as errors with a human-readable description and unique error code.
+	CheckProposedCluster(ctx context.Context, req *CheckProposedClusterRequest) (*CheckProposedResult, *SdkError)
+
+	// The CreateCluster method enables you to initialize the node in a cluster that has ownership of the "mvip" and "svip" addresses. Each new cluster is initialized using the management IP (MIP) of the first node in the cluster. This method also automatically adds all the nodes being configured into the cluster. You only need to use this method once each time a new cluster is initialized.
+	// Note: You need to log in to the node that is used as the master node for the cluster. After you log in, run the GetBootstrapConfig method on the node to get the IP addresses for the rest of the nodes that you want to include in the
+	// cluster. Then, run the CreateCluster method.
+	CreateCluster(ctx context.Context, req *CreateClusterRequest) (*CreateClusterResult, *SdkError)
+
+	// CreateSupportBundle enables you to create a support bundle file under the node's directory. After creation, the bundle is stored on the node as a tar.gz file.
+	CreateSupportBundle(ctx context.Context, req *CreateSupportBundleRequest) (*CreateSupportBundleResult, *SdkError)
+
+	// DeleteAllSupportBundles enables you to delete all support bundles generated with the CreateSupportBundle API method.
+	DeleteAllSupportBundles(ctx context.Context) (*DeleteAllSupportBundlesResult, *SdkError)
+
+	// GetBootstrapConfig returns cluster and node information from the bootstrap configuration file. Use this API method on an individual node before it has been joined with a cluster. You can use the information this method returns in the cluster configuration interface when you create a cluster.
+	// If a cluster has already been created, this can be used to obtain the MVIP and SVIP addresses of the cluster.
+	GetBootstrapConfig(ctx context.Context) (*GetBootstrapConfigResult, *SdkError)
+
+	// The GetClusterConfig API method enables you to return information about the cluster configuration this node uses to communicate with the cluster that it is a part of.
+	GetClusterConfig(ctx context.Context, req *GetClusterConfigRequest) (*GetClusterConfigResult, *SdkError)
+
+	// The GetConfig API method enables you to retrieve all configuration information for a node. This method includes the same information available in both the GetClusterConfig and GetNetworkConfig API methods.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	GetConfig(ctx context.Context, req *GetConfigRequest) (*GetConfigResult, *SdkError)
+
+	// GetDriveConfig enables you to display drive information for expected slice and block drive counts as well as the number of slices
+	// and block drives that are currently connected to the node.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	GetDriveConfig(ctx context.Context, req *GetDriveConfigRequest) (*GetDriveConfigResult, *SdkError)
+
+	// GetHardwareConfig enables you to display the hardware configuration information for a node.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	GetHardwareConfig(ctx context.Context, req *GetHardwareConfigRequest) (*GetHardwareConfigResult, *SdkError)
+
+	// The GetHardwareInfo API method enables you to return hardware information and status for a single node. This generally includes details about manufacturers, vendors, versions, drives, and other associated hardware identification information.
+	GetHardwareInfo(ctx context.Context) (*GetHardwareInfoResult, *SdkError)
+
+	// The GetNetworkConfig API method enables you to display the network configuration information for a node.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	GetNetworkConfig(ctx context.Context, req *GetNetworkConfigRequest) (*GetNetworkConfigResult, *SdkError)
+
+	// You can use the GetNodeActiveTlsCiphers method to get a list of the TLS ciphers that are currently accepted on this node.
+	// You can use this method on both management and storage nodes.
+	GetNodeActiveTlsCiphers(ctx context.Context) (*GetNodeActiveTlsCiphersResult, *SdkError)
+
+	// You can use the GetNodeSSLCertificate method to retrieve the SSL certificate that is currently active on the cluster.
+	// You can use this method on both management and storage nodes.
+	GetNodeSSLCertificate(ctx context.Context) (*GetNodeSSLCertificateResult, *SdkError)
+
+	// You can use the GetSupportedTlsCiphers method to get a list of the supported TLS ciphers on this node.
+	// You can use this method on both management and storage nodes.
+	GetNodeSupportedTlsCiphers(ctx context.Context) (*GetNodeSupportedTlsCiphersResult, *SdkError)
+
+	// You can use GetPendingOperation to detect an operation on a node that is currently in progress. You can also use this method to report back when an operation has completed.
+	// Note: method is available only through the per-node API endpoint 5.0 or later.
+	GetPendingOperation(ctx context.Context) (*GetPendingOperationResult, *SdkError)
+
+	// ListDriveHardware returns all the drives connected to a node. Use this method on individual nodes to return drive hardware
+	// information or use this method on the cluster master node MVIP to see information for all the drives on all nodes.
+	// Note: The "securitySupported": true line of the method response does not imply that the drives are capable of
+	// encryption; only that the security status can be queried. If you have a node type with a model number ending in "-NE",
+	// commands to enable security features on these drives will fail. See the EnableEncryptionAtRest method for more information.
+	ListDriveHardware(ctx context.Context, req *ListDriveHardwareRequest) (*ListDriveHardwareResult, *SdkError)
+
+	// ListNetworkInterfaces enables you to retrieve information about each network interface on a node. The API method is intended for use on individual nodes; userid and password authentication is required for access to individual nodes.
+	ListNetworkInterfaces(ctx context.Context) (*ListNetworkInterfacesResult, *SdkError)
+
+	// You can use the ListTests API method to return the tests that are available to run on a node.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	ListTests(ctx context.Context, req *ListTestsRequest) (*ListTestsResult, *SdkError)
+
+	// You can use the ListUtilities API method to return the operations that are available to run on a node.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	ListUtilities(ctx context.Context, req *ListUtilitiesRequest) (*ListUtilitiesResult, *SdkError)
+
+	// You can use the RemoveNodeSSLCertificate method to remove the user SSL certificate and private key for the management node.
+	// After the certificate and private key are removed, the management node is configured to use the default certificate and private key..
+	RemoveNodeSSLCertificate(ctx context.Context) (*RemoveNodeSSLCertificateResult, *SdkError)
+
+	// ResetDrives enables you to proactively initialize drives and remove all data currently residing on a drive. The drive can then be reused
+	// in an existing node or used in an upgraded node. This method requires the force parameter to be included in the method call.
+	ResetDrives(ctx context.Context, req *ResetDrivesRequest) (*ResetDrivesResult, *SdkError)
+
+	// The ResetNode API method enables you to reset a node to the factory settings. All data, packages (software upgrades, and so on),
+	// configurations, and log files are deleted from the node when you call this method. However, network settings for the node are
+	// preserved during this operation. Nodes that are participating in a cluster cannot be reset to the factory settings.
+	// The ResetNode API can only be used on nodes that are in an "Available" state. It cannot be used on nodes that are "Active" in a
+	// cluster, or in a "Pending" state.
+	// Caution: This method clears any data that is on the node. Exercise caution when using this method.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	ResetNode(ctx context.Context, req *ResetNodeRequest) (*ResetNodeResult, *SdkError)
+
+	// The RestartNetworking API method enables you to restart the networking services on a node.
+	// Warning: This method restarts all networking services on a node, causing temporary loss of networking connectivity.
+	// Exercise caution when using this method.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	RestartNetworking(ctx context.Context, req *RestartNetworkingRequest) (*interface{}, *SdkError)
+
+	// The RestartServices API method enables you to restart the services on a node.
+	// Caution: This method causes temporary node services interruption. Exercise caution when using this method.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	RestartServices(ctx context.Context, req *RestartServicesRequest) (*interface{}, *SdkError)
+
+	// The SetClusterConfig API method enables you to set the configuration this node uses to communicate with the cluster it is associated with. To see the states in which these objects can be modified, see Cluster Object Attributes. To display the current cluster
+	// interface settings for a node, run the GetClusterConfig API method.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	SetClusterConfig(ctx context.Context, req *SetClusterConfigRequest) (*SetClusterConfigResult, *SdkError)
+
+	// The SetConfig API method enables you to set all the configuration information for the node. This includes the same information available via calls to SetClusterConfig and SetNetworkConfig in one API method.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	// Caution: Changing the "bond-mode" on a node can cause a temporary loss of network connectivity. Exercise caution when using this method.
+	SetConfig(ctx context.Context, req *SetConfigRequest) (*SetConfigResult, *SdkError)
+
+	// The SetNetworkConfig API method enables you to set the network configuration for a node. To display the current network settings for a node, run the GetNetworkConfig API method.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	// Changing the "bond-mode" on a node can cause a temporary loss of network connectivity. Exercise caution when using this method.
+	SetNetworkConfig(ctx context.Context, req *SetNetworkConfigRequest) (*SetNetworkConfigResult, *SdkError)
+
+	// You can use the SetNodeSSLCertificate method to set a user SSL certificate and private key for the management node.
+	SetNodeSSLCertificate(ctx context.Context, req *SetNodeSSLCertificateRequest) (*SetNodeSSLCertificateResult, *SdkError)
+
+	// The TestConnectEnsemble API method enables you to verify connectivity with a specified database ensemble. By default, it uses the ensemble for the cluster that the node is associated with. Alternatively, you can provide a different ensemble to test connectivity with.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	TestConnectEnsemble(ctx context.Context, req *TestConnectEnsembleRequest) (*TestConnectEnsembleResult, *SdkError)
+
+	// The TestConnectMvip API method enables you to test the
+	// management connection to the cluster. The test pings the MVIP and executes a simple API method to verify connectivity.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	TestConnectMvip(ctx context.Context, req *TestConnectMvipRequest) (*TestConnectMvipResult, *SdkError)
+
+	// The TestConnectSvip API method enables you to test the storage connection to the cluster. The test pings the SVIP using ICMP packets, and when successful, connects as an iSCSI initiator.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	TestConnectSvip(ctx context.Context, req *TestConnectSvipRequest) (*TestConnectSvipResult, *SdkError)
+
+	// You can use the TestDrives API method to run a hardware validation on all drives on the node. This method detects hardware
+	// failures on the drives (if present) and reports them in the results of the validation tests.
+	// You can only use the TestDrives method on nodes that are not "active" in a cluster.
+	// Note: This test takes approximately 10 minutes.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	TestDrives(ctx context.Context, req *TestDrivesRequest) (*TestDrivesResult, *SdkError)
+
+	// You can use the TestPing API method to validate the
+	// connection to all the nodes in a cluster on both 1G and 10G interfaces by using ICMP packets. The test uses the appropriate MTU sizes for each packet based on the MTU settings in the network configuration.
+	// Note: This method is available only through the per-node API endpoint 5.0 or later.
+	TestPing(ctx context.Context, req *TestPingRequest) (*TestPingResult, *SdkError)
+}
+
+var _ NodeApi = (*NodeClient)(nil)
+
+// The CheckPingOnVlan API method provides the ability to test IP address(s) reachability on a not-yet-configured VLAN.
+// This API creates a temporary vlan interface, uses it to ping the provided list of host IP addresses, removes the VLAN interface.
+// If the vlan interface already exits TestPing should be used.
+func (nodeClient *NodeClient) CheckPingOnVlan(ctx context.Context, req *CheckPingOnVlanRequest) (*CheckPingOnVlanResult, *SdkError) {
+	var res CheckPingOnVlanResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "CheckPingOnVlan", 1, req, &res)
+	return &res, err
+}
+
+// CheckProposedCluster validates that creating a cluster from a given set of nodes is likely to succeed.  Any problems with the proposed cluster are returned as errors with a human-readable description and unique error code.
+func (nodeClient *NodeClient) CheckProposedCluster(ctx context.Context, req *CheckProposedClusterRequest) (*CheckProposedResult, *SdkError) {
+	var res CheckProposedResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "CheckProposedCluster", 1, req, &res)
+	return &res, err
+}
+
+// The CreateCluster method enables you to initialize the node in a cluster that has ownership of the "mvip" and "svip" addresses. Each new cluster is initialized using the management IP (MIP) of the first node in the cluster. This method also automatically adds all the nodes being configured into the cluster. You only need to use this method once each time a new cluster is initialized.
+// Note: You need to log in to the node that is used as the master node for the cluster. After you log in, run the GetBootstrapConfig method on the node to get the IP addresses for the rest of the nodes that you want to include in the
+// cluster. Then, run the CreateCluster method.
+func (nodeClient *NodeClient) CreateCluster(ctx context.Context, req *CreateClusterRequest) (*CreateClusterResult, *SdkError) {
+	var res CreateClusterResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "CreateCluster", 1, req, &res)
+	return &res, err
+}
+
+// CreateSupportBundle enables you to create a support bundle file under the node's directory. After creation, the bundle is stored on the node as a tar.gz file.
+func (nodeClient *NodeClient) CreateSupportBundle(ctx context.Context, req *CreateSupportBundleRequest) (*CreateSupportBundleResult, *SdkError) {
+	var res CreateSupportBundleResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "CreateSupportBundle", 1, req, &res)
+	return &res, err
+}
+
+// DeleteAllSupportBundles enables you to delete all support bundles generated with the CreateSupportBundle API method.
+func (nodeClient *NodeClient) DeleteAllSupportBundles(ctx context.Context) (*DeleteAllSupportBundlesResult, *SdkError) {
+	var res DeleteAllSupportBundlesResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "DeleteAllSupportBundles", 1, nil, &res)
+	return &res, err
+}
+
+// GetBootstrapConfig returns cluster and node information from the bootstrap configuration file. Use this API method on an individual node before it has been joined with a cluster. You can use the information this method returns in the cluster configuration interface when you create a cluster.
+// If a cluster has already been created, this can be used to obtain the MVIP and SVIP addresses of the cluster.
+func (nodeClient *NodeClient) GetBootstrapConfig(ctx context.Context) (*GetBootstrapConfigResult, *SdkError) {
+	var res GetBootstrapConfigResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "GetBootstrapConfig", 1, nil, &res)
+	return &res, err
+}
+
+// The GetClusterConfig API method enables you to return information about the cluster configuration this node uses to communicate with the cluster that it is a part of.
+func (nodeClient *NodeClient) GetClusterConfig(ctx context.Context, req *GetClusterConfigRequest) (*GetClusterConfigResult, *SdkError) {
+	var res GetClusterConfigResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "GetClusterConfig", 1, req, &res)
+	return &res, err
+}
+
+// The GetConfig API method enables you to retrieve all configuration information for a node. This method includes the same information available in both the GetClusterConfig and GetNetworkConfig API methods.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) GetConfig(ctx context.Context, req *GetConfigRequest) (*GetConfigResult, *SdkError) {
+	var res GetConfigResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "GetConfig", 1, req, &res)
+	return &res, err
+}
+
+// GetDriveConfig enables you to display drive information for expected slice and block drive counts as well as the number of slices
+// and block drives that are currently connected to the node.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) GetDriveConfig(ctx context.Context, req *GetDriveConfigRequest) (*GetDriveConfigResult, *SdkError) {
+	var res GetDriveConfigResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "GetDriveConfig", 1, req, &res)
+	return &res, err
+}
+
+// GetHardwareConfig enables you to display the hardware configuration information for a node.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) GetHardwareConfig(ctx context.Context, req *GetHardwareConfigRequest) (*GetHardwareConfigResult, *SdkError) {
+	var res GetHardwareConfigResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "GetHardwareConfig", 1, req, &res)
+	return &res, err
+}
+
+// The GetHardwareInfo API method enables you to return hardware information and status for a single node. This generally includes details about manufacturers, vendors, versions, drives, and other associated hardware identification information.
+func (nodeClient *NodeClient) GetHardwareInfo(ctx context.Context) (*GetHardwareInfoResult, *SdkError) {
+	var res GetHardwareInfoResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "GetHardwareInfo", 1, nil, &res)
+	return &res, err
+}
+
+// The GetNetworkConfig API method enables you to display the network configuration information for a node.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) GetNetworkConfig(ctx context.Context, req *GetNetworkConfigRequest) (*GetNetworkConfigResult, *SdkError) {
+	var res GetNetworkConfigResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "GetNetworkConfig", 1, req, &res)
+	return &res, err
+}
+
+// You can use the GetNodeActiveTlsCiphers method to get a list of the TLS ciphers that are currently accepted on this node.
+// You can use this method on both management and storage nodes.
+func (nodeClient *NodeClient) GetNodeActiveTlsCiphers(ctx context.Context) (*GetNodeActiveTlsCiphersResult, *SdkError) {
+	var res GetNodeActiveTlsCiphersResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "GetNodeActiveTlsCiphers", 1, nil, &res)
+	return &res, err
+}
+
+// You can use the GetNodeSSLCertificate method to retrieve the SSL certificate that is currently active on the cluster.
+// You can use this method on both management and storage nodes.
+func (nodeClient *NodeClient) GetNodeSSLCertificate(ctx context.Context) (*GetNodeSSLCertificateResult, *SdkError) {
+	var res GetNodeSSLCertificateResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "GetNodeSSLCertificate", 1, nil, &res)
+	return &res, err
+}
+
+// You can use the GetSupportedTlsCiphers method to get a list of the supported TLS ciphers on this node.
+// You can use this method on both management and storage nodes.
+func (nodeClient *NodeClient) GetNodeSupportedTlsCiphers(ctx context.Context) (*GetNodeSupportedTlsCiphersResult, *SdkError) {
+	var res GetNodeSupportedTlsCiphersResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "GetNodeSupportedTlsCiphers", 1, nil, &res)
+	return &res, err
+}
+
+// You can use GetPendingOperation to detect an operation on a node that is currently in progress. You can also use this method to report back when an operation has completed.
+// Note: method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) GetPendingOperation(ctx context.Context) (*GetPendingOperationResult, *SdkError) {
+	var res GetPendingOperationResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "GetPendingOperation", 1, nil, &res)
+	return &res, err
+}
+
+// ListDriveHardware returns all the drives connected to a node. Use this method on individual nodes to return drive hardware
+// information or use this method on the cluster master node MVIP to see information for all the drives on all nodes.
+// Note: The "securitySupported": true line of the method response does not imply that the drives are capable of
+// encryption; only that the security status can be queried. If you have a node type with a model number ending in "-NE",
+// commands to enable security features on these drives will fail. See the EnableEncryptionAtRest method for more information.
+func (nodeClient *NodeClient) ListDriveHardware(ctx context.Context, req *ListDriveHardwareRequest) (*ListDriveHardwareResult, *SdkError) {
+	var res ListDriveHardwareResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "ListDriveHardware", 1, req, &res)
+	return &res, err
+}
+
+// ListNetworkInterfaces enables you to retrieve information about each network interface on a node. The API method is intended for use on individual nodes; userid and password authentication is required for access to individual nodes.
+func (nodeClient *NodeClient) ListNetworkInterfaces(ctx context.Context) (*ListNetworkInterfacesResult, *SdkError) {
+	var res ListNetworkInterfacesResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "ListNetworkInterfaces", 1, nil, &res)
+	return &res, err
+}
+
+// You can use the ListTests API method to return the tests that are available to run on a node.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) ListTests(ctx context.Context, req *ListTestsRequest) (*ListTestsResult, *SdkError) {
+	var res ListTestsResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "ListTests", 1, req, &res)
+	return &res, err
+}
+
+// You can use the ListUtilities API method to return the operations that are available to run on a node.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) ListUtilities(ctx context.Context, req *ListUtilitiesRequest) (*ListUtilitiesResult, *SdkError) {
+	var res ListUtilitiesResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "ListUtilities", 1, req, &res)
+	return &res, err
+}
+
+// You can use the RemoveNodeSSLCertificate method to remove the user SSL certificate and private key for the management node.
+// After the certificate and private key are removed, the management node is configured to use the default certificate and private key..
+func (nodeClient *NodeClient) RemoveNodeSSLCertificate(ctx context.Context) (*RemoveNodeSSLCertificateResult, *SdkError) {
+	var res RemoveNodeSSLCertificateResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "RemoveNodeSSLCertificate", 1, nil, &res)
+	return &res, err
+}
+
+// ResetDrives enables you to proactively initialize drives and remove all data currently residing on a drive. The drive can then be reused
+// in an existing node or used in an upgraded node. This method requires the force parameter to be included in the method call.
+func (nodeClient *NodeClient) ResetDrives(ctx context.Context, req *ResetDrivesRequest) (*ResetDrivesResult, *SdkError) {
+	var res ResetDrivesResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "ResetDrives", 1, req, &res)
+	return &res, err
+}
+
+// The ResetNode API method enables you to reset a node to the factory settings. All data, packages (software upgrades, and so on),
+// configurations, and log files are deleted from the node when you call this method. However, network settings for the node are
+// preserved during this operation. Nodes that are participating in a cluster cannot be reset to the factory settings.
+// The ResetNode API can only be used on nodes that are in an "Available" state. It cannot be used on nodes that are "Active" in a
+// cluster, or in a "Pending" state.
+// Caution: This method clears any data that is on the node. Exercise caution when using this method.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) ResetNode(ctx context.Context, req *ResetNodeRequest) (*ResetNodeResult, *SdkError) {
+	var res ResetNodeResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "ResetNode", 1, req, &res)
+	return &res, err
+}
+
+// The RestartNetworking API method enables you to restart the networking services on a node.
+// Warning: This method restarts all networking services on a node, causing temporary loss of networking connectivity.
+// Exercise caution when using this method.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) RestartNetworking(ctx context.Context, req *RestartNetworkingRequest) (*interface{}, *SdkError) {
+	var res interface{}
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "RestartNetworking", 1, req, &res)
+	return &res, err
+}
+
+// The RestartServices API method enables you to restart the services on a node.
+// Caution: This method causes temporary node services interruption. Exercise caution when using this method.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) RestartServices(ctx context.Context, req *RestartServicesRequest) (*interface{}, *SdkError) {
+	var res interface{}
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "RestartServices", 1, req, &res)
+	return &res, err
+}
+
+// The SetClusterConfig API method enables you to set the configuration this node uses to communicate with the cluster it is associated with. To see the states in which these objects can be modified, see Cluster Object Attributes. To display the current cluster
+// interface settings for a node, run the GetClusterConfig API method.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) SetClusterConfig(ctx context.Context, req *SetClusterConfigRequest) (*SetClusterConfigResult, *SdkError) {
+	var res SetClusterConfigResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "SetClusterConfig", 1, req, &res)
+	return &res, err
+}
+
+// The SetConfig API method enables you to set all the configuration information for the node. This includes the same information available via calls to SetClusterConfig and SetNetworkConfig in one API method.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+// Caution: Changing the "bond-mode" on a node can cause a temporary loss of network connectivity. Exercise caution when using this method.
+func (nodeClient *NodeClient) SetConfig(ctx context.Context, req *SetConfigRequest) (*SetConfigResult, *SdkError) {
+	var res SetConfigResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "SetConfig", 1, req, &res)
+	return &res, err
+}
+
+// The SetNetworkConfig API method enables you to set the network configuration for a node. To display the current network settings for a node, run the GetNetworkConfig API method.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+// Changing the "bond-mode" on a node can cause a temporary loss of network connectivity. Exercise caution when using this method.
+func (nodeClient *NodeClient) SetNetworkConfig(ctx context.Context, req *SetNetworkConfigRequest) (*SetNetworkConfigResult, *SdkError) {
+	var res SetNetworkConfigResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "SetNetworkConfig", 1, req, &res)
+	return &res, err
+}
+
+// You can use the SetNodeSSLCertificate method to set a user SSL certificate and private key for the management node.
+func (nodeClient *NodeClient) SetNodeSSLCertificate(ctx context.Context, req *SetNodeSSLCertificateRequest) (*SetNodeSSLCertificateResult, *SdkError) {
+	var res SetNodeSSLCertificateResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "SetNodeSSLCertificate", 1, req, &res)
+	return &res, err
+}
+
+// The TestConnectEnsemble API method enables you to verify connectivity with a specified database ensemble. By default, it uses the ensemble for the cluster that the node is associated with. Alternatively, you can provide a different ensemble to test connectivity with.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) TestConnectEnsemble(ctx context.Context, req *TestConnectEnsembleRequest) (*TestConnectEnsembleResult, *SdkError) {
+	var res TestConnectEnsembleResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "TestConnectEnsemble", 1, req, &res)
+	return &res, err
+}
+
+// The TestConnectMvip API method enables you to test the
+// management connection to the cluster. The test pings the MVIP and executes a simple API method to verify connectivity.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) TestConnectMvip(ctx context.Context, req *TestConnectMvipRequest) (*TestConnectMvipResult, *SdkError) {
+	var res TestConnectMvipResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "TestConnectMvip", 1, req, &res)
+	return &res, err
+}
+
+// The TestConnectSvip API method enables you to test the storage connection to the cluster. The test pings the SVIP using ICMP packets, and when successful, connects as an iSCSI initiator.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) TestConnectSvip(ctx context.Context, req *TestConnectSvipRequest) (*TestConnectSvipResult, *SdkError) {
+	var res TestConnectSvipResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "TestConnectSvip", 1, req, &res)
+	return &res, err
+}
+
+// You can use the TestDrives API method to run a hardware validation on all drives on the node. This method detects hardware
+// failures on the drives (if present) and reports them in the results of the validation tests.
+// You can only use the TestDrives method on nodes that are not "active" in a cluster.
+// Note: This test takes approximately 10 minutes.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) TestDrives(ctx context.Context, req *TestDrivesRequest) (*TestDrivesResult, *SdkError) {
+	var res TestDrivesResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "TestDrives", 1, req, &res)
+	return &res, err
+}
+
+// You can use the TestPing API method to validate the
+// connection to all the nodes in a cluster on both 1G and 10G interfaces by using ICMP packets. The test uses the appropriate MTU sizes for each packet based on the MTU settings in the network configuration.
+// Note: This method is available only through the per-node API endpoint 5.0 or later.
+func (nodeClient *NodeClient) TestPing(ctx context.Context, req *TestPingRequest) (*TestPingResult, *SdkError) {
+	var res TestPingResult
+	_, err := nodeClient.sfClient.MakeSFCall(ctx, "TestPing", 1, req, &res)
+	return &res, err
+}
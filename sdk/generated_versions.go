@@ -0,0 +1,92 @@
+// Code generated by sdkgen from api/element.json. DO NOT EDIT.
+
+package sdk
+
+// methodSince maps methods to the first API version that has them.
+var methodSince = map[string]string{
+	"AbortSnapMirrorRelationship":              "10.0",
+	"AddIdpClusterAdmin":                       "12.0",
+	"BreakSnapMirrorRelationship":              "10.0",
+	"BreakSnapMirrorVolume":                    "10.0",
+	"CreateAuthSession":                        "12.0",
+	"CreateIdpAuthSession":                     "12.0",
+	"CreateIdpConfiguration":                   "12.0",
+	"CreateSnapMirrorEndpoint":                 "10.0",
+	"CreateSnapMirrorEndpointUnmanaged":        "10.0",
+	"CreateSnapMirrorRelationship":             "10.0",
+	"CreateSnapMirrorVolume":                   "10.0",
+	"DeleteAuthSession":                        "12.0",
+	"DeleteAuthSessionsByClusterAdmin":         "12.0",
+	"DeleteAuthSessionsByUsername":             "12.0",
+	"DeleteIdpConfiguration":                   "12.0",
+	"DeleteSnapMirrorEndpoints":                "10.0",
+	"DeleteSnapMirrorObjectAttributes":         "10.0",
+	"DeleteSnapMirrorRelationships":            "10.0",
+	"DeleteSnapshotSnapMirrorObjectAttributes": "10.0",
+	"DeleteVolumeSnapMirrorObjectAttributes":   "10.0",
+	"DisableIdpAuthentication":                 "12.0",
+	"DisableProtectionSchemes":                 "12.5",
+	"EnableIdpAuthentication":                  "12.0",
+	"EnableProtectionSchemes":                  "12.5",
+	"GetAuthConfiguration":                     "12.0",
+	"GetCurrentClusterAdmin":                   "12.0",
+	"GetIdpAuthenticationState":                "12.0",
+	"GetProtectionDomainLayout":                "12.0",
+	"GetProtectionSchemes":                     "12.5",
+	"GetSnapMirrorClusterIdentity":             "10.0",
+	"InitializeSnapMirrorRelationship":         "10.0",
+	"ListActiveAuthSessions":                   "12.0",
+	"ListAuthSessionsByClusterAdmin":           "12.0",
+	"ListAuthSessionsByUsername":               "12.0",
+	"ListIdpConfigurations":                    "12.0",
+	"ListProtectionDomainLevels":               "11.0",
+	"ListSnapMirrorAggregates":                 "10.0",
+	"ListSnapMirrorEndpoints":                  "10.0",
+	"ListSnapMirrorLuns":                       "10.0",
+	"ListSnapMirrorNetworkInterfaces":          "10.0",
+	"ListSnapMirrorNodes":                      "10.0",
+	"ListSnapMirrorObjectAttributes":           "10.0",
+	"ListSnapMirrorPolicies":                   "10.0",
+	"ListSnapMirrorRelationships":              "10.0",
+	"ListSnapMirrorSchedules":                  "10.0",
+	"ListSnapMirrorVolumes":                    "10.0",
+	"ListSnapMirrorVservers":                   "10.0",
+	"ModifySnapMirrorEndpoint":                 "10.0",
+	"ModifySnapMirrorEndpointUnmanaged":        "10.0",
+	"ModifySnapMirrorRelationship":             "10.0",
+	"QuiesceSnapMirrorRelationship":            "10.0",
+	"ResumeSnapMirrorRelationship":             "10.0",
+	"ResyncSnapMirrorRelationship":             "10.0",
+	"SetDefaultProtectionScheme":               "12.5",
+	"SetProtectionDomainLayout":                "12.0",
+	"SetProtectionDomainLayoutChassisOverride": "12.0",
+	"UpdateAuthSession":                        "12.0",
+	"UpdateIdpConfiguration":                   "12.0",
+	"UpdateSnapMirrorRelationship":             "10.0",
+}
+
+// requestSince maps methods to the request members that need a newer API version.
+var requestSince = map[string][]versionedMember{
+	"AddAccount": {
+		{Field: "ChapAlgorithm", JSON: "chapAlgorithm", Since: "12.7"},
+	},
+	"CreateInitiators": {
+		{Field: "Initiators.ChapAlgorithm", JSON: "initiators.chapAlgorithm", Since: "12.7"},
+	},
+	"CreateMultipleVolumes": {
+		{Field: "ProtectionScheme", JSON: "protectionScheme", Since: "12.5"},
+	},
+	"CreateVolume": {
+		{Field: "EnableSnapMirrorReplication", JSON: "enableSnapMirrorReplication", Since: "10.0"},
+		{Field: "ProtectionScheme", JSON: "protectionScheme", Since: "12.5"},
+	},
+	"ListVolumes": {
+		{Field: "ProtectionSchemes", JSON: "protectionSchemes", Since: "12.5"},
+	},
+	"ModifyAccount": {
+		{Field: "ChapAlgorithm", JSON: "chapAlgorithm", Since: "12.7"},
+	},
+	"ModifyInitiators": {
+		{Field: "Initiators.ChapAlgorithm", JSON: "initiators.chapAlgorithm", Since: "12.7"},
+	},
+}
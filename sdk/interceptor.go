@@ -0,0 +1,133 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrBlocked matches errors returned for calls that an interceptor refused to send.
+var ErrBlocked = errors.New("sdk: call blocked")
+
+// Invoker sends an API call and decodes its result into res, which is the pointer the
+// generated method passed to MakeSFCall.
+type Invoker func(ctx context.Context, method string, params, res interface{}) (BaseResponse, *SdkError)
+
+// Interceptor wraps every API call, in the style of a gRPC unary client interceptor.
+// It sees the method and params before the call and the decoded res and error after
+// invoker returns. It may change the method, params or context it passes on, return
+// without calling invoker to short-circuit the call (filling res itself if it has an
+// answer), or replace the result and error. Retries happen inside invoker, so an
+// interceptor sees each logical call once.
+type Interceptor func(ctx context.Context, method string, params, res interface{}, invoker Invoker) (BaseResponse, *SdkError)
+
+// WithInterceptors adds interceptors to the client. The first one is the outermost.
+func WithInterceptors(interceptors ...Interceptor) ClientOption {
+	return func(sfClient *SFClient) error {
+		for _, ic := range interceptors {
+			if ic == nil {
+				return errors.New("nil Interceptor")
+			}
+		}
+		sfClient.interceptors = append(sfClient.interceptors, interceptors...)
+		return nil
+	}
+}
+
+// Use appends interceptors to an existing client. It must not be called concurrently
+// with API calls.
+func (sfClient *SFClient) Use(interceptors ...Interceptor) {
+	for _, ic := range interceptors {
+		if ic != nil {
+			sfClient.interceptors = append(sfClient.interceptors, ic)
+		}
+	}
+}
+
+// invoke runs the call through the interceptor chain.
+func (sfClient *SFClient) invoke(ctx context.Context, id int32, method string, params, res interface{}) (BaseResponse, *SdkError) {
+	if err := sfClient.checkVersion(method, params); err != nil {
+		return BaseResponse{}, err
+	}
+	invoker := func(ctx context.Context, method string, params, res interface{}) (BaseResponse, *SdkError) {
+		out := sfClient.callWithRetry(ctx, BaseRequest{Id: id, Method: method, Parameters: params}, res)
+		return out.response, out.err
+	}
+	for i := len(sfClient.interceptors) - 1; i >= 0; i-- {
+		ic, next := sfClient.interceptors[i], invoker
+		invoker = func(ctx context.Context, method string, params, res interface{}) (BaseResponse, *SdkError) {
+			return ic(ctx, method, params, res, next)
+		}
+	}
+	return invoker(ctx, method, params, res)
+}
+
+// BlockedError returns the error an interceptor should return for a call it refuses.
+// It matches ErrBlocked with errors.Is.
+func BlockedError(method, reason string) *SdkError {
+	return &SdkError{
+		Code:   "sdk.blocked",
+		Detail: fmt.Sprintf("%s blocked: %s", method, reason),
+		Method: method,
+		Err:    ErrBlocked,
+	}
+}
+
+// sessionMethods manage the client's own auth sessions and are let through by DryRun.
+var sessionMethods = map[string]bool{
+	"CreateAuthSession":    true,
+	"CreateIdpAuthSession": true,
+	"UpdateAuthSession":    true,
+	"DeleteAuthSession":    true,
+}
+
+// IsReadOnlyMethod reports whether method only reads cluster state, that is whether it
+// starts with "Get" or "List".
+func IsReadOnlyMethod(method string) bool {
+	return strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "List")
+}
+
+// AllowMethods blocks every method that is not listed. Connect calls GetAPI, and
+// session authenticators call the auth session methods, so list those if they are used.
+func AllowMethods(methods ...string) Interceptor {
+	allowed := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		allowed[m] = true
+	}
+	return func(ctx context.Context, method string, params, res interface{}, invoker Invoker) (BaseResponse, *SdkError) {
+		if !allowed[method] {
+			return BaseResponse{}, BlockedError(method, "not in the allow-list")
+		}
+		return invoker(ctx, method, params, res)
+	}
+}
+
+// DenyMethods blocks the listed methods.
+func DenyMethods(methods ...string) Interceptor {
+	denied := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		denied[m] = true
+	}
+	return func(ctx context.Context, method string, params, res interface{}, invoker Invoker) (BaseResponse, *SdkError) {
+		if denied[method] {
+			return BaseResponse{}, BlockedError(method, "denied")
+		}
+		return invoker(ctx, method, params, res)
+	}
+}
+
+// DryRun blocks every call that could change the cluster, letting read-only methods
+// (see IsReadOnlyMethod) and auth session management through. Each blocked call is
+// passed to report, if not nil, so a dry run can print what it would have done.
+func DryRun(report func(method string, params interface{})) Interceptor {
+	return func(ctx context.Context, method string, params, res interface{}, invoker Invoker) (BaseResponse, *SdkError) {
+		if IsReadOnlyMethod(method) || sessionMethods[method] {
+			return invoker(ctx, method, params, res)
+		}
+		if report != nil {
+			report(method, params)
+		}
+		return BaseResponse{}, BlockedError(method, "dry run")
+	}
+}
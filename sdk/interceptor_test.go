@@ -0,0 +1,129 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+func TestInterceptorChain(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+
+	var order []string
+	trace := func(name string) sdk.Interceptor {
+		return func(ctx context.Context, method string, params, res interface{}, invoker sdk.Invoker) (sdk.BaseResponse, *sdk.SdkError) {
+			order = append(order, name+">"+method)
+			resp, err := invoker(ctx, method, params, res)
+			order = append(order, name+"<"+method)
+			return resp, err
+		}
+	}
+	var accounts int
+	observe := func(ctx context.Context, method string, params, res interface{}, invoker sdk.Invoker) (sdk.BaseResponse, *sdk.SdkError) {
+		resp, err := invoker(ctx, method, params, res)
+		if r, ok := res.(*sdk.ListAccountsResult); ok && err == nil {
+			accounts = len(r.Accounts)
+		}
+		return resp, err
+	}
+	sf, err := srv.NewClient(sdk.WithInterceptors(trace("outer"), trace("inner"), observe))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := sf.AddAccount(ctx, &sdk.AddAccountRequest{Username: "tenant1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sf.ListAccounts(ctx, &sdk.ListAccountsRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"outer>GetAPI", "inner>GetAPI", "inner<GetAPI", "outer<GetAPI",
+		"outer>AddAccount", "inner>AddAccount", "inner<AddAccount", "outer<AddAccount",
+		"outer>ListAccounts", "inner>ListAccounts", "inner<ListAccounts", "outer<ListAccounts",
+	}
+	if !reflect.DeepEqual(order, want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	if accounts != 1 {
+		t.Fatalf("interceptor saw %d accounts, want 1", accounts)
+	}
+}
+
+func TestInterceptorModifiesAndShortCircuits(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+
+	prefix := func(ctx context.Context, method string, params, res interface{}, invoker sdk.Invoker) (sdk.BaseResponse, *sdk.SdkError) {
+		if req, ok := params.(*sdk.AddAccountRequest); ok {
+			copied := *req
+			copied.Username = "prod-" + req.Username
+			params = &copied
+		}
+		return invoker(ctx, method, params, res)
+	}
+	cached := func(ctx context.Context, method string, params, res interface{}, invoker sdk.Invoker) (sdk.BaseResponse, *sdk.SdkError) {
+		if r, ok := res.(*sdk.GetClusterInfoResult); ok {
+			r.ClusterInfo.Name = "cached"
+			return sdk.BaseResponse{}, nil
+		}
+		return invoker(ctx, method, params, res)
+	}
+	sf, err := srv.NewClient(sdk.WithInterceptors(prefix, cached))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if _, err := sf.AddAccount(ctx, &sdk.AddAccountRequest{Username: "db"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sf.GetAccountByName(ctx, &sdk.GetAccountByNameRequest{Username: "prod-db"}); err != nil {
+		t.Fatalf("modified params not sent: %v", err)
+	}
+	info, sdkErr := sf.GetClusterInfo(ctx)
+	if sdkErr != nil || info.ClusterInfo.Name != "cached" {
+		t.Fatalf("GetClusterInfo = %+v, %v", info, sdkErr)
+	}
+	if n := srv.CallCount("GetClusterInfo"); n != 0 {
+		t.Fatalf("short-circuited call reached the cluster %d times", n)
+	}
+}
+
+func TestDryRunAndAllowList(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+
+	var blocked []string
+	sf, err := srv.NewClient(sdk.WithInterceptors(sdk.DryRun(func(method string, params interface{}) {
+		blocked = append(blocked, method)
+	})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	_, sdkErr := sf.AddAccount(ctx, &sdk.AddAccountRequest{Username: "tenant1"})
+	if !errors.Is(sdkErr, sdk.ErrBlocked) {
+		t.Fatalf("AddAccount error = %v, want ErrBlocked", sdkErr)
+	}
+	if _, err := sf.ListAccounts(ctx, &sdk.ListAccountsRequest{}); err != nil {
+		t.Fatalf("ListAccounts: %v", err)
+	}
+	if srv.CallCount("AddAccount") != 0 || !reflect.DeepEqual(blocked, []string{"AddAccount"}) {
+		t.Fatalf("dry run sent or misreported calls: %v", blocked)
+	}
+
+	sf.Use(sdk.AllowMethods("GetAPI", "ListVolumes"))
+	if _, err := sf.ListAccounts(ctx, &sdk.ListAccountsRequest{}); !errors.Is(err, sdk.ErrBlocked) {
+		t.Fatalf("ListAccounts error = %v, want ErrBlocked", err)
+	}
+	if _, err := sf.ListVolumes(ctx, &sdk.ListVolumesRequest{}); err != nil {
+		t.Fatalf("ListVolumes: %v", err)
+	}
+}
@@ -0,0 +1,457 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrUnknownAsyncHandle is the error of a tracked job whose handle the cluster no
+// longer knows, for example because another client collected its result.
+var ErrUnknownAsyncHandle = errors.New("sdk: unknown async handle")
+
+// CloneAsyncResult is the result of a Clone job: CloneVolume, CloneMultipleVolumes
+// or CopyVolume. CopyVolume reports the source as VolumeID and the destination as
+// DstVolumeID.
+type CloneAsyncResult struct {
+	CloneID      int64                    `json:"cloneID"`
+	GroupCloneID int64                    `json:"groupCloneID"`
+	VolumeID     int64                    `json:"volumeID"`
+	DstVolumeID  int64                    `json:"dstVolumeID"`
+	Members      []GroupCloneVolumeMember `json:"members"`
+	Message      string                   `json:"message"`
+}
+
+// BulkVolumeAsyncResult is the result of a BulkVolume job started by
+// StartBulkVolumeRead or StartBulkVolumeWrite.
+type BulkVolumeAsyncResult struct {
+	BulkVolumeID int64  `json:"bulkVolumeID"`
+	VolumeID     int64  `json:"volumeID"`
+	Key          string `json:"key"`
+	Message      string `json:"message"`
+}
+
+// AsyncMessageResult is the result of jobs that only report a message, such as
+// DriveAdd and DriveRemoval.
+type AsyncMessageResult struct {
+	Message string `json:"message"`
+}
+
+// SecureEraseAsyncResult is the result of a SecureErase job started by
+// SecureEraseDrives.
+type SecureEraseAsyncResult struct {
+	Drives  []int64 `json:"drives"`
+	Message string  `json:"message"`
+}
+
+// RecoverDeadVolumesAsyncResult is the result of a RecoverDeadVolumes job. It lists
+// the volumes and slices whose recovery was started; the slices report has their
+// progress.
+type RecoverDeadVolumesAsyncResult struct {
+	VolumeIDs []int64 `json:"volumeIDs"`
+	SliceIDs  []int64 `json:"sliceIDs"`
+	Message   string  `json:"message"`
+}
+
+// DefaultAsyncResultTypes maps the resultType of an async handle to the type its
+// result is decoded into. Types not listed here, or in JobTracker.ResultTypes, are
+// returned as json.RawMessage.
+var DefaultAsyncResultTypes = map[string]func() interface{}{
+	"Clone":              func() interface{} { return new(CloneAsyncResult) },
+	"BulkVolume":         func() interface{} { return new(BulkVolumeAsyncResult) },
+	"DriveAdd":           func() interface{} { return new(AsyncMessageResult) },
+	"DriveRemoval":       func() interface{} { return new(AsyncMessageResult) },
+	"RtfiPendingNode":    func() interface{} { return new(AsyncMessageResult) },
+	"SecureErase":        func() interface{} { return new(SecureEraseAsyncResult) },
+	"SupportBundle":      func() interface{} { return new(CreateSupportBundleResult) },
+	"RecoverDeadVolumes": func() interface{} { return new(RecoverDeadVolumesAsyncResult) },
+}
+
+// AsyncJob is the state of an async handle followed by a JobTracker.
+type AsyncJob struct {
+	Handle int64
+	// Label is the caller's name for the job, as passed to Track.
+	Label           string
+	ResultType      string
+	Completed       bool
+	PercentComplete int64
+	// Result is the decoded result of a successful job, see DefaultAsyncResultTypes.
+	Result interface{}
+	// Err is an *AsyncJobError for a failed job, or wraps ErrUnknownAsyncHandle.
+	Err error
+}
+
+// PendingHandle is an async handle a JobTracker is waiting for, as saved in a HandleStore.
+type PendingHandle struct {
+	Handle int64  `json:"asyncHandle"`
+	Label  string `json:"label,omitempty"`
+}
+
+// HandleStore persists the handles a JobTracker is waiting for, so a restarted
+// process can resume waiting for them.
+type HandleStore interface {
+	Load(ctx context.Context) ([]PendingHandle, error)
+	Save(ctx context.Context, handles []PendingHandle) error
+}
+
+// FileHandleStore stores pending handles as JSON in Path, written atomically like
+// FileCheckpoint.
+type FileHandleStore struct {
+	Path string
+}
+
+func (f FileHandleStore) Load(ctx context.Context) ([]PendingHandle, error) {
+	data, err := os.ReadFile(f.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading handles: %w", err)
+	}
+	var handles []PendingHandle
+	if err := json.Unmarshal(data, &handles); err != nil {
+		return nil, fmt.Errorf("parsing handles %s: %w", f.Path, err)
+	}
+	return handles, nil
+}
+
+func (f FileHandleStore) Save(ctx context.Context, handles []PendingHandle) error {
+	if handles == nil {
+		handles = []PendingHandle{}
+	}
+	data, _ := json.Marshal(handles)
+	if err := writeFileAtomic(f.Path, append(data, '\n')); err != nil {
+		return fmt.Errorf("writing handles: %w", err)
+	}
+	return nil
+}
+
+// JobTracker waits for many async handles with one ListAsyncResults call per poll,
+// instead of a GetAsyncResult call per handle. Run does the polling; Track adds
+// handles and Wait blocks until one completes.
+//
+// Completed results are left on the cluster, so other clients can still read them.
+// The tracker forgets a completed job after Retention.
+type JobTracker struct {
+	// Store, if set, keeps the pending handles across restarts. Run resumes the handles
+	// it finds there.
+	Store HandleStore
+	// PollInterval is the wait between ListAsyncResults calls. Default 2 seconds.
+	PollInterval time.Duration
+	// ResultTypes adds or replaces entries of DefaultAsyncResultTypes.
+	ResultTypes map[string]func() interface{}
+	// Retention is how long a completed job can still be read with Wait. Poll
+	// removes older ones. Default 10 minutes.
+	Retention time.Duration
+	// OnProgress is called from Run when the progress of a running job changes, and
+	// OnDone once when a job completes, successfully or not.
+	OnProgress func(AsyncJob)
+	OnDone     func(AsyncJob)
+
+	sfClient *SFClient
+	mu       sync.Mutex
+	jobs     map[int64]*trackedJob
+	resumed  bool
+}
+
+type trackedJob struct {
+	job      AsyncJob
+	done     chan struct{}
+	finished time.Time
+}
+
+// NewJobTracker returns a tracker for sfClient's async handles. A nil store keeps
+// the handles in memory only.
+func NewJobTracker(sfClient *SFClient, store HandleStore) *JobTracker {
+	return &JobTracker{sfClient: sfClient, Store: store, jobs: map[int64]*trackedJob{}}
+}
+
+// Track starts following handle. Tracking a handle twice is not an error.
+func (t *JobTracker) Track(ctx context.Context, handle int64, label string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.jobs[handle]; ok {
+		return nil
+	}
+	t.jobs[handle] = &trackedJob{job: AsyncJob{Handle: handle, Label: label}, done: make(chan struct{})}
+	return t.saveLocked(ctx)
+}
+
+// Wait blocks until the job of a tracked handle completes and returns it with its
+// Err. Run must be running for the job to complete. A job completed longer than
+// Retention ago is no longer tracked.
+func (t *JobTracker) Wait(ctx context.Context, handle int64) (AsyncJob, error) {
+	t.mu.Lock()
+	tj, ok := t.jobs[handle]
+	t.mu.Unlock()
+	if !ok {
+		return AsyncJob{Handle: handle}, fmt.Errorf("async handle %d is not tracked", handle)
+	}
+	select {
+	case <-tj.done:
+		return tj.job, tj.job.Err
+	case <-ctx.Done():
+		return AsyncJob{Handle: handle}, ctx.Err()
+	}
+}
+
+// Pending returns the tracked jobs that have not completed, ordered by handle.
+func (t *JobTracker) Pending() []AsyncJob {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var out []AsyncJob
+	for _, tj := range t.jobs {
+		if !tj.job.Completed {
+			out = append(out, tj.job)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Handle < out[j].Handle })
+	return out
+}
+
+// Resume tracks the handles saved in Store and returns their jobs. Run calls it;
+// call it first to Wait for resumed handles before Run has started. Only the first
+// call loads the store.
+func (t *JobTracker) Resume(ctx context.Context) ([]AsyncJob, error) {
+	t.mu.Lock()
+	resumed := t.resumed
+	t.resumed = true
+	t.mu.Unlock()
+	if resumed || t.Store == nil {
+		return nil, nil
+	}
+	handles, err := t.Store.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var jobs []AsyncJob
+	for _, h := range handles {
+		if err := t.Track(ctx, h.Handle, h.Label); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, AsyncJob{Handle: h.Handle, Label: h.Label})
+	}
+	return jobs, nil
+}
+
+// Run resumes the handles in Store and polls until ctx is done or the store fails.
+// Failed ListAsyncResults calls are logged and retried after PollInterval.
+func (t *JobTracker) Run(ctx context.Context) error {
+	if _, err := t.Resume(ctx); err != nil {
+		return err
+	}
+	poll := durationOr(t.PollInterval, 2*time.Second)
+	for {
+		if err := t.Poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			var sdkErr *SdkError
+			if !errors.As(err, &sdkErr) {
+				return err
+			}
+			log.WithContext(ctx).Warnf("ListAsyncResults failed, retrying in %s: %v", poll, err)
+		}
+		if err := sleepCtx(ctx, poll); err != nil {
+			return err
+		}
+	}
+}
+
+// Poll updates every pending job with one ListAsyncResults call. Handles missing
+// from the list are checked with GetAsyncResult. It also forgets jobs completed
+// longer than Retention ago. Run calls Poll; it is exported for callers with their
+// own loop.
+func (t *JobTracker) Poll(ctx context.Context) error {
+	t.evict()
+	if len(t.Pending()) == 0 {
+		return nil
+	}
+	res, sdkErr := t.sfClient.ListAsyncResults(ctx, &ListAsyncResultsRequest{})
+	if sdkErr != nil {
+		return sdkErr
+	}
+	listed := map[int64]AsyncHandle{}
+	for _, h := range res.AsyncHandles {
+		listed[h.AsyncResultID] = h
+	}
+
+	var progressed, finished []AsyncJob
+	for _, pending := range t.Pending() {
+		h, ok := listed[pending.Handle]
+		if !ok {
+			h, sdkErr = t.getAsyncHandle(ctx, pending.Handle)
+			if sdkErr != nil && !IsNotFound(sdkErr) {
+				return sdkErr
+			}
+			if sdkErr != nil {
+				finished = append(finished, t.complete(pending.Handle, func(job *AsyncJob) {
+					job.Err = fmt.Errorf("%w %d: %v", ErrUnknownAsyncHandle, pending.Handle, sdkErr)
+				}))
+				continue
+			}
+		}
+		job, changed := t.update(h)
+		if job.Completed {
+			finished = append(finished, job)
+		} else if changed {
+			progressed = append(progressed, job)
+		}
+	}
+	if len(finished) > 0 {
+		t.mu.Lock()
+		err := t.saveLocked(ctx)
+		t.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	for _, job := range progressed {
+		if t.OnProgress != nil {
+			t.OnProgress(job)
+		}
+	}
+	for _, job := range finished {
+		if t.OnDone != nil {
+			t.OnDone(job)
+		}
+	}
+	return nil
+}
+
+// getAsyncHandle reads one handle with GetAsyncResult, keeping the result on the
+// cluster, in the shape of a ListAsyncResults entry.
+func (t *JobTracker) getAsyncHandle(ctx context.Context, handle int64) (AsyncHandle, *SdkError) {
+	res, sdkErr := t.sfClient.GetAsyncResult(ctx, &GetAsyncResultRequest{AsyncHandle: handle, KeepResult: true})
+	if sdkErr != nil {
+		return AsyncHandle{}, sdkErr
+	}
+	completed := res.Status == "complete"
+	return AsyncHandle{
+		AsyncResultID:  handle,
+		Completed:      completed,
+		CreateTime:     res.CreateTime,
+		LastUpdateTime: res.LastUpdateTime,
+		ResultType:     res.ResultType,
+		Success:        completed && res.Error == nil,
+		Data:           res,
+	}, nil
+}
+
+// asyncData is the data of a ListAsyncResults entry. Depending on the job it is
+// the result itself, or a GetAsyncResult-style object with result, error and details.
+type asyncData struct {
+	Status  string            `json:"status"`
+	Result  json.RawMessage   `json:"result"`
+	Error   *AsyncResultError `json:"error"`
+	Details struct {
+		PercentComplete *int64 `json:"percentComplete"`
+	} `json:"details"`
+	PercentComplete *int64 `json:"percentComplete"`
+	Message         string `json:"message"`
+}
+
+// update applies a ListAsyncResults entry to its job and reports whether a running
+// job's progress changed.
+func (t *JobTracker) update(h AsyncHandle) (AsyncJob, bool) {
+	raw, _ := json.Marshal(h.Data)
+	var data asyncData
+	_ = json.Unmarshal(raw, &data)
+	result := json.RawMessage(raw)
+	if data.Status != "" || data.Result != nil {
+		result = data.Result
+	}
+
+	if h.Completed {
+		return t.complete(h.AsyncResultID, func(job *AsyncJob) {
+			job.ResultType = h.ResultType
+			job.PercentComplete = 100
+			switch {
+			case data.Error != nil:
+				job.Err = &AsyncJobError{AsyncHandle: h.AsyncResultID, Name: data.Error.Name, Message: data.Error.Message}
+			case !h.Success:
+				job.Err = &AsyncJobError{AsyncHandle: h.AsyncResultID, Name: "xAsyncJobFailed", Message: data.Message}
+			default:
+				job.Result, job.Err = t.decode(h, result)
+			}
+		}), false
+	}
+
+	percent := data.PercentComplete
+	if percent == nil {
+		percent = data.Details.PercentComplete
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tj := t.jobs[h.AsyncResultID]
+	tj.job.ResultType = h.ResultType
+	if percent == nil || *percent == tj.job.PercentComplete {
+		return tj.job, false
+	}
+	tj.job.PercentComplete = *percent
+	return tj.job, true
+}
+
+// complete marks a job completed once, wakes its waiters and returns it.
+func (t *JobTracker) complete(handle int64, set func(*AsyncJob)) AsyncJob {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tj := t.jobs[handle]
+	if tj.job.Completed {
+		return tj.job
+	}
+	set(&tj.job)
+	tj.job.Completed = true
+	tj.finished = time.Now()
+	close(tj.done)
+	return tj.job
+}
+
+// evict removes the jobs completed longer than Retention ago.
+func (t *JobTracker) evict() {
+	cutoff := time.Now().Add(-durationOr(t.Retention, 10*time.Minute))
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for handle, tj := range t.jobs {
+		if tj.job.Completed && tj.finished.Before(cutoff) {
+			delete(t.jobs, handle)
+		}
+	}
+}
+
+func (t *JobTracker) decode(h AsyncHandle, result json.RawMessage) (interface{}, error) {
+	newResult := t.ResultTypes[h.ResultType]
+	if newResult == nil {
+		newResult = DefaultAsyncResultTypes[h.ResultType]
+	}
+	if newResult == nil || len(result) == 0 {
+		return result, nil
+	}
+	v := newResult()
+	if err := json.Unmarshal(result, v); err != nil {
+		return result, fmt.Errorf("decoding %s result of async handle %d: %w", h.ResultType, h.AsyncResultID, err)
+	}
+	return v, nil
+}
+
+// saveLocked writes the pending handles to Store, if there is one.
+func (t *JobTracker) saveLocked(ctx context.Context) error {
+	if t.Store == nil {
+		return nil
+	}
+	var handles []PendingHandle
+	for _, tj := range t.jobs {
+		if !tj.job.Completed {
+			handles = append(handles, PendingHandle{Handle: tj.job.Handle, Label: tj.job.Label})
+		}
+	}
+	sort.Slice(handles, func(i, j int) bool { return handles[i].Handle < handles[j].Handle })
+	return t.Store.Save(ctx, handles)
+}
@@ -0,0 +1,254 @@
+package sdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func TestJobTrackerPollsManyHandlesAtOnce(t *testing.T) {
+	srv, sf, _, advance := newCloneServer(t)
+	_, vols := createVolumes(t, sf, "a", "b")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tr := sdk.NewJobTracker(sf, nil)
+	tr.PollInterval = 5 * time.Millisecond
+	var handles []int64
+	for i, src := range vols {
+		res, err := sf.CloneVolume(ctx, &sdk.CloneVolumeRequest{VolumeID: src, Name: []string{"a-clone", "b-clone"}[i]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		handles = append(handles, res.AsyncHandle)
+	}
+	handles = append(handles, srv.AddAsyncJob("DriveAdd", map[string]interface{}{"message": "All drives added."}))
+	handles = append(handles, srv.AddAsyncJob("Custom", map[string]interface{}{"url": "https://node/bundle.tgz"}))
+	handles = append(handles, srv.AddAsyncJob("SupportBundle", map[string]interface{}{
+		"details":  map[string]interface{}{"bundleName": "sb1", "files": []string{"sb1.tar.gz"}, "url": []string{"https://node/sb1.tar.gz"}, "timeoutSec": 1500},
+		"duration": "00:02:13.201234",
+		"result":   "Passed",
+	}))
+	handles = append(handles, srv.AddAsyncJob("SecureErase", map[string]interface{}{"drives": []int64{4, 5}, "message": "Drives erased."}))
+	handles = append(handles, srv.AddAsyncJob("RecoverDeadVolumes", map[string]interface{}{"volumeIDs": []int64{14}, "message": "Recovery started."}))
+	for _, h := range handles {
+		if err := tr.Track(ctx, h, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	go tr.Run(ctx)
+	waitFor(t, "the first poll", func() bool { return srv.CallCount("ListAsyncResults") > 0 })
+	advance()
+
+	job, err := tr.Wait(ctx, handles[1])
+	if res, ok := job.Result.(*sdk.CloneAsyncResult); err != nil || !ok || res.VolumeID == 0 || res.CloneID == 0 {
+		t.Fatalf("clone job: %v %+v", err, job)
+	}
+	job, err = tr.Wait(ctx, handles[2])
+	if res, ok := job.Result.(*sdk.AsyncMessageResult); err != nil || !ok || res.Message != "All drives added." || job.ResultType != "DriveAdd" {
+		t.Fatalf("drive job: %v %+v", err, job)
+	}
+	job, err = tr.Wait(ctx, handles[3])
+	if raw, ok := job.Result.(json.RawMessage); err != nil || !ok || string(raw) != `{"url":"https://node/bundle.tgz"}` {
+		t.Fatalf("untyped job: %v %+v", err, job)
+	}
+	job, err = tr.Wait(ctx, handles[4])
+	if res, ok := job.Result.(*sdk.CreateSupportBundleResult); err != nil || !ok || res.Result != "Passed" || res.Details.Url[0] != "https://node/sb1.tar.gz" {
+		t.Fatalf("support bundle job: %v %+v", err, job)
+	}
+	job, err = tr.Wait(ctx, handles[5])
+	if res, ok := job.Result.(*sdk.SecureEraseAsyncResult); err != nil || !ok || len(res.Drives) != 2 {
+		t.Fatalf("secure erase job: %v %+v", err, job)
+	}
+	job, err = tr.Wait(ctx, handles[6])
+	if res, ok := job.Result.(*sdk.RecoverDeadVolumesAsyncResult); err != nil || !ok || res.VolumeIDs[0] != 14 {
+		t.Fatalf("recovery job: %v %+v", err, job)
+	}
+	if _, err := tr.Wait(ctx, handles[0]); err != nil {
+		t.Fatal(err)
+	}
+	if srv.CallCount("GetAsyncResult") != 0 || len(tr.Pending()) != 0 {
+		t.Fatalf("%d GetAsyncResult calls, %d pending", srv.CallCount("GetAsyncResult"), len(tr.Pending()))
+	}
+}
+
+func TestJobTrackerReportsProgressAndErrors(t *testing.T) {
+	srv, sf := newSimulator(t)
+	var offset time.Duration
+	var clock sync.Mutex
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv.SetClock(func() time.Time { clock.Lock(); defer clock.Unlock(); return start.Add(offset) })
+	srv.AsyncDelay = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var progress []int64
+	var done []sdk.AsyncJob
+	tr := sdk.NewJobTracker(sf, nil)
+	tr.OnProgress = func(job sdk.AsyncJob) { mu.Lock(); progress = append(progress, job.PercentComplete); mu.Unlock() }
+	tr.OnDone = func(job sdk.AsyncJob) { mu.Lock(); done = append(done, job); mu.Unlock() }
+
+	ok := srv.AddAsyncJob("DriveRemoval", map[string]interface{}{"message": "done"})
+	failed := srv.AddAsyncJob("DriveAdd", nil)
+	for _, h := range []int64{ok, failed} {
+		if err := tr.Track(ctx, h, "drives"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	clock.Lock()
+	offset = 30 * time.Minute
+	clock.Unlock()
+	if err := tr.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	srv.FailAsyncJob(failed, "xDriveAddFailed", "drive 4 failed")
+	if err := tr.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	var jobErr *sdk.AsyncJobError
+	if _, err := tr.Wait(ctx, failed); !errors.As(err, &jobErr) || jobErr.Name != "xDriveAddFailed" {
+		t.Fatalf("expected xDriveAddFailed, got %v", err)
+	}
+
+	clock.Lock()
+	offset = time.Hour
+	clock.Unlock()
+	if err := tr.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(progress) != 2 || progress[0] != 50 || len(done) != 2 || done[0].Err == nil || done[1].Err != nil || done[1].Label != "drives" {
+		t.Fatalf("progress %v, done %+v", progress, done)
+	}
+}
+
+func TestJobTrackerFallsBackToGetAsyncResult(t *testing.T) {
+	srv, sf := newSimulator(t)
+	ctx := context.Background()
+	// The list misses the handles, as it does for results another client collected.
+	srv.Handle("ListAsyncResults", func(json.RawMessage) (interface{}, error) {
+		return sdk.ListAsyncResultsResult{AsyncHandles: []sdk.AsyncHandle{}}, nil
+	})
+	tr := sdk.NewJobTracker(sf, nil)
+	ok := srv.AddAsyncJob("SecureErase", map[string]interface{}{"drives": []int64{4}, "message": "Drives erased."})
+	failed := srv.AddAsyncJob("SecureErase", nil)
+	srv.FailAsyncJob(failed, "xSecureEraseFailed", "Drive 5 is not available.")
+	for _, h := range []int64{ok, failed} {
+		if err := tr.Track(ctx, h, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tr.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if job, err := tr.Wait(ctx, ok); err != nil || job.Result.(*sdk.SecureEraseAsyncResult).Drives[0] != 4 {
+		t.Fatalf("Wait: %v %+v", err, job)
+	}
+	var jobErr *sdk.AsyncJobError
+	if _, err := tr.Wait(ctx, failed); !errors.As(err, &jobErr) || jobErr.Name != "xSecureEraseFailed" {
+		t.Fatalf("expected xSecureEraseFailed, got %v", err)
+	}
+	// The results stay on the cluster for other clients.
+	if res, err := sf.GetAsyncResult(ctx, &sdk.GetAsyncResultRequest{AsyncHandle: ok}); err != nil || res.Status != "complete" {
+		t.Fatalf("result not kept: %v", err)
+	}
+}
+
+func TestJobTrackerForgetsCompletedJobs(t *testing.T) {
+	srv, sf := newSimulator(t)
+	ctx := context.Background()
+	tr := sdk.NewJobTracker(sf, nil)
+	tr.Retention = time.Millisecond
+	handle := srv.AddAsyncJob("DriveAdd", map[string]interface{}{"message": "ok"})
+	if err := tr.Track(ctx, handle, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tr.Wait(ctx, handle); err != nil {
+		t.Fatalf("Wait right after completion: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if err := tr.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tr.Wait(ctx, handle); err == nil || !strings.Contains(err.Error(), "not tracked") {
+		t.Fatalf("completed job still tracked after Retention: %v", err)
+	}
+}
+
+func TestWaitForAsyncResult(t *testing.T) {
+	srv, sf := newSimulator(t)
+	ctx := context.Background()
+
+	handle := srv.AddAsyncJob("DriveAdd", map[string]interface{}{"message": "All drives added."})
+	res, err := sf.WaitForAsyncResult(ctx, handle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, err := sdk.ResultAs[sdk.AsyncMessageResult](&res.Result); err != nil || out.Message != "All drives added." || res.ResultType != "DriveAdd" {
+		t.Fatalf("result %s: %v", res.Result.Raw(), err)
+	}
+
+	srv.AsyncDelay = time.Hour
+	handle = srv.AddAsyncJob("DriveAdd", nil)
+	srv.FailAsyncJob(handle, "xDriveAddFailed", "Drive 12 is not available.")
+	var jobErr *sdk.AsyncJobError
+	if _, err := sf.WaitForAsyncResult(ctx, handle); !errors.As(err, &jobErr) || jobErr.Name != "xDriveAddFailed" {
+		t.Fatalf("expected xDriveAddFailed, got %v", err)
+	}
+}
+
+func TestJobTrackerResumesFromStore(t *testing.T) {
+	srv, sf, _, advance := newCloneServer(t)
+	store := sdk.FileHandleStore{Path: filepath.Join(t.TempDir(), "handles.json")}
+	ctx := context.Background()
+
+	first := sdk.NewJobTracker(sf, store)
+	handle := srv.AddAsyncJob("DriveAdd", map[string]interface{}{"message": "ok"})
+	if err := first.Track(ctx, handle, "add drives on node 3"); err != nil {
+		t.Fatal(err)
+	}
+	if err := first.Poll(ctx); err != nil || len(first.Pending()) != 1 {
+		t.Fatalf("Poll: %v", err)
+	}
+
+	// A new process picks the handle up from the store.
+	advance()
+	second := sdk.NewJobTracker(sf, store)
+	resumed, err := second.Resume(ctx)
+	if err != nil || len(resumed) != 1 || resumed[0].Label != "add drives on node 3" {
+		t.Fatalf("Resume: %v %+v", err, resumed)
+	}
+	if err := second.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if job, err := second.Wait(ctx, handle); err != nil || job.Label != "add drives on node 3" {
+		t.Fatalf("Wait: %v %+v", err, job)
+	}
+	if data, err := os.ReadFile(store.Path); err != nil || string(data) != "[]\n" {
+		t.Fatalf("store not cleared: %v %q", err, data)
+	}
+
+	// A handle the cluster no longer knows fails instead of being waited for forever.
+	if err := second.Track(ctx, 999, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := second.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := second.Wait(ctx, 999); !errors.Is(err, sdk.ErrUnknownAsyncHandle) {
+		t.Fatalf("expected ErrUnknownAsyncHandle, got %v", err)
+	}
+}
@@ -0,0 +1,219 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// NodePort is the port of the per-node management API.
+const NodePort = 442
+
+// NodeClient calls the per-node management API of one node,
+// https://<MIP>:442/json-rpc/<version>. It serves the methods in NodeApi, such as
+// network and cluster configuration, hardware tests and resets, which the cluster
+// (MVIP) endpoint does not have.
+//
+// Create one with NewNodeClient and Connect, or from a connected SFClient with Node,
+// which reuses the client's transport, credentials, retries and interceptors.
+type NodeClient struct {
+	sfClient *SFClient
+	host     string
+}
+
+// NewNodeClient returns a NodeClient configured with the same options as NewSFClient.
+// Call Connect on the result to set the node and credentials.
+func NewNodeClient(opts ...ClientOption) (*NodeClient, error) {
+	sfClient, err := NewSFClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeClient{sfClient: sfClient}, nil
+}
+
+// Connect points the client at the node API of host, an IP address or name with an
+// optional port (NodePort by default), and calls GetAPI like SFClient.Connect,
+// including version negotiation with AutoVersion.
+func (nodeClient *NodeClient) Connect(ctx context.Context, host string, version string, uid string, password string) *SdkError {
+	nodeClient.host = nodeHost(host)
+	return nodeClient.sfClient.Connect(ctx, nodeClient.host, version, uid, password)
+}
+
+// Host returns the host:port of the node API.
+func (nodeClient *NodeClient) Host() string {
+	return nodeClient.host
+}
+
+// APIVersion returns the endpoint version the client is connected with.
+func (nodeClient *NodeClient) APIVersion() string {
+	return nodeClient.sfClient.APIVersion()
+}
+
+// MakeSFCall calls a node API method that has no generated wrapper.
+func (nodeClient *NodeClient) MakeSFCall(ctx context.Context, method string, id int32, params interface{}, res interface{}) (BaseResponse, *SdkError) {
+	return nodeClient.sfClient.MakeSFCall(ctx, method, id, params, res)
+}
+
+// Node returns a client for the node API of host, an IP address or name with an
+// optional port (NodePort by default). It shares the settings, credentials and API
+// version of sfClient, which must be connected, and does not call the node.
+func (sfClient *SFClient) Node(host string) *NodeClient {
+	host = nodeHost(host)
+	httpClient, initErr := sfClient.client()
+	node := &SFClient{
+		baseUrl:        fmt.Sprintf("https://%s/json-rpc/%s", host, sfClient.apiVersion),
+		basic:          sfClient.basic,
+		authenticator:  sfClient.authenticator,
+		transport:      sfClient.transport,
+		httpClient:     httpClient,
+		initErr:        initErr,
+		retryPolicy:    sfClient.retryPolicy,
+		cassette:       sfClient.cassette,
+		interceptors:   sfClient.interceptors,
+		apiVersion:     sfClient.apiVersion,
+		clusterVersion: sfClient.clusterVersion,
+		maxAPIVersion:  sfClient.maxAPIVersion,
+	}
+	node.initOnce.Do(func() {})
+	return &NodeClient{sfClient: node, host: host}
+}
+
+// nodeHost adds NodePort to host unless it has a port.
+func nodeHost(host string) string {
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+	return net.JoinHostPort(strings.Trim(host, "[]"), strconv.Itoa(NodePort))
+}
+
+// DiscoverNodes returns the active nodes of the cluster from ListAllNodes. Their Mip
+// is the address of the node API.
+func (sfClient *SFClient) DiscoverNodes(ctx context.Context) ([]Node, *SdkError) {
+	res, sdkErr := sfClient.ListAllNodes(ctx)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	return res.Nodes, nil
+}
+
+// FanOutOptions configures FanOut.
+type FanOutOptions struct {
+	// NodeIDs limits the call to these nodes. Empty means all active nodes.
+	NodeIDs []int64
+	// Concurrency is the maximum number of nodes called at once. Zero or less calls
+	// all nodes at once.
+	Concurrency int
+	// Address returns the host[:port] of a node's API. The default is the node's Mip.
+	Address func(Node) string
+}
+
+// NodeResult is the outcome of a FanOut call on one node.
+type NodeResult[T any] struct {
+	Node   Node
+	Result T
+	// Err is nil when the call succeeded.
+	Err *SdkError
+}
+
+// NodeError is the error of a FanOut call on one node.
+type NodeError struct {
+	NodeID int64
+	Name   string
+	Err    *SdkError
+}
+
+func (e NodeError) Error() string {
+	return fmt.Sprintf("node %d (%s): %v", e.NodeID, e.Name, e.Err)
+}
+
+func (e NodeError) Unwrap() error { return e.Err }
+
+// NodeErrors is returned by FanOut when the call failed on some nodes. errors.Is and
+// errors.As look at each node's error.
+type NodeErrors []NodeError
+
+func (e NodeErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, ne := range e {
+		msgs[i] = ne.Error()
+	}
+	return fmt.Sprintf("%d node(s) failed: %s", len(e), strings.Join(msgs, "; "))
+}
+
+func (e NodeErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, ne := range e {
+		errs[i] = ne
+	}
+	return errs
+}
+
+// FanOut discovers the cluster's nodes with DiscoverNodes and runs call against the
+// node API of each of them concurrently. It returns one result per node, in
+// ListAllNodes order, and, if any call failed, NodeErrors with the failures.
+// Discovery errors are returned as an *SdkError without results.
+//
+//	results, err := sdk.FanOut(ctx, sf, sdk.FanOutOptions{}, func(ctx context.Context, n *sdk.NodeClient) (*sdk.GetNetworkConfigResult, *sdk.SdkError) {
+//		return n.GetNetworkConfig(ctx)
+//	})
+func FanOut[T any](ctx context.Context, sfClient *SFClient, opts FanOutOptions, call func(ctx context.Context, node *NodeClient) (T, *SdkError)) ([]NodeResult[T], error) {
+	nodes, sdkErr := sfClient.DiscoverNodes(ctx)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	if len(opts.NodeIDs) > 0 {
+		byID := map[int64]Node{}
+		for _, n := range nodes {
+			byID[n.NodeID] = n
+		}
+		nodes = nodes[:0:0]
+		for _, id := range opts.NodeIDs {
+			n, ok := byID[id]
+			if !ok {
+				return nil, &SdkError{Code: "sdk.node", Name: "xNodeIDDoesNotExist", Detail: fmt.Sprintf("node %d is not an active node", id), Method: "ListAllNodes"}
+			}
+			nodes = append(nodes, n)
+		}
+	}
+	address := opts.Address
+	if address == nil {
+		address = func(n Node) string { return n.Mip }
+	}
+	limit := opts.Concurrency
+	if limit <= 0 || limit > len(nodes) {
+		limit = len(nodes)
+	}
+
+	results := make([]NodeResult[T], len(nodes))
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i, n := range nodes {
+		results[i].Node = n
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			res, sdkErr := call(ctx, sfClient.Node(address(n)))
+			results[i].Result = res
+			if sdkErr != nil {
+				results[i].Err = sdkErr
+			}
+		}()
+	}
+	wg.Wait()
+
+	var failed NodeErrors
+	for _, r := range results {
+		if r.Err != nil {
+			failed = append(failed, NodeError{NodeID: r.Node.NodeID, Name: r.Node.Name, Err: r.Err})
+		}
+	}
+	if len(failed) > 0 {
+		return results, failed
+	}
+	return results, nil
+}
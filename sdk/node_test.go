@@ -0,0 +1,120 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+func TestNodeClient(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+	node := srv.AddNode("sf-01")
+
+	nc, err := sdk.NewNodeClient(srv.ClientOptions()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if sdkErr := nc.Connect(ctx, node.Host(), sdk.AutoVersion, srv.Username, srv.Password); sdkErr != nil {
+		t.Fatal(sdkErr)
+	}
+	res, sdkErr := nc.GetConfig(ctx, &sdk.GetConfigRequest{})
+	if sdkErr != nil {
+		t.Fatal(sdkErr)
+	}
+	if res.Config.Cluster.Name != "sf-01" || res.Config.Network.Bond1G.Address != node.Node.Mip {
+		t.Errorf("config = %+v", res.Config)
+	}
+	if nc.APIVersion() != srv.Version || nc.Host() != node.Host() {
+		t.Errorf("connected to %s with %s", nc.Host(), nc.APIVersion())
+	}
+
+	sf, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for host, want := range map[string]string{
+		"10.117.0.1":      "10.117.0.1:442",
+		"fe80::1":         "[fe80::1]:442",
+		"[fe80::1]":       "[fe80::1]:442",
+		"node1.lab:10442": "node1.lab:10442",
+	} {
+		if got := sf.Node(host).Host(); got != want {
+			t.Errorf("Node(%q).Host() = %q, want %q", host, got, want)
+		}
+	}
+	// The cluster endpoint does not serve node methods.
+	if _, sdkErr := sf.ListTests(ctx, &sdk.ListTestsRequest{}); sdkErr == nil || sdkErr.Name != "xUnknownAPIMethod" {
+		t.Errorf("ListTests on the cluster = %v", sdkErr)
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+	for _, name := range []string{"sf-01", "sf-02", "sf-03"} {
+		srv.AddNode(name)
+	}
+	sf, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	opts := sdk.FanOutOptions{Address: srv.NodeAddress, Concurrency: 2}
+	networkConfig := func(ctx context.Context, n *sdk.NodeClient) (*sdk.GetNetworkConfigResult, *sdk.SdkError) {
+		return n.GetNetworkConfig(ctx, &sdk.GetNetworkConfigRequest{})
+	}
+
+	results, err := sdk.FanOut(ctx, sf, opts, networkConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("results = %+v", results)
+	}
+	for i, r := range results {
+		if r.Node.NodeID != int64(i+1) || r.Err != nil || r.Result.Network.Bond1G.Address != r.Node.Mip {
+			t.Errorf("result %d = %+v", i, r)
+		}
+	}
+	nodeCalls := 0
+	for _, c := range srv.Calls() {
+		if c.Method == "GetNetworkConfig" {
+			nodeCalls++
+			if c.NodeID == 0 || c.APIVersion != srv.Version {
+				t.Errorf("call %+v", c)
+			}
+		}
+	}
+	if nodeCalls != 3 {
+		t.Errorf("%d GetNetworkConfig calls, want 3", nodeCalls)
+	}
+
+	srv.AddNode("sf-04").InjectError("TestPing", "xPermissionDenied", "no", 1)
+	pings, err := sdk.FanOut(ctx, sf, opts, func(ctx context.Context, n *sdk.NodeClient) (*sdk.TestPingResult, *sdk.SdkError) {
+		return n.TestPing(ctx, &sdk.TestPingRequest{})
+	})
+	var nodeErrs sdk.NodeErrors
+	if !errors.As(err, &nodeErrs) || len(nodeErrs) != 1 || nodeErrs[0].NodeID != 4 || nodeErrs[0].Name != "sf-04" {
+		t.Fatalf("err = %v, want a NodeErrors for sf-04", err)
+	}
+	if !errors.Is(err, sdk.ErrPermissionDenied) {
+		t.Errorf("errors.Is(%v, ErrPermissionDenied) = false", err)
+	}
+	if len(pings) != 4 || pings[3].Err == nil || pings[0].Err != nil || pings[0].Result.Result != "Passed" {
+		t.Errorf("pings = %+v", pings)
+	}
+
+	opts.NodeIDs = []int64{3}
+	if results, err := sdk.FanOut(ctx, sf, opts, networkConfig); err != nil || len(results) != 1 || results[0].Node.Name != "sf-03" {
+		t.Errorf("FanOut to node 3 = %+v, %v", results, err)
+	}
+	opts.NodeIDs = []int64{9}
+	if _, err := sdk.FanOut(ctx, sf, opts, networkConfig); !sdk.IsNotFound(err) {
+		t.Errorf("FanOut to node 9 err = %v, want not found", err)
+	}
+}
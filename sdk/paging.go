@@ -0,0 +1,174 @@
+package sdk
+
+import (
+	"context"
+	"iter"
+	"sort"
+)
+
+// DefaultPageSize is the number of objects the list iterators request per call when
+// no page size is given.
+const DefaultPageSize int64 = 500
+
+// AllVolumes pages through ListVolumes, fetching pageSize volumes per call. The
+// filters in req apply to every page; StartVolumeID is where paging begins and Limit,
+// if set, caps the total number of volumes yielded. When req.VolumeIDs is set, the IDs
+// are requested pageSize at a time instead.
+//
+// Only one page is held in memory at a time. Iteration stops at the first failed call
+// or when ctx is done, yielding the error as the last element.
+func (sfClient *SFClient) AllVolumes(ctx context.Context, req ListVolumesRequest, pageSize int64) iter.Seq2[Volume, error] {
+	pageSize = pageSizeOr(pageSize)
+	if len(req.VolumeIDs) > 0 {
+		return volumesByID(ctx, sfClient, req, pageSize)
+	}
+	return pageByID(ctx, req.StartVolumeID, req.Limit, pageSize, volumeID,
+		func(start, n int64) ([]Volume, *SdkError) {
+			r := req
+			r.StartVolumeID, r.Limit = start, n
+			res, err := sfClient.ListVolumes(ctx, &r)
+			if err != nil {
+				return nil, err
+			}
+			return res.Volumes, nil
+		})
+}
+
+// AllActiveVolumes pages through ListActiveVolumes. StartVolumeID and Limit in req
+// behave as in AllVolumes.
+func (sfClient *SFClient) AllActiveVolumes(ctx context.Context, req ListActiveVolumesRequest, pageSize int64) iter.Seq2[Volume, error] {
+	return pageByID(ctx, req.StartVolumeID, req.Limit, pageSizeOr(pageSize), volumeID,
+		func(start, n int64) ([]Volume, *SdkError) {
+			r := req
+			r.StartVolumeID, r.Limit = start, n
+			res, err := sfClient.ListActiveVolumes(ctx, &r)
+			if err != nil {
+				return nil, err
+			}
+			return res.Volumes, nil
+		})
+}
+
+// AllVolumesForAccount pages through ListVolumesForAccount for req.AccountID.
+// StartVolumeID and Limit in req behave as in AllVolumes.
+func (sfClient *SFClient) AllVolumesForAccount(ctx context.Context, req ListVolumesForAccountRequest, pageSize int64) iter.Seq2[Volume, error] {
+	return pageByID(ctx, req.StartVolumeID, req.Limit, pageSizeOr(pageSize), volumeID,
+		func(start, n int64) ([]Volume, *SdkError) {
+			r := req
+			r.StartVolumeID, r.Limit = start, n
+			res, err := sfClient.ListVolumesForAccount(ctx, &r)
+			if err != nil {
+				return nil, err
+			}
+			return res.Volumes, nil
+		})
+}
+
+// AllDeletedVolumes yields the volumes that are deleted but not yet purged.
+// ListDeletedVolumes has no paging parameters, so this pages through ListVolumes with
+// volumeStatus "deleted" instead.
+func (sfClient *SFClient) AllDeletedVolumes(ctx context.Context, pageSize int64) iter.Seq2[Volume, error] {
+	return sfClient.AllVolumes(ctx, ListVolumesRequest{VolumeStatus: "deleted"}, pageSize)
+}
+
+// AllEvents pages through ListEvents in EventID order. StartEventID, EndEventID and
+// the filters in req apply as in ListEvents; MaxEvents, if set, caps the total number
+// of events yielded.
+func (sfClient *SFClient) AllEvents(ctx context.Context, req ListEventsRequest, pageSize int64) iter.Seq2[EventInfo, error] {
+	return pageByID(ctx, req.StartEventID, req.MaxEvents, pageSizeOr(pageSize), eventID,
+		func(start, n int64) ([]EventInfo, *SdkError) {
+			r := req
+			r.StartEventID, r.MaxEvents = start, n
+			res, err := sfClient.ListEvents(ctx, &r)
+			if err != nil {
+				return nil, err
+			}
+			events := res.Events
+			sort.Slice(events, func(i, j int) bool { return events[i].EventID < events[j].EventID })
+			return events, nil
+		})
+}
+
+func pageSizeOr(n int64) int64 {
+	if n <= 0 {
+		return DefaultPageSize
+	}
+	return n
+}
+
+func volumeID(v *Volume) int64   { return v.VolumeID }
+func eventID(e *EventInfo) int64 { return e.EventID }
+
+// pageByID yields the objects returned by list, which must return up to n objects with
+// an ID of at least start in ID order. Each page starts after the highest ID of the
+// previous one; a short page ends the iteration. limit caps the total when positive.
+func pageByID[T any](ctx context.Context, start, limit, pageSize int64, id func(*T) int64, list func(start, n int64) ([]T, *SdkError)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		var yielded int64
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			n := pageSize
+			if limit > 0 && limit-yielded < n {
+				n = limit - yielded
+			}
+			page, sdkErr := list(start, n)
+			if sdkErr != nil {
+				yield(zero, sdkErr)
+				return
+			}
+			next := start
+			for i := range page {
+				objID := id(&page[i])
+				// A cluster that ignores the start parameter must not cause repeats.
+				if objID < start {
+					continue
+				}
+				if !yield(page[i], nil) {
+					return
+				}
+				yielded++
+				next = max(next, objID+1)
+			}
+			if int64(len(page)) < n || (limit > 0 && yielded >= limit) || next == start {
+				return
+			}
+			start = next
+		}
+	}
+}
+
+// volumesByID requests req.VolumeIDs in chunks of pageSize.
+func volumesByID(ctx context.Context, sfClient *SFClient, req ListVolumesRequest, pageSize int64) iter.Seq2[Volume, error] {
+	return func(yield func(Volume, error) bool) {
+		ids := req.VolumeIDs
+		var yielded int64
+		for len(ids) > 0 {
+			if err := ctx.Err(); err != nil {
+				yield(Volume{}, err)
+				return
+			}
+			chunk := ids[:min(int64(len(ids)), pageSize)]
+			ids = ids[len(chunk):]
+			r := req
+			r.VolumeIDs, r.StartVolumeID, r.Limit = chunk, 0, 0
+			res, sdkErr := sfClient.ListVolumes(ctx, &r)
+			if sdkErr != nil {
+				yield(Volume{}, sdkErr)
+				return
+			}
+			for _, v := range res.Volumes {
+				if !yield(v, nil) {
+					return
+				}
+				yielded++
+				if req.Limit > 0 && yielded >= req.Limit {
+					return
+				}
+			}
+		}
+	}
+}
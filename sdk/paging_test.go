@@ -0,0 +1,138 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"testing"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+// newPagingServer returns a client for a simulator holding n volumes of one account.
+func newPagingServer(t *testing.T, n int) (*sdktest.Server, *sdk.SFClient, int64) {
+	t.Helper()
+	srv, sf := newSimulator(t)
+	names := make([]string, n)
+	for i := range names {
+		names[i] = fmt.Sprintf("vol-%d", i)
+	}
+	accountID, _ := createVolumes(t, sf, names...)
+	return srv, sf, accountID
+}
+
+func collect[T any](t *testing.T, seq iter.Seq2[T, error]) []T {
+	t.Helper()
+	var out []T
+	for v, err := range seq {
+		if err != nil {
+			t.Fatalf("iteration failed: %v", err)
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
+func TestAllVolumesPages(t *testing.T) {
+	srv, sf, accountID := newPagingServer(t, 25)
+	ctx := context.Background()
+
+	vols := collect(t, sf.AllVolumesForAccount(ctx, sdk.ListVolumesForAccountRequest{AccountID: accountID}, 10))
+	if len(vols) != 25 || srv.CallCount("ListVolumesForAccount") != 3 {
+		t.Fatalf("got %d volumes in %d calls", len(vols), srv.CallCount("ListVolumesForAccount"))
+	}
+	for i := 1; i < len(vols); i++ {
+		if vols[i].VolumeID <= vols[i-1].VolumeID {
+			t.Fatalf("volumes out of order: %d after %d", vols[i].VolumeID, vols[i-1].VolumeID)
+		}
+	}
+
+	// StartVolumeID and Limit pick a window across page boundaries.
+	window := collect(t, sf.AllActiveVolumes(ctx, sdk.ListActiveVolumesRequest{StartVolumeID: vols[3].VolumeID, Limit: 12}, 5))
+	if len(window) != 12 || window[0].VolumeID != vols[3].VolumeID || window[11].VolumeID != vols[14].VolumeID {
+		t.Fatalf("unexpected window %d..%d (%d)", window[0].VolumeID, window[len(window)-1].VolumeID, len(window))
+	}
+
+	ids := []int64{vols[0].VolumeID, vols[7].VolumeID, vols[20].VolumeID}
+	byID := collect(t, sf.AllVolumes(ctx, sdk.ListVolumesRequest{VolumeIDs: ids}, 2))
+	if len(byID) != 3 || srv.CallCount("ListVolumes") != 2 {
+		t.Fatalf("got %d volumes by ID in %d calls", len(byID), srv.CallCount("ListVolumes"))
+	}
+}
+
+func TestAllDeletedVolumes(t *testing.T) {
+	_, sf, _ := newPagingServer(t, 6)
+	ctx := context.Background()
+	for _, id := range []int64{2, 4, 5} {
+		if _, err := sf.DeleteVolume(ctx, &sdk.DeleteVolumeRequest{VolumeID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	deleted := collect(t, sf.AllDeletedVolumes(ctx, 2))
+	if len(deleted) != 3 || deleted[0].VolumeID != 2 || deleted[2].VolumeID != 5 {
+		t.Fatalf("unexpected deleted volumes %+v", deleted)
+	}
+}
+
+func TestAllVolumesStopsEarly(t *testing.T) {
+	srv, sf, _ := newPagingServer(t, 25)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	seen := 0
+	for _, err := range sf.AllVolumes(ctx, sdk.ListVolumesRequest{}, 10) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen++; seen == 3 {
+			break
+		}
+	}
+	if srv.CallCount("ListVolumes") != 1 {
+		t.Fatalf("breaking out of the loop should not fetch more pages, got %d calls", srv.CallCount("ListVolumes"))
+	}
+
+	var last error
+	seen = 0
+	for _, err := range sf.AllVolumes(ctx, sdk.ListVolumesRequest{}, 10) {
+		if err != nil {
+			last = err
+			break
+		}
+		if seen++; seen == 10 {
+			cancel()
+		}
+	}
+	if !errors.Is(last, context.Canceled) || seen != 10 {
+		t.Fatalf("expected cancellation after the first page, got %v after %d volumes", last, seen)
+	}
+}
+
+func TestAllVolumesReportsErrors(t *testing.T) {
+	srv, sf, _ := newPagingServer(t, 5)
+	srv.InjectError("ListVolumes", "xClusterBusy", "busy", 1)
+	for _, err := range sf.AllVolumes(context.Background(), sdk.ListVolumesRequest{}, 2) {
+		if err == nil || sdk.ErrorName(err) != "xClusterBusy" {
+			t.Fatalf("expected xClusterBusy, got %v", err)
+		}
+		return
+	}
+	t.Fatal("no error yielded")
+}
+
+func TestAllEvents(t *testing.T) {
+	srv, sf := newSimulator(t)
+	for i := 0; i < 7; i++ {
+		srv.AddEvent(sdk.EventInfo{EventInfoType: "apiEvent", Message: "event"})
+	}
+	events := collect(t, sf.AllEvents(context.Background(), sdk.ListEventsRequest{StartEventID: 2, EndEventID: 6}, 2))
+	if len(events) != 5 || events[0].EventID != 2 || events[4].EventID != 6 {
+		t.Fatalf("unexpected events %+v", events)
+	}
+	capped := collect(t, sf.AllEvents(context.Background(), sdk.ListEventsRequest{MaxEvents: 3}, 2))
+	if len(capped) != 3 {
+		t.Fatalf("MaxEvents should cap the total, got %d", len(capped))
+	}
+}
@@ -0,0 +1,92 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// RawResult holds a result the SDK does not model, such as that of a method missing
+// from api/element.json called through MakeSFCall. It keeps the JSON exactly as the
+// cluster sent it; Get reads one member into a typed value and Decode reads the
+// whole result into a struct or map.
+type RawResult struct {
+	raw json.RawMessage
+}
+
+func (r *RawResult) UnmarshalJSON(data []byte) error {
+	r.raw = append(r.raw[:0], data...)
+	return nil
+}
+
+func (r RawResult) MarshalJSON() ([]byte, error) {
+	if len(r.raw) == 0 {
+		return []byte("{}"), nil
+	}
+	return r.raw, nil
+}
+
+// Raw returns the result as received. It is nil before the result was decoded.
+func (r *RawResult) Raw() json.RawMessage {
+	return r.raw
+}
+
+// Decode unmarshals the whole result into v.
+func (r *RawResult) Decode(v interface{}) error {
+	if len(r.raw) == 0 {
+		return fmt.Errorf("empty result")
+	}
+	return json.Unmarshal(r.raw, v)
+}
+
+// Keys returns the sorted member names of the result, or nil if the result is not
+// a JSON object.
+func (r *RawResult) Keys() []string {
+	members, err := r.members()
+	if err != nil {
+		return nil
+	}
+	keys := make([]string, 0, len(members))
+	for k := range members {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Get unmarshals the member key into v. It reports false, and leaves v alone, when
+// the result has no such member.
+func (r *RawResult) Get(key string, v interface{}) (bool, error) {
+	members, err := r.members()
+	if err != nil {
+		return false, err
+	}
+	m, ok := members[key]
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(m, v); err != nil {
+		return true, fmt.Errorf("result member %q: %w", key, err)
+	}
+	return true, nil
+}
+
+func (r *RawResult) members() (map[string]json.RawMessage, error) {
+	var members map[string]json.RawMessage
+	if len(bytes.TrimSpace(r.raw)) == 0 {
+		return members, nil
+	}
+	if err := json.Unmarshal(r.raw, &members); err != nil {
+		return nil, fmt.Errorf("result is not an object: %w", err)
+	}
+	return members, nil
+}
+
+// ResultAs decodes a RawResult into a new T, for callers that know the layout their
+// cluster returns.
+func ResultAs[T any](r *RawResult) (T, error) {
+	var v T
+	err := r.Decode(&v)
+	return v, err
+}
@@ -0,0 +1,183 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// resultFixtures maps each fixture in testdata/results to the result it must decode
+// into. The fixtures cover the results that used to be empty placeholders.
+var resultFixtures = map[string]interface{}{
+	"APIWriteBlock":                            &APIWriteBlockResult{BlockID: "e242ed3bffccdf271b7fbaf34ed72d089537b42f", Written: true},
+	"BDTPipeline":                              &BDTPipelineResult{Pipelines: []BDTPipeline{{ServiceID: 5, State: "idle"}}},
+	"CheckBlock":                               &CheckBlockResult{BlockID: "f1d2d2f924e986ac86fdf7b36c94bcdf32beec15", ServiceIDs: []int64{5, 9}, Found: true},
+	"CheckClusterPair":                         &CheckClusterPairResult{ClusterPairID: 1, Status: "Connected"},
+	"CopyVolumeToSnapshot":                     &CopyVolumeToSnapshotResult{AsyncHandle: 301, SnapshotID: 88},
+	"CreateClusterFault":                       &CreateClusterFaultResult{ClusterFaultID: 97},
+	"CreateDatabaseEntry":                      &CreateDatabaseEntryResult{Path: "/cluster/custom/entry", Data: map[string]interface{}{"owner": "ops"}},
+	"CreateEvent":                              &CreateEventResult{EventID: 8812},
+	"CreateSnapMirrorEndpointUnmanaged":        &CreateSnapMirrorEndpointUnmanagedResult{SnapMirrorEndpoint: SnapMirrorEndpoint{SnapMirrorEndpointID: 2, ManagementIP: "10.117.60.20", ClusterName: "ontap-dr", Username: "admin", IpAddresses: []string{"10.117.61.21", "10.117.61.22"}, IsConnected: true}},
+	"DeleteDatabaseEntry":                      &DeleteDatabaseEntryResult{Path: "/cluster/custom/entry"},
+	"DeleteSnapMirrorObjectAttributes":         &DeleteSnapMirrorObjectAttributesResult{Attributes: map[string]interface{}{}},
+	"DeleteSnapshotSnapMirrorObjectAttributes": &DeleteSnapshotSnapMirrorObjectAttributesResult{Attributes: map[string]interface{}{}},
+	"DeleteVirtualVolumeHosts":                 &DeleteVirtualVolumeHostsResult{VirtualVolumeHostIDs: []string{"b7c13d6f-89f4-4c9b-a0c0-3d14bb97a4ab"}},
+	"DeleteVolumeSnapMirrorObjectAttributes":   &DeleteVolumeSnapMirrorObjectAttributesResult{Attributes: map[string]interface{}{}},
+	"DisableBmcColdReset":                      &DisableBmcColdResetResult{CBmcResetDurationMinutes: 0},
+	"DisableClusterSsh":                        &DisableClusterSshResult{Enabled: false, TimeLeft: "00:00:00", Nodes: []NodeSshInfo{{NodeID: 1, Enabled: false}, {NodeID: 2, Enabled: false}}},
+	"DisconnectISCSISessions":                  &DisconnectISCSISessionsResult{SessionIDs: []int64{451, 452}},
+	"EnableBmcColdReset":                       &EnableBmcColdResetResult{CBmcResetDurationMinutes: 20160},
+	"EnableClusterSsh":                         &EnableClusterSshResult{Enabled: true, TimeLeft: "00:59:48", Nodes: []NodeSshInfo{{NodeID: 1, Enabled: true}, {NodeID: 2, Enabled: true}, {NodeID: 3, Enabled: true}}},
+	"FillSlice":                                &FillSliceResult{SliceID: 21, Filled: true},
+	"FindMismatchedSliceLBAs":                  &FindMismatchedSliceLBAsResult{SliceID: 21, MismatchedLBAs: []int64{}},
+	"FinishUpgrade":                            &FinishUpgradeResult{State: "complete", CurrentVersion: "12.5.0.897"},
+	"ForceRecycle":                             &ForceRecycleResult{ServiceIDs: []int64{5}},
+	"ForceWholeFileSync":                       &ForceWholeFileSyncResult{SliceIDs: []int64{21}},
+	"GetBinAssignments":                        &GetBinAssignmentsResult{Bins: []BinAssignment{{BinID: 0, ServiceIDs: []int64{5, 9}}}},
+	"GetClusterSettings":                       &GetClusterSettingsResult{Settings: map[string]string{"ClusterFullWarnPercent": "80", "DefaultProtectionScheme": "doubleHelix"}},
+	"GetClusterSshInfo":                        &GetClusterSshInfoResult{Enabled: true, TimeLeft: "00:59:48", Nodes: []NodeSshInfo{{NodeID: 1, Enabled: true}, {NodeID: 2, Enabled: true}, {NodeID: 3, Enabled: true}}},
+	"GetClusterStructure":                      &GetClusterStructureResult{Accounts: []Account{{AccountID: 1, Username: "tenant1"}}, ClusterInfo: ClusterInfo{Name: "prod"}, Initiators: []Initiator{}, QosPolicies: []QoSPolicy{}, Schedules: []Schedule{}, VolumeAccessGroups: []VolumeAccessGroup{{VolumeAccessGroupID: 2, Name: "esx", Volumes: []int64{14}}}, Volumes: []Volume{}},
+	"GetCodeTimings":                           &GetCodeTimingsResult{Services: map[string]map[string]CodeTiming{"5": {"Write": {Count: 1000, AvgMicroseconds: 210}}}},
+	"GetConnectivityReport":                    &GetConnectivityReportResult{Nodes: map[string]map[string]NodeConnectivity{"1": {"2": {Reachable: true, LatencyMs: 0.11}}}},
+	"GetDaemonStatus":                          &GetDaemonStatusResult{Daemons: map[string]string{"solidfire": "running", "sfconfig": "running", "ntpd": "running"}},
+	"GetDatabaseEntry":                         &GetDatabaseEntryResult{Path: "/cluster/settings/ntp", Data: map[string]interface{}{"servers": []interface{}{"pool.ntp.org"}}},
+	"GetDebugOptions":                          &GetDebugOptionsResult{Options: map[string]interface{}{"logLevel": "info", "traceISCSI": false}},
+	"GetEnsemble":                              &GetEnsembleResult{Ensemble: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}},
+	"GetFibreChannelVolumeAccessInfo":          &GetFibreChannelVolumeAccessInfoResult{Volumes: []FibreChannelVolumeAccess{{VolumeID: 14, AccessGroups: []int64{2}}}},
+	"GetGCStatus":                              &GetGCStatusResult{GcInProgress: false, LastGCStart: "2026-10-14T02:00:00Z", LastGCEnd: "2026-10-14T02:41:17Z", BlocksDiscarded: 120433},
+	"GetLocalStats":                            &GetLocalStatsResult{Cpu: 12, MemoryUsedBytes: 9125312512},
+	"GetReport":                                &GetReportResult{Services: []ReportService{{ServiceID: 23, NodeID: 1, Status: "healthy"}, {ServiceID: 24, NodeID: 2, Status: "healthy"}}, Slices: []ReportSlice{{SliceID: 14, VolumeID: 14, Primary: 23, LiveSecondaries: []int64{24}, DeadSecondaries: []int64{}}}},
+	"GetRsyslogInfo":                           &GetRsyslogInfoResult{RemoteHosts: []RsyslogHost{{Host: "10.1.1.10", Port: 514}}},
+	"GetServiceStatus":                         &GetServiceStatusResult{Services: []ServiceStatus{{ServiceID: 5, Status: "healthy"}}},
+	"GetSliceFileSizeReport":                   &GetSliceFileSizeReportResult{Slices: []SliceFileSize{{SliceID: 21, FileSize: 5368709120}}},
+	"GetSliceInfo":                             &GetSliceInfoResult{Slices: []SliceInfo{{SliceID: 21, Primary: 5, Secondaries: []int64{9}}}},
+	"GetSliceReserveUsedThresholdPct":          &GetSliceReserveUsedThresholdPctResult{SliceReserveUsedThresholdPct: 5},
+	"GetThreadBacktraces":                      &GetThreadBacktracesResult{Services: map[string][]ThreadBacktrace{"5": {{Thread: "main", Frames: []string{"sf::Run", "main"}}}}},
+	"GetVolumeSetEfficiency":                   &GetVolumeSetEfficiencyResult{Compression: 1.55, Deduplication: 2.1, MissingVolumes: []int64{14}, ThinProvisioning: 1.8, Timestamp: "2026-10-14T03:10:02Z"},
+	"IsUpgradeInProgress":                      &IsUpgradeInProgressResult{InProgress: false},
+	"ListAptSourceLines":                       &ListAptSourceLinesResult{Lines: []string{"deb http://repo.example.com/solidfire 12.5 main"}},
+	"ListCloneJobs":                            &ListCloneJobsResult{CloneJobs: []CloneJob{{AsyncHandle: 301, CloneID: 4, GroupCloneID: 0, SrcVolumeID: 14, DstVolumeID: 15, DstAccountID: 1, SnapshotID: 0, CreateTime: "2026-10-14T09:00:00Z", ElapsedTime: 42, PercentComplete: 40, Stage: "data"}}},
+	"ListClusterCapacityHistory":               &ListClusterCapacityHistoryResult{ClusterCapacityHistory: []ClusterCapacity{{ActiveBlockSpace: 13062484992, ActiveSessions: 12, AverageIOPS: 840, ClusterRecentIOSize: 8192, CompressionPercent: 160, CurrentIOPS: 610, DeDuplicationPercent: 210, EfficiencyPercent: 350, MaxIOPS: 200000, MaxOverProvisionableSpace: 276546135777280, MaxProvisionedSpace: 55309227155456, MaxUsedMetadataSpace: 432103337164, MaxUsedSpace: 73745636212736, NonZeroBlocks: 31163285, PeakActiveSessions: 20, PeakIOPS: 7020, ProvisionedSpace: 24696061952000, SnapshotNonZeroBlocks: 1024, ThinProvisioningPercent: 180, Timestamp: "2026-10-14T00:00:00Z", TotalOps: 4092231210, UniqueBlocks: 5013928, UniqueBlocksUsedSpace: 2041270272, UsedMetadataSpace: 2134605824, UsedMetadataSpaceInSnapshots: 4096000, UsedSpace: 12500000000000, ZeroBlocks: 40203851}, {ActiveBlockSpace: 13062484992, ActiveSessions: 12, AverageIOPS: 840, ClusterRecentIOSize: 8192, CompressionPercent: 160, CurrentIOPS: 610, DeDuplicationPercent: 210, EfficiencyPercent: 350, MaxIOPS: 200000, MaxOverProvisionableSpace: 276546135777280, MaxProvisionedSpace: 55309227155456, MaxUsedMetadataSpace: 432103337164, MaxUsedSpace: 73745636212736, NonZeroBlocks: 31163285, PeakActiveSessions: 20, PeakIOPS: 7020, ProvisionedSpace: 24696061952000, SnapshotNonZeroBlocks: 1024, ThinProvisioningPercent: 180, Timestamp: "2026-10-15T00:00:00Z", TotalOps: 4092231210, UniqueBlocks: 5013928, UniqueBlocksUsedSpace: 2041270272, UsedMetadataSpace: 2134605824, UsedMetadataSpaceInSnapshots: 4096000, UsedSpace: 12600000000000, ZeroBlocks: 40203851}}},
+	"ListDatabaseChildren":                     &ListDatabaseChildrenResult{Path: "/cluster", Children: []string{"nodes", "services", "volumes"}},
+	"ListDatabaseChildrenData":                 &ListDatabaseChildrenDataResult{Path: "/cluster/nodes", Children: map[string]interface{}{"1": map[string]interface{}{"name": "sf-node-01"}, "2": map[string]interface{}{"name": "sf-node-02"}}},
+	"ListRepositories":                         &ListRepositoriesResult{Repositories: []Repository{{Name: "solidfire", Url: "http://repo.example.com/solidfire"}}},
+	"ListSliceBranchesByService":               &ListSliceBranchesByServiceResult{Services: map[string][]SliceBranches{"5": {{SliceID: 21, Branches: 2}}}},
+	"ListSnapMirrorObjectAttributes":           &ListSnapMirrorObjectAttributesResult{Objects: []SnapMirrorObjectAttributes{{ObjectID: 12, Attributes: map[string]interface{}{"relationship": "dr"}}}},
+	"ModifyClusterFault":                       &ModifyClusterFaultResult{Fault: ClusterFaultInfo{ClusterFaultID: 97, Severity: "warning"}},
+	"ModifySliceReserveUsedThresholdPct":       &ModifySliceReserveUsedThresholdPctResult{SliceReserveUsedThresholdPct: 10},
+	"ModifySnapMirrorEndpointUnmanaged":        &ModifySnapMirrorEndpointUnmanagedResult{SnapMirrorEndpoint: SnapMirrorEndpoint{SnapMirrorEndpointID: 2, ManagementIP: "10.117.60.20", ClusterName: "ontap-dr", Username: "admin", IpAddresses: []string{"10.117.61.21", "10.117.61.22"}, IsConnected: true}},
+	"MovePrimariesAwayFromNode":                &MovePrimariesAwayFromNodeResult{NodeID: 3, MovedSlices: []int64{21, 34}},
+	"NotifyIntentToRestart":                    &NotifyIntentToRestartResult{Acknowledged: true},
+	"PairCluster":                              &PairClusterResult{ClusterPairingKey: "7b22636c7573746572", ClusterPairID: 1},
+	"PairVolume":                               &PairVolumeResult{VolumePairingKey: "7b226d6f6465223a22417379"},
+	"ProtocolVersionUpgrade":                   &ProtocolVersionUpgradeResult{CurrentVersion: 7, TargetVersion: 8, State: "upgrading"},
+	"ProtocolVersionUpgradeAvailable":          &ProtocolVersionUpgradeAvailableResult{Available: true, CurrentVersion: 7, TargetVersion: 8},
+	"PurgeAllDeletedVolumes":                   &PurgeAllDeletedVolumesResult{VolumeIDs: []int64{31, 32}},
+	"ReadSliceLBA":                             &ReadSliceLBAResult{SliceID: 21, LBAs: []SliceLBA{{LBA: 0, BlockID: "f1d2d2f924e986ac86fdf7b36c94bcdf32beec15"}}},
+	"ReadSliceLBAChecksum":                     &ReadSliceLBAChecksumResult{SliceID: 21, Checksums: []SliceLBAChecksum{{LBA: 0, Checksum: "9a0364b9e99bb480dd25e1f0284c8555"}, {LBA: 8, Checksum: "0cc175b9c0f1b6a831c399e269772661"}}},
+	"ReassignSlice":                            &ReassignSliceResult{SliceID: 21, Primary: 9},
+	"RebalanceSlices":                          &RebalanceSlicesResult{Moves: []SliceMove{{SliceID: 21, From: 5, To: 9}}},
+	"ReconfigureEnsemble":                      &ReconfigureEnsembleResult{Ensemble: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}},
+	"RegenerateClusterUuid":                    &RegenerateClusterUuidResult{Uuid: "0d7ea1a3-6f0a-4f3b-8e84-f3a14b7e77c2"},
+	"ReleaseFreeMemory":                        &ReleaseFreeMemoryResult{Services: map[string]ReleasedMemory{"5": {ReleasedBytes: 104857600}}},
+	"SetAptSourceLines":                        &SetAptSourceLinesResult{Lines: []string{"deb http://repo.example.com/solidfire 12.5 main"}},
+	"SetClusterFullThresholds":                 &SetClusterFullThresholdsResult{BlockFullness: "stage1Happy", Fullness: "stage1Happy", MaxMetadataOverProvisionFactor: 5, MetadataFullness: "stage1Happy", SliceReserveUsedThresholdPct: 5, Stage2AwareThreshold: 3, Stage2BlockThresholdBytes: 25000000000000, Stage3BlockThresholdBytes: 30000000000000, Stage3BlockThresholdPercent: 3, Stage3MetadataThresholdPercent: 3, Stage3LowThreshold: 2, Stage4CriticalThreshold: 1, Stage4BlockThresholdBytes: 35000000000000, Stage5BlockThresholdBytes: 40000000000000, SumTotalClusterBytes: 40000000000000, SumTotalMetadataClusterBytes: 4000000000000, SumUsedClusterBytes: 12500000000000, SumUsedMetadataClusterBytes: 900000000000, Stage2MetadataThresholdBytes: 2500000000000, Stage3MetadataThresholdBytes: 3000000000000, Stage4MetadataThresholdBytes: 3500000000000, Stage5MetadataThresholdBytes: 4000000000000},
+	"SetClusterSettings":                       &SetClusterSettingsResult{Settings: map[string]string{"ClusterFullWarnPercent": "75"}},
+	"SetClusterStructure":                      &SetClusterStructureResult{AsyncHandle: 303},
+	"SetConstants":                             &SetConstantsResult{Constants: map[string]interface{}{"sliceFileLogFileCapacity": float64(5000000000)}},
+	"SetDaemonStatus":                          &SetDaemonStatusResult{Daemons: map[string]string{"snmpd": "stopped"}},
+	"SetDatabaseEntry":                         &SetDatabaseEntryResult{Path: "/cluster/custom/entry", Data: map[string]interface{}{"owner": "storage"}},
+	"SetDebugOptions":                          &SetDebugOptionsResult{Options: map[string]interface{}{"logLevel": "debug"}},
+	"SetInitiatorID":                           &SetInitiatorIDResult{Initiator: Initiator{InitiatorID: 7, InitiatorName: "iqn.1993-08.org.debian:01:host1"}},
+	"SetRepositories":                          &SetRepositoriesResult{Repositories: []Repository{{Name: "solidfire", Url: "http://mirror.example.com/solidfire"}}},
+	"SetRsyslogInfo":                           &SetRsyslogInfoResult{RemoteHosts: []RsyslogHost{{Host: "10.1.1.10", Port: 514}}},
+	"SetUpgradeNodeId":                         &SetUpgradeNodeIdResult{State: "started", NodeID: 3},
+	"StartClusterBSCheck":                      &StartClusterBSCheckResult{AsyncHandle: 302},
+	"StartGC":                                  &StartGCResult{GcInProgress: true, StartTime: "2026-10-14T09:12:00Z"},
+	"StartUpgrade":                             &StartUpgradeResult{State: "started", TargetVersion: "12.5.0.897"},
+	"StopClusterBSCheck":                       &StopClusterBSCheckResult{Stopped: true},
+	"TestClientConnectivity":                   &TestClientConnectivityResult{Clients: []ClientConnectivity{{Address: "10.10.10.100", Reachable: true, LatencyMs: 0.42}}},
+}
+
+func TestResultFixtures(t *testing.T) {
+	files, err := filepath.Glob("testdata/results/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != len(resultFixtures) {
+		t.Errorf("%d fixtures for %d result types", len(files), len(resultFixtures))
+	}
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		t.Run(name, func(t *testing.T) {
+			want, ok := resultFixtures[name]
+			if !ok {
+				t.Fatalf("no result registered for %s", file)
+			}
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Every member of the fixture must map to a field, and every field must be
+			// in the fixture.
+			res := reflect.New(reflect.TypeOf(want).Elem())
+			dec := json.NewDecoder(bytes.NewReader(data))
+			dec.DisallowUnknownFields()
+			if err := dec.Decode(res.Interface()); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(res.Interface(), want) {
+				t.Errorf("decoded %+v, want %+v", res.Elem().Interface(), reflect.ValueOf(want).Elem().Interface())
+			}
+			var members map[string]json.RawMessage
+			if err := json.Unmarshal(data, &members); err != nil {
+				t.Fatal(err)
+			}
+			v := res.Elem()
+			for i := 0; i < v.NumField(); i++ {
+				key, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+				if _, ok := members[key]; !ok {
+					t.Errorf("%s (%q) is missing from the fixture", v.Type().Field(i).Name, key)
+				}
+			}
+		})
+	}
+}
+
+func TestRawResultAccessors(t *testing.T) {
+	var res RawResult
+	if err := json.Unmarshal([]byte(`{"daemons": {"solidfire": "running", "ntpd": "running"}}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if keys := res.Keys(); len(keys) != 1 || keys[0] != "daemons" {
+		t.Errorf("Keys() = %v", keys)
+	}
+	var daemons map[string]string
+	if ok, err := res.Get("daemons", &daemons); !ok || err != nil || daemons["solidfire"] != "running" {
+		t.Errorf("Get(daemons) = %v, %v, %v", ok, err, daemons)
+	}
+	if ok, err := res.Get("missing", &daemons); ok || err != nil {
+		t.Errorf("Get(missing) = %v, %v", ok, err)
+	}
+	var count int
+	if _, err := res.Get("daemons", &count); err == nil {
+		t.Error("Get into the wrong type succeeded")
+	}
+
+	typed, err := ResultAs[GetDaemonStatusResult](&res)
+	if err != nil || typed.Daemons["ntpd"] != "running" {
+		t.Errorf("ResultAs = %+v, %v", typed, err)
+	}
+
+	var empty RawResult
+	if err := empty.Decode(&daemons); err == nil {
+		t.Error("Decode of an empty result succeeded")
+	}
+	if out, _ := json.Marshal(empty); string(out) != "{}" {
+		t.Errorf("empty result marshals as %s", out)
+	}
+}
@@ -0,0 +1,256 @@
+package sdk
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultRetryableErrorNames are the Element error names that indicate a transient
+// condition on the cluster rather than a problem with the request itself.
+var DefaultRetryableErrorNames = []string{
+	"xClusterBusy",
+	"xDBConnectionLoss",
+	"xDBOperationTimeout",
+	"xDBNoServerResponse",
+	"xNotPrimary",
+	"xSliceNotRegistered",
+}
+
+// readOnlyMethods are the methods that can safely be sent twice because they only
+// read cluster state. GetAsyncResult is left out because it deletes the result it
+// returns unless keepResult is set, GetClientCertificateSignRequest because it
+// generates a new key pair, and GetVirtualVolumeTaskUpdate because it advances a task.
+var readOnlyMethods = map[string]bool{
+	"GetAPI": true, "GetAccountByID": true, "GetAccountByName": true, "GetAccountEfficiency": true,
+	"GetActiveTlsCiphers": true, "GetAuthConfiguration": true, "GetBackupTarget": true,
+	"GetBinAssignments": true, "GetBootstrapConfig": true, "GetClusterCapacity": true,
+	"GetClusterConfig": true, "GetClusterFullThreshold": true, "GetClusterHardwareInfo": true,
+	"GetClusterInfo": true, "GetClusterInterfacePreference": true, "GetClusterMasterNodeID": true,
+	"GetClusterSettings": true, "GetClusterSshInfo": true, "GetClusterState": true,
+	"GetClusterStats": true, "GetClusterStructure": true, "GetClusterVersionInfo": true,
+	"GetCodeTimings": true, "GetCompleteStats": true, "GetConfig": true,
+	"GetConnectivityReport": true, "GetConstants": true, "GetCurrentClusterAdmin": true,
+	"GetDaemonStatus": true, "GetDatabaseEntry": true, "GetDebugOptions": true, "GetDefaultQoS": true,
+	"GetDriveConfig": true, "GetDriveHardwareInfo": true, "GetDriveStats": true,
+	"GetEncryptionAtRestInfo": true, "GetEnsemble": true, "GetFeatureStatus": true,
+	"GetFibreChannelVolumeAccessInfo": true, "GetFipsReport": true, "GetGCStatus": true,
+	"GetHardwareConfig": true, "GetHardwareInfo": true, "GetIdpAuthenticationState": true,
+	"GetImmutableValues": true, "GetIpmiConfig": true, "GetIpmiInfo": true,
+	"GetKeyProviderKmip": true, "GetKeyServerKmip": true, "GetLdapConfiguration": true,
+	"GetLimits": true, "GetLldpConfig": true, "GetLldpInfo": true, "GetLocalStats": true,
+	"GetLoginBanner": true, "GetLoginSessionInfo": true, "GetNetworkConfig": true,
+	"GetNodeActiveTlsCiphers": true, "GetNodeConstants": true, "GetNodeFipsDrivesReport": true,
+	"GetNodeHardwareInfo": true, "GetNodeSSLCertificate": true, "GetNodeStats": true,
+	"GetNodeSupportedTlsCiphers": true, "GetNtpInfo": true, "GetNvramInfo": true,
+	"GetOntapVersionInfo": true, "GetOrigin": true, "GetPendingOperation": true,
+	"GetProtectionDomainLayout": true, "GetProtectionSchemes": true, "GetQoSPolicy": true,
+	"GetRawStats": true, "GetRemoteLoggingHosts": true, "GetReport": true, "GetRsyslogInfo": true,
+	"GetSSLCertificate": true, "GetSchedule": true, "GetServiceStatus": true,
+	"GetSliceFileSizeReport": true, "GetSliceInfo": true, "GetSliceReserveUsedThresholdPct": true,
+	"GetSnapMirrorClusterIdentity": true, "GetSnmpACL": true, "GetSnmpInfo": true,
+	"GetSnmpState": true, "GetSnmpTrapInfo": true, "GetStorageContainerEfficiency": true,
+	"GetSupportedTlsCiphers": true, "GetSystemStatus": true, "GetThreadBacktraces": true,
+	"GetVasaProviderInfo": true, "GetVirtualVolumeAllocatedBitmap": true,
+	"GetVirtualVolumeCount": true, "GetVirtualVolumeUnsharedBitmap": true,
+	"GetVirtualVolumeUnsharedChunks": true, "GetVolumeAccessGroupEfficiency": true,
+	"GetVolumeAccessGroupLunAssignments": true, "GetVolumeCount": true, "GetVolumeEfficiency": true,
+	"GetVolumeSetEfficiency": true, "GetVolumeStats": true, "ListAccounts": true,
+	"ListActiveAuthSessions": true, "ListActiveNodes": true, "ListActivePairedVolumes": true,
+	"ListActiveVolumes": true, "ListAllNodes": true, "ListAptSourceLines": true,
+	"ListAsyncResults": true, "ListAuthSessionsByClusterAdmin": true,
+	"ListAuthSessionsByUsername": true, "ListBackupTargets": true, "ListBulkVolumeJobs": true,
+	"ListCloneJobs": true, "ListClusterAdmins": true, "ListClusterCapacityHistory": true,
+	"ListClusterFaults": true, "ListClusterInterfacePreferences": true, "ListClusterPairs": true,
+	"ListCurrentClusterAdmins": true, "ListDatabaseChildren": true, "ListDatabaseChildrenData": true,
+	"ListDeletedVolumes": true, "ListDriveHardware": true, "ListDriveStats": true, "ListDrives": true,
+	"ListEvents": true, "ListExpiredAuthSessions": true, "ListFibreChannelPortInfo": true,
+	"ListFibreChannelSessions": true, "ListGroupSnapshots": true, "ListISCSISessions": true,
+	"ListIdpConfigurations": true, "ListInitiators": true, "ListKeyProvidersKmip": true,
+	"ListKeyServersKmip": true, "ListNetworkInterfaces": true, "ListNodeFibreChannelPortInfo": true,
+	"ListNodeStats": true, "ListPendingActiveNodes": true, "ListPendingNodes": true,
+	"ListProtectionDomainLevels": true, "ListProtocolEndpoints": true, "ListQoSPolicies": true,
+	"ListRepositories": true, "ListSchedules": true, "ListServices": true,
+	"ListSliceBranchesByService": true, "ListSnapMirrorAggregates": true,
+	"ListSnapMirrorEndpoints": true, "ListSnapMirrorLuns": true,
+	"ListSnapMirrorNetworkInterfaces": true, "ListSnapMirrorNodes": true,
+	"ListSnapMirrorObjectAttributes": true, "ListSnapMirrorPolicies": true,
+	"ListSnapMirrorRelationships": true, "ListSnapMirrorSchedules": true,
+	"ListSnapMirrorVolumes": true, "ListSnapMirrorVservers": true, "ListSnapshots": true,
+	"ListStorageContainers": true, "ListSyncJobs": true, "ListTests": true, "ListUtilities": true,
+	"ListVirtualNetworks": true, "ListVirtualVolumeBindings": true, "ListVirtualVolumeHosts": true,
+	"ListVirtualVolumeTasks": true, "ListVirtualVolumes": true, "ListVolumeAccessGroups": true,
+	"ListVolumeQoSHistograms": true, "ListVolumeStats": true, "ListVolumeStatsByAccount": true,
+	"ListVolumeStatsByVirtualVolume": true, "ListVolumeStatsByVolume": true,
+	"ListVolumeStatsByVolumeAccessGroup": true, "ListVolumes": true, "ListVolumesForAccount": true,
+}
+
+// RetryPolicy controls how MakeSFCall retries failed calls. Zero fields take the
+// defaults listed below; a nil policy (the default for SFClient) disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. Default 4.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. Default 250ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts. Default 10s.
+	MaxBackoff time.Duration
+	// Multiplier grows the delay after each attempt. Default 2.
+	Multiplier float64
+	// Jitter randomizes each delay by up to this fraction in either direction. Default 0.2.
+	Jitter float64
+	// Deadline bounds the total time spent retrying a call. Zero leaves it to ctx.
+	Deadline time.Duration
+	// RetryableErrorNames overrides DefaultRetryableErrorNames.
+	RetryableErrorNames []string
+	// Idempotent reports whether method can be repeated after the cluster may have
+	// received it. Defaults to IsIdempotentMethod.
+	Idempotent func(method string) bool
+}
+
+// DefaultRetryPolicy returns a policy with all defaults filled in.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:         4,
+		InitialBackoff:      250 * time.Millisecond,
+		MaxBackoff:          10 * time.Second,
+		Multiplier:          2,
+		Jitter:              0.2,
+		RetryableErrorNames: DefaultRetryableErrorNames,
+		Idempotent:          IsIdempotentMethod,
+	}
+}
+
+// WithRetryPolicy enables retries on the client. Pass DefaultRetryPolicy() for the defaults.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(sfClient *SFClient) error {
+		sfClient.retryPolicy = policy
+		return nil
+	}
+}
+
+// SetRetryPolicy changes the retry policy of an existing client. A nil policy disables retries.
+func (sfClient *SFClient) SetRetryPolicy(policy *RetryPolicy) {
+	sfClient.retryPolicy = policy
+}
+
+// IsIdempotentMethod reports whether repeating method leaves the cluster in the same state
+// as running it once. Only the read-only Get and List methods qualify; every method that
+// changes the cluster, including Modify and Set methods, is treated as unsafe to repeat.
+func IsIdempotentMethod(method string) bool {
+	return readOnlyMethods[method]
+}
+
+func (p *RetryPolicy) withDefaults() RetryPolicy {
+	def := DefaultRetryPolicy()
+	out := *p
+	if out.MaxAttempts <= 0 {
+		out.MaxAttempts = def.MaxAttempts
+	}
+	if out.InitialBackoff <= 0 {
+		out.InitialBackoff = def.InitialBackoff
+	}
+	if out.MaxBackoff <= 0 {
+		out.MaxBackoff = def.MaxBackoff
+	}
+	if out.Multiplier < 1 {
+		out.Multiplier = def.Multiplier
+	}
+	if out.Jitter <= 0 || out.Jitter > 1 {
+		out.Jitter = def.Jitter
+	}
+	if out.RetryableErrorNames == nil {
+		out.RetryableErrorNames = def.RetryableErrorNames
+	}
+	if out.Idempotent == nil {
+		out.Idempotent = def.Idempotent
+	}
+	return out
+}
+
+// backoff returns the delay before retry number n (1-based).
+func (p *RetryPolicy) backoff(n int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(n-1))
+	d = math.Min(d, float64(p.MaxBackoff))
+	d *= 1 - p.Jitter + 2*p.Jitter*rand.Float64()
+	return time.Duration(d)
+}
+
+// shouldRetry classifies a failed attempt.
+func (p *RetryPolicy) shouldRetry(method string, out callOutcome) bool {
+	if out.transportErr != nil {
+		if errors.Is(out.transportErr, context.Canceled) || errors.Is(out.transportErr, context.DeadlineExceeded) {
+			return false
+		}
+		var missErr *CassetteMissError
+		if isCertificateError(out.transportErr) || errors.As(out.transportErr, &missErr) {
+			return false
+		}
+		// A request that was never written cannot have been executed, so even
+		// CreateVolume or CloneVolume may be sent again.
+		if !out.delivered {
+			return true
+		}
+		return p.Idempotent(method)
+	}
+	if !p.Idempotent(method) {
+		return false
+	}
+	if out.status >= 500 {
+		return true
+	}
+	for _, name := range p.RetryableErrorNames {
+		if out.err.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isCertificateError(err error) bool {
+	var verifyErr *tls.CertificateVerificationError
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidErr x509.CertificateInvalidError
+	var pinErr *pinMismatchError
+	return errors.As(err, &verifyErr) || errors.As(err, &unknownAuthority) ||
+		errors.As(err, &hostnameErr) || errors.As(err, &invalidErr) || errors.As(err, &pinErr)
+}
+
+// callWithRetry runs doCall under the client's retry policy, if any.
+func (sfClient *SFClient) callWithRetry(ctx context.Context, entry BaseRequest, res interface{}) callOutcome {
+	out := sfClient.doCall(ctx, entry, res)
+	if out.err == nil || sfClient.retryPolicy == nil {
+		return out
+	}
+
+	policy := sfClient.retryPolicy.withDefaults()
+	var deadline time.Time
+	if policy.Deadline > 0 {
+		deadline = time.Now().Add(policy.Deadline)
+	}
+	for attempt := 1; attempt < policy.MaxAttempts && out.err != nil; attempt++ {
+		if !policy.shouldRetry(entry.Method, out) {
+			break
+		}
+		delay := policy.backoff(attempt)
+		if !deadline.IsZero() && time.Now().Add(delay).After(deadline) {
+			break
+		}
+		log.WithContext(ctx).Warnf("%s failed (%s), retrying in %v (attempt %d of %d)", entry.Method, out.err.Detail, delay, attempt+1, policy.MaxAttempts)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return out
+		case <-timer.C:
+		}
+		out = sfClient.doCall(ctx, entry, res)
+	}
+	return out
+}
@@ -0,0 +1,137 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func fastRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+}
+
+func newRetryClient(t *testing.T, handler http.HandlerFunc) (*SFClient, *int32) {
+	t.Helper()
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	sf, err := NewSFClient(WithHTTPClient(srv.Client()), WithRetryPolicy(fastRetryPolicy()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sf.baseUrl = srv.URL
+	return sf, &calls
+}
+
+func TestRetryTransientElementError(t *testing.T) {
+	var n int32
+	sf, calls := newRetryClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&n, 1) == 1 {
+			w.Write([]byte(`{"id":1,"error":{"code":500,"name":"xClusterBusy","message":"busy"}}`))
+			return
+		}
+		w.Write([]byte(`{"id":1,"result":{"clusterVersion":"12.5"}}`))
+	})
+	res, err := sf.GetClusterVersionInfo(context.Background())
+	if err != nil {
+		t.Fatalf("expected success after retry: %v", err)
+	}
+	if res.ClusterVersion != "12.5" || atomic.LoadInt32(calls) != 2 {
+		t.Fatalf("got version %q after %d calls", res.ClusterVersion, *calls)
+	}
+}
+
+func TestRetrySkipsNonIdempotentAfterDelivery(t *testing.T) {
+	sf, calls := newRetryClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":1,"error":{"code":500,"name":"xClusterBusy","message":"busy"}}`))
+	})
+	if _, err := sf.CreateVolume(context.Background(), &CreateVolumeRequest{Name: "v"}); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Fatalf("CreateVolume sent %d times, want 1", got)
+	}
+}
+
+func TestIsIdempotentMethod(t *testing.T) {
+	for method, want := range map[string]bool{
+		"ListVolumes":            true,
+		"GetClusterInfo":         true,
+		"GetAsyncResult":         false,
+		"ModifyVolume":           false,
+		"SetClusterConfig":       false,
+		"TestPing":               false,
+		"EnableEncryptionAtRest": false,
+		"DisableSnmp":            false,
+		"CreateVolume":           false,
+	} {
+		if got := IsIdempotentMethod(method); got != want {
+			t.Errorf("IsIdempotentMethod(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
+
+func TestRetryPermanentError(t *testing.T) {
+	sf, calls := newRetryClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":1,"error":{"code":500,"name":"xUnknownAccount","message":"no"}}`))
+	})
+	if _, err := sf.GetAccountByName(context.Background(), &GetAccountByNameRequest{Username: "x"}); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Fatalf("permanent error retried: %d calls", got)
+	}
+}
+
+func TestRetryUndeliveredNonIdempotent(t *testing.T) {
+	var dials int32
+	sf, err := NewSFClient(WithRetryPolicy(fastRetryPolicy()), WithHTTPClient(&http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&dials, 1)
+			return nil, &dialError{}
+		}),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sf.baseUrl = "http://127.0.0.1:1/json-rpc/12.5"
+	if _, sdkErr := sf.CreateVolume(context.Background(), &CreateVolumeRequest{Name: "v"}); sdkErr == nil {
+		t.Fatal("expected error")
+	}
+	if got := atomic.LoadInt32(&dials); got != 3 {
+		t.Fatalf("undelivered CreateVolume attempted %d times, want 3", got)
+	}
+}
+
+func TestRetryHonorsContext(t *testing.T) {
+	sf, _ := newRetryClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	sf.retryPolicy = &RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := sf.ListVolumes(ctx, &ListVolumesRequest{})
+	if err == nil || !strings.Contains(err.Code, "503") {
+		t.Fatalf("expected 503 error, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Fatal("retry loop ignored context cancellation")
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+// dialError stands in for a refused dial: the transport fails before writing the request.
+type dialError struct{}
+
+func (*dialError) Error() string { return "dial tcp 127.0.0.1:1: connect: connection refused" }
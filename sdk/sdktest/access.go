@@ -0,0 +1,603 @@
+package sdktest
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"CreateVolumeAccessGroup":               (*Server).createVolumeAccessGroup,
+		"ListVolumeAccessGroups":                (*Server).listVolumeAccessGroups,
+		"ModifyVolumeAccessGroup":               (*Server).modifyVolumeAccessGroup,
+		"DeleteVolumeAccessGroup":               (*Server).deleteVolumeAccessGroup,
+		"AddVolumesToVolumeAccessGroup":         (*Server).addVolumesToVolumeAccessGroup,
+		"RemoveVolumesFromVolumeAccessGroup":    (*Server).removeVolumesFromVolumeAccessGroup,
+		"AddInitiatorsToVolumeAccessGroup":      (*Server).addInitiatorsToVolumeAccessGroup,
+		"RemoveInitiatorsFromVolumeAccessGroup": (*Server).removeInitiatorsFromVolumeAccessGroup,
+		"GetVolumeAccessGroupLunAssignments":    (*Server).getVolumeAccessGroupLunAssignments,
+		"ModifyVolumeAccessGroupLunAssignments": (*Server).modifyVolumeAccessGroupLunAssignments,
+		"CreateInitiators":                      (*Server).createInitiators,
+		"ListInitiators":                        (*Server).listInitiators,
+		"ModifyInitiators":                      (*Server).modifyInitiators,
+		"DeleteInitiators":                      (*Server).deleteInitiators,
+	})
+}
+
+func (s *Server) vag(id int64) (*sdk.VolumeAccessGroup, error) {
+	vag, ok := s.vags[id]
+	if !ok {
+		return nil, Errorf("xVolumeAccessGroupIDDoesNotExist", "Volume access group %d does not exist.", id)
+	}
+	return vag, nil
+}
+
+// vagView returns a copy of vag with its initiator IDs filled in.
+func (s *Server) vagView(vag *sdk.VolumeAccessGroup) sdk.VolumeAccessGroup {
+	out := *vag
+	out.Volumes = slices.Clone(vag.Volumes)
+	out.DeletedVolumes = slices.Clone(vag.DeletedVolumes)
+	out.Initiators = slices.Clone(vag.Initiators)
+	out.InitiatorIDs = []int64{}
+	for _, name := range vag.Initiators {
+		if init := s.initiatorByName(name); init != nil {
+			out.InitiatorIDs = append(out.InitiatorIDs, init.InitiatorID)
+		}
+	}
+	return out
+}
+
+func (s *Server) validVAGName(name string) error {
+	if n := int64(len(name)); n < s.Limits.VolumeAccessGroupNameLengthMin || n > s.Limits.VolumeAccessGroupNameLengthMax {
+		return Errorf("xInvalidParameter", "Volume access group name must be %d to %d characters",
+			s.Limits.VolumeAccessGroupNameLengthMin, s.Limits.VolumeAccessGroupNameLengthMax)
+	}
+	return nil
+}
+
+// addVolumes adds volumes to vag, assigning each the lowest free LUN.
+func (s *Server) addVolumes(vag *sdk.VolumeAccessGroup, ids []int64) error {
+	for _, id := range ids {
+		if _, err := s.volume(id); err != nil {
+			return err
+		}
+		if slices.Contains(vag.Volumes, id) {
+			continue
+		}
+		if int64(len(vag.Volumes)) >= s.Limits.VolumesPerVolumeAccessGroupCountMax {
+			return Errorf("xExceededLimit", "Volume access group %d has the maximum number of volumes", vag.VolumeAccessGroupID)
+		}
+		var groups int64
+		for _, other := range s.vags {
+			if slices.Contains(other.Volumes, id) {
+				groups++
+			}
+		}
+		if groups >= s.Limits.VolumeAccessGroupsPerVolumeCountMax {
+			return Errorf("xExceededLimit", "Volume %d is in the maximum number of volume access groups", id)
+		}
+		vag.Volumes = append(vag.Volumes, id)
+		luns := s.luns[vag.VolumeAccessGroupID]
+		if _, ok := luns[id]; !ok {
+			luns[id] = lowestFreeLUN(luns)
+		}
+	}
+	return nil
+}
+
+func lowestFreeLUN(luns map[int64]int64) int64 {
+	used := map[int64]bool{}
+	for _, lun := range luns {
+		used[lun] = true
+	}
+	var lun int64
+	for used[lun] {
+		lun++
+	}
+	return lun
+}
+
+func (s *Server) removeVolumes(vag *sdk.VolumeAccessGroup, ids []int64) error {
+	for _, id := range ids {
+		if !removeID(&vag.Volumes, id) && !removeID(&vag.DeletedVolumes, id) {
+			return Errorf("xVolumeIDDoesNotExist", "Volume %d is not in volume access group %d.", id, vag.VolumeAccessGroupID)
+		}
+		delete(s.luns[vag.VolumeAccessGroupID], id)
+	}
+	return nil
+}
+
+// addInitiators adds initiator names to vag, creating initiator objects as the cluster does.
+func (s *Server) addInitiators(vag *sdk.VolumeAccessGroup, names []string) error {
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if slices.Contains(vag.Initiators, name) {
+			continue
+		}
+		if int64(len(vag.Initiators)) >= s.Limits.InitiatorsPerVolumeAccessGroupCountMax {
+			return Errorf("xExceededLimit", "Volume access group %d has the maximum number of initiators", vag.VolumeAccessGroupID)
+		}
+		if n := int64(len(s.initiatorGroups(name))); n >= s.Limits.VolumeAccessGroupsPerInitiatorCountMax {
+			return Errorf("xExceededLimit", "Initiator %s is in the maximum number of volume access groups", name)
+		}
+		if s.initiatorByName(name) == nil {
+			if _, err := s.newInitiator(sdk.CreateInitiator{Name: name}); err != nil {
+				return err
+			}
+		}
+		vag.Initiators = append(vag.Initiators, name)
+	}
+	return nil
+}
+
+func (s *Server) removeInitiators(vag *sdk.VolumeAccessGroup, names []string, deleteOrphans bool) error {
+	for _, name := range names {
+		name = strings.ToLower(name)
+		i := slices.Index(vag.Initiators, name)
+		if i < 0 {
+			return Errorf("xInitiatorDoesNotExist", "Initiator %s is not in volume access group %d.", name, vag.VolumeAccessGroupID)
+		}
+		vag.Initiators = slices.Delete(vag.Initiators, i, i+1)
+		if init := s.initiatorByName(name); deleteOrphans && init != nil && len(s.initiatorGroups(name)) == 0 {
+			delete(s.initiators, init.InitiatorID)
+		}
+	}
+	return nil
+}
+
+func (s *Server) createVolumeAccessGroup(params json.RawMessage) (interface{}, error) {
+	var req sdk.CreateVolumeAccessGroupRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validVAGName(req.Name); err != nil {
+		return nil, err
+	}
+	if int64(len(s.vags)) >= s.Limits.VolumeAccessGroupCountMax {
+		return nil, Errorf("xExceededLimit", "The maximum number of volume access groups has been reached")
+	}
+	id := s.newID("volumeAccessGroup")
+	vag := &sdk.VolumeAccessGroup{
+		VolumeAccessGroupID: id,
+		Name:                req.Name,
+		Initiators:          []string{},
+		Volumes:             []int64{},
+		DeletedVolumes:      []int64{},
+		Attributes:          emptyAttributes(req.Attributes),
+	}
+	s.vags[id] = vag
+	s.luns[id] = map[int64]int64{}
+	err := s.addVolumes(vag, req.Volumes)
+	if err == nil {
+		err = s.addInitiators(vag, req.Initiators)
+	}
+	if err != nil {
+		delete(s.vags, id)
+		delete(s.luns, id)
+		return nil, err
+	}
+	return sdk.CreateVolumeAccessGroupResult{VolumeAccessGroupID: id, VolumeAccessGroup: s.vagView(vag)}, nil
+}
+
+func (s *Server) listVolumeAccessGroups(params json.RawMessage) (interface{}, error) {
+	var req sdk.ListVolumeAccessGroupsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	res := sdk.ListVolumeAccessGroupsResult{VolumeAccessGroups: []sdk.VolumeAccessGroup{}}
+	if len(req.VolumeAccessGroups) > 0 {
+		for _, id := range req.VolumeAccessGroups {
+			if vag, ok := s.vags[id]; ok {
+				res.VolumeAccessGroups = append(res.VolumeAccessGroups, s.vagView(vag))
+			} else {
+				res.VolumeAccessGroupsNotFound = append(res.VolumeAccessGroupsNotFound, id)
+			}
+		}
+		return res, nil
+	}
+	for _, id := range sortedKeys(s.vags) {
+		if id < req.StartVolumeAccessGroupID {
+			continue
+		}
+		if req.Limit > 0 && int64(len(res.VolumeAccessGroups)) >= req.Limit {
+			break
+		}
+		res.VolumeAccessGroups = append(res.VolumeAccessGroups, s.vagView(s.vags[id]))
+	}
+	return res, nil
+}
+
+// modifyVolumeAccessGroup replaces the volume and initiator lists when they are given.
+func (s *Server) modifyVolumeAccessGroup(params json.RawMessage) (interface{}, error) {
+	var req sdk.ModifyVolumeAccessGroupRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	vag, err := s.vag(req.VolumeAccessGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != "" {
+		if err := s.validVAGName(req.Name); err != nil {
+			return nil, err
+		}
+		vag.Name = req.Name
+	}
+	if req.Volumes != nil {
+		var drop []int64
+		for _, id := range vag.Volumes {
+			if !slices.Contains(req.Volumes, id) {
+				drop = append(drop, id)
+			}
+		}
+		if err := s.removeVolumes(vag, drop); err != nil {
+			return nil, err
+		}
+		if err := s.addVolumes(vag, req.Volumes); err != nil {
+			return nil, err
+		}
+	}
+	if req.Initiators != nil {
+		want := make([]string, len(req.Initiators))
+		for i, name := range req.Initiators {
+			want[i] = strings.ToLower(name)
+		}
+		var drop []string
+		for _, name := range vag.Initiators {
+			if !slices.Contains(want, name) {
+				drop = append(drop, name)
+			}
+		}
+		if err := s.removeInitiators(vag, drop, req.DeleteOrphanInitiators); err != nil {
+			return nil, err
+		}
+		if err := s.addInitiators(vag, want); err != nil {
+			return nil, err
+		}
+	}
+	if req.Attributes != nil {
+		vag.Attributes = req.Attributes
+	}
+	return sdk.ModifyVolumeAccessGroupResult{VolumeAccessGroup: s.vagView(vag)}, nil
+}
+
+func (s *Server) deleteVolumeAccessGroup(params json.RawMessage) (interface{}, error) {
+	var req sdk.DeleteVolumeAccessGroupRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	vag, err := s.vag(req.VolumeAccessGroupID)
+	if err != nil {
+		return nil, err
+	}
+	names := vag.Initiators
+	delete(s.vags, vag.VolumeAccessGroupID)
+	delete(s.luns, vag.VolumeAccessGroupID)
+	if req.DeleteOrphanInitiators {
+		for _, name := range names {
+			if init := s.initiatorByName(name); init != nil && len(s.initiatorGroups(name)) == 0 {
+				delete(s.initiators, init.InitiatorID)
+			}
+		}
+	}
+	return sdk.DeleteVolumeAccessGroupResult{}, nil
+}
+
+func (s *Server) addVolumesToVolumeAccessGroup(params json.RawMessage) (interface{}, error) {
+	var req sdk.AddVolumesToVolumeAccessGroupRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	vag, err := s.vag(req.VolumeAccessGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.addVolumes(vag, req.Volumes); err != nil {
+		return nil, err
+	}
+	return sdk.ModifyVolumeAccessGroupResult{VolumeAccessGroup: s.vagView(vag)}, nil
+}
+
+func (s *Server) removeVolumesFromVolumeAccessGroup(params json.RawMessage) (interface{}, error) {
+	var req sdk.RemoveVolumesFromVolumeAccessGroupRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	vag, err := s.vag(req.VolumeAccessGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.removeVolumes(vag, req.Volumes); err != nil {
+		return nil, err
+	}
+	return sdk.ModifyVolumeAccessGroupResult{VolumeAccessGroup: s.vagView(vag)}, nil
+}
+
+func (s *Server) addInitiatorsToVolumeAccessGroup(params json.RawMessage) (interface{}, error) {
+	var req sdk.AddInitiatorsToVolumeAccessGroupRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	vag, err := s.vag(req.VolumeAccessGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.addInitiators(vag, req.Initiators); err != nil {
+		return nil, err
+	}
+	return sdk.ModifyVolumeAccessGroupResult{VolumeAccessGroup: s.vagView(vag)}, nil
+}
+
+func (s *Server) removeInitiatorsFromVolumeAccessGroup(params json.RawMessage) (interface{}, error) {
+	var req sdk.RemoveInitiatorsFromVolumeAccessGroupRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	vag, err := s.vag(req.VolumeAccessGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.removeInitiators(vag, req.Initiators, req.DeleteOrphanInitiators); err != nil {
+		return nil, err
+	}
+	return sdk.ModifyVolumeAccessGroupResult{VolumeAccessGroup: s.vagView(vag)}, nil
+}
+
+func (s *Server) lunAssignments(vag *sdk.VolumeAccessGroup) sdk.VolumeAccessGroupLunAssignments {
+	out := sdk.VolumeAccessGroupLunAssignments{
+		VolumeAccessGroupID:   vag.VolumeAccessGroupID,
+		LunAssignments:        []sdk.LunAssignment{},
+		DeletedLunAssignments: []sdk.LunAssignment{},
+	}
+	luns := s.luns[vag.VolumeAccessGroupID]
+	for _, id := range sortedKeys(luns) {
+		a := sdk.LunAssignment{VolumeID: id, Lun: luns[id]}
+		if slices.Contains(vag.DeletedVolumes, id) {
+			out.DeletedLunAssignments = append(out.DeletedLunAssignments, a)
+		} else {
+			out.LunAssignments = append(out.LunAssignments, a)
+		}
+	}
+	return out
+}
+
+func (s *Server) getVolumeAccessGroupLunAssignments(params json.RawMessage) (interface{}, error) {
+	var req sdk.GetVolumeAccessGroupLunAssignmentsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	vag, err := s.vag(req.VolumeAccessGroupID)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.GetVolumeAccessGroupLunAssignmentsResult{VolumeAccessGroupLunAssignments: s.lunAssignments(vag)}, nil
+}
+
+func (s *Server) modifyVolumeAccessGroupLunAssignments(params json.RawMessage) (interface{}, error) {
+	var req sdk.ModifyVolumeAccessGroupLunAssignmentsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	vag, err := s.vag(req.VolumeAccessGroupID)
+	if err != nil {
+		return nil, err
+	}
+	luns := s.luns[vag.VolumeAccessGroupID]
+	next := make(map[int64]int64, len(luns))
+	for id, lun := range luns {
+		next[id] = lun
+	}
+	for _, a := range req.LunAssignments {
+		if _, ok := luns[a.VolumeID]; !ok {
+			return nil, Errorf("xVolumeIDDoesNotExist", "Volume %d is not in volume access group %d.", a.VolumeID, vag.VolumeAccessGroupID)
+		}
+		if a.Lun < 0 || a.Lun > s.Limits.VolumeAccessGroupLunMax {
+			return nil, Errorf("xInvalidParameter", "LUN %d is out of range", a.Lun)
+		}
+		next[a.VolumeID] = a.Lun
+	}
+	seen := map[int64]bool{}
+	for _, lun := range next {
+		if seen[lun] {
+			return nil, Errorf("xInvalidParameter", "LUN %d is assigned to more than one volume", lun)
+		}
+		seen[lun] = true
+	}
+	s.luns[vag.VolumeAccessGroupID] = next
+	return sdk.ModifyVolumeAccessGroupLunAssignmentsResult{VolumeAccessGroupLunAssignments: s.lunAssignments(vag)}, nil
+}
+
+func (s *Server) initiatorByName(name string) *sdk.Initiator {
+	for _, init := range s.initiators {
+		if init.InitiatorName == name {
+			return init
+		}
+	}
+	return nil
+}
+
+// initiatorGroups returns the IDs of the access groups that list name.
+func (s *Server) initiatorGroups(name string) []int64 {
+	out := []int64{}
+	for _, id := range sortedKeys(s.vags) {
+		if slices.Contains(s.vags[id].Initiators, name) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func (s *Server) initiatorView(init *sdk.Initiator) sdk.Initiator {
+	out := *init
+	out.VolumeAccessGroups = s.initiatorGroups(init.InitiatorName)
+	return out
+}
+
+func (s *Server) initiator(id int64) (*sdk.Initiator, error) {
+	init, ok := s.initiators[id]
+	if !ok {
+		return nil, Errorf("xInitiatorDoesNotExist", "Initiator %d does not exist.", id)
+	}
+	return init, nil
+}
+
+func (s *Server) newInitiator(req sdk.CreateInitiator) (*sdk.Initiator, error) {
+	name := strings.ToLower(req.Name)
+	if name == "" || int64(len(name)) > s.Limits.InitiatorNameLengthMax {
+		return nil, Errorf("xInvalidParameter", "Initiator name must be 1 to %d characters", s.Limits.InitiatorNameLengthMax)
+	}
+	if s.initiatorByName(name) != nil {
+		return nil, Errorf("xInitiatorExists", "Initiator %s already exists.", name)
+	}
+	if int64(len(s.initiators)) >= s.Limits.InitiatorCountMax {
+		return nil, Errorf("xExceededLimit", "The maximum number of initiators has been reached")
+	}
+	init := &sdk.Initiator{
+		InitiatorID:     s.newID("initiator"),
+		InitiatorName:   name,
+		Alias:           req.Alias,
+		Attributes:      emptyAttributes(req.Attributes),
+		RequireChap:     req.RequireChap,
+		ChapUsername:    req.ChapUsername,
+		InitiatorSecret: req.InitiatorSecret,
+		TargetSecret:    req.TargetSecret,
+	}
+	if init.RequireChap {
+		if init.ChapUsername == "" {
+			init.ChapUsername = name
+		}
+		if init.InitiatorSecret == "" {
+			init.InitiatorSecret = newSecret()
+		}
+		if init.TargetSecret == "" {
+			init.TargetSecret = newSecret()
+		}
+	}
+	s.initiators[init.InitiatorID] = init
+	return init, nil
+}
+
+func (s *Server) createInitiators(params json.RawMessage) (interface{}, error) {
+	var req sdk.CreateInitiatorsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	res := sdk.CreateInitiatorsResult{Initiators: []sdk.Initiator{}}
+	var created []*sdk.Initiator
+	for _, ci := range req.Initiators {
+		init, err := s.newInitiator(ci)
+		if err == nil && ci.VolumeAccessGroupID != 0 {
+			var vag *sdk.VolumeAccessGroup
+			if vag, err = s.vag(ci.VolumeAccessGroupID); err == nil {
+				err = s.addInitiators(vag, []string{init.InitiatorName})
+			}
+			if err != nil {
+				delete(s.initiators, init.InitiatorID)
+			}
+		}
+		if err != nil {
+			for _, c := range created {
+				for _, vag := range s.vags {
+					vag.Initiators = slices.DeleteFunc(vag.Initiators, func(n string) bool { return n == c.InitiatorName })
+				}
+				delete(s.initiators, c.InitiatorID)
+			}
+			return nil, err
+		}
+		created = append(created, init)
+	}
+	for _, init := range created {
+		res.Initiators = append(res.Initiators, s.initiatorView(init))
+	}
+	return res, nil
+}
+
+func (s *Server) listInitiators(params json.RawMessage) (interface{}, error) {
+	var req sdk.ListInitiatorsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	res := sdk.ListInitiatorsResult{Initiators: []sdk.Initiator{}}
+	for _, id := range sortedKeys(s.initiators) {
+		if id < req.StartInitiatorID || (len(req.Initiators) > 0 && !slices.Contains(req.Initiators, id)) {
+			continue
+		}
+		if req.Limit > 0 && int64(len(res.Initiators)) >= req.Limit {
+			break
+		}
+		res.Initiators = append(res.Initiators, s.initiatorView(s.initiators[id]))
+	}
+	return res, nil
+}
+
+func (s *Server) modifyInitiators(params json.RawMessage) (interface{}, error) {
+	var req sdk.ModifyInitiatorsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	for _, mi := range req.Initiators {
+		if _, err := s.initiator(mi.InitiatorID); err != nil {
+			return nil, err
+		}
+		if mi.VolumeAccessGroupID != 0 {
+			if _, err := s.vag(mi.VolumeAccessGroupID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	res := sdk.ModifyInitiatorsResult{Initiators: []sdk.Initiator{}}
+	for _, mi := range req.Initiators {
+		init := s.initiators[mi.InitiatorID]
+		if mi.Alias != "" {
+			init.Alias = mi.Alias
+		}
+		if mi.Attributes != nil {
+			init.Attributes = mi.Attributes
+		}
+		if mi.RequireChap {
+			init.RequireChap = true
+		}
+		if mi.ChapUsername != "" {
+			init.ChapUsername = mi.ChapUsername
+		}
+		if mi.InitiatorSecret != "" {
+			init.InitiatorSecret = mi.InitiatorSecret
+		}
+		if mi.TargetSecret != "" {
+			init.TargetSecret = mi.TargetSecret
+		}
+		if mi.VolumeAccessGroupID != 0 {
+			for _, vag := range s.vags {
+				vag.Initiators = slices.DeleteFunc(vag.Initiators, func(n string) bool { return n == init.InitiatorName })
+			}
+			if err := s.addInitiators(s.vags[mi.VolumeAccessGroupID], []string{init.InitiatorName}); err != nil {
+				return nil, err
+			}
+		}
+		res.Initiators = append(res.Initiators, s.initiatorView(init))
+	}
+	return res, nil
+}
+
+func (s *Server) deleteInitiators(params json.RawMessage) (interface{}, error) {
+	var req sdk.DeleteInitiatorsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	for _, id := range req.Initiators {
+		if _, err := s.initiator(id); err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range req.Initiators {
+		name := s.initiators[id].InitiatorName
+		for _, vag := range s.vags {
+			vag.Initiators = slices.DeleteFunc(vag.Initiators, func(n string) bool { return n == name })
+		}
+		delete(s.initiators, id)
+	}
+	return sdk.DeleteInitiatorsResult{}, nil
+}
@@ -0,0 +1,196 @@
+package sdktest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"maps"
+	"slices"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"AddAccount":       (*Server).addAccount,
+		"GetAccountByName": (*Server).getAccountByName,
+		"GetAccountByID":   (*Server).getAccountByID,
+		"ListAccounts":     (*Server).listAccounts,
+		"ModifyAccount":    (*Server).modifyAccount,
+		"RemoveAccount":    (*Server).removeAccount,
+	})
+}
+
+const secretAlphabet = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+func newSecret() string {
+	b := make([]byte, 12)
+	rand.Read(b)
+	for i := range b {
+		b[i] = secretAlphabet[int(b[i])%len(secretAlphabet)]
+	}
+	return string(b)
+}
+
+// accountView returns a copy of a with its volume list filled in.
+func (s *Server) accountView(a *sdk.Account) sdk.Account {
+	out := *a
+	out.Volumes = []int64{}
+	for _, id := range sortedKeys(s.volumes) {
+		if s.volumes[id].AccountID == a.AccountID {
+			out.Volumes = append(out.Volumes, id)
+		}
+	}
+	return out
+}
+
+func (s *Server) account(id int64) (*sdk.Account, error) {
+	a, ok := s.accounts[id]
+	if !ok {
+		return nil, Errorf("xUnknownAccount", "Unknown accountID %d", id)
+	}
+	return a, nil
+}
+
+func (s *Server) validSecret(secret string) bool {
+	return int64(len(secret)) >= s.Limits.SecretLengthMin && int64(len(secret)) <= s.Limits.SecretLengthMax
+}
+
+func (s *Server) addAccount(params json.RawMessage) (interface{}, error) {
+	var req sdk.AddAccountRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if n := int64(len(req.Username)); n < s.Limits.AccountNameLengthMin || n > s.Limits.AccountNameLengthMax {
+		return nil, Errorf("xInvalidParameter", "Invalid username length %d", n)
+	}
+	for _, a := range s.accounts {
+		if a.Username == req.Username {
+			return nil, Errorf("xDuplicateUsername", "Username %s already exists", req.Username)
+		}
+	}
+	if int64(len(s.accounts)) >= s.Limits.AccountCountMax {
+		return nil, Errorf("xMaxAccountsExceeded", "The maximum number of accounts has been reached")
+	}
+	for _, secret := range []string{req.InitiatorSecret, req.TargetSecret} {
+		if secret != "" && !s.validSecret(secret) {
+			return nil, Errorf("xInvalidParameter", "CHAP secrets must be %d to %d characters", s.Limits.SecretLengthMin, s.Limits.SecretLengthMax)
+		}
+	}
+	a := &sdk.Account{
+		AccountID:       s.newID("account"),
+		Username:        req.Username,
+		Status:          "active",
+		InitiatorSecret: req.InitiatorSecret,
+		TargetSecret:    req.TargetSecret,
+		Attributes:      emptyAttributes(req.Attributes),
+	}
+	if a.InitiatorSecret == "" {
+		a.InitiatorSecret = newSecret()
+	}
+	if a.TargetSecret == "" {
+		a.TargetSecret = newSecret()
+	}
+	s.accounts[a.AccountID] = a
+	return sdk.AddAccountResult{AccountID: a.AccountID, Account: s.accountView(a)}, nil
+}
+
+func (s *Server) getAccountByName(params json.RawMessage) (interface{}, error) {
+	var req sdk.GetAccountByNameRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	for _, a := range s.accounts {
+		if a.Username == req.Username {
+			return sdk.GetAccountResult{Account: s.accountView(a)}, nil
+		}
+	}
+	return nil, Errorf("xUnknownAccount", "Unknown account name %s", req.Username)
+}
+
+func (s *Server) getAccountByID(params json.RawMessage) (interface{}, error) {
+	var req sdk.GetAccountByIDRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	a, err := s.account(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.GetAccountResult{Account: s.accountView(a)}, nil
+}
+
+func (s *Server) listAccounts(params json.RawMessage) (interface{}, error) {
+	var req sdk.ListAccountsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	out := []sdk.Account{}
+	for _, id := range sortedKeys(s.accounts) {
+		if id < req.StartAccountID {
+			continue
+		}
+		if req.Limit > 0 && int64(len(out)) >= req.Limit {
+			break
+		}
+		out = append(out, s.accountView(s.accounts[id]))
+	}
+	return sdk.ListAccountsResult{Accounts: out}, nil
+}
+
+func (s *Server) modifyAccount(params json.RawMessage) (interface{}, error) {
+	var req sdk.ModifyAccountRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	a, err := s.account(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Username != "" && req.Username != a.Username {
+		for _, other := range s.accounts {
+			if other.Username == req.Username {
+				return nil, Errorf("xDuplicateUsername", "Username %s already exists", req.Username)
+			}
+		}
+		a.Username = req.Username
+	}
+	for _, secret := range []string{req.InitiatorSecret, req.TargetSecret} {
+		if secret != "" && !s.validSecret(secret) {
+			return nil, Errorf("xInvalidParameter", "CHAP secrets must be %d to %d characters", s.Limits.SecretLengthMin, s.Limits.SecretLengthMax)
+		}
+	}
+	if req.Status != "" {
+		a.Status = req.Status
+	}
+	if req.InitiatorSecret != "" {
+		a.InitiatorSecret = req.InitiatorSecret
+	}
+	if req.TargetSecret != "" {
+		a.TargetSecret = req.TargetSecret
+	}
+	if req.Attributes != nil {
+		a.Attributes = req.Attributes
+	}
+	return sdk.ModifyAccountResult{Account: s.accountView(a)}, nil
+}
+
+func (s *Server) removeAccount(params json.RawMessage) (interface{}, error) {
+	var req sdk.RemoveAccountRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if _, err := s.account(req.AccountID); err != nil {
+		return nil, err
+	}
+	for _, v := range s.volumes {
+		if v.AccountID == req.AccountID {
+			return nil, Errorf("xAccountHasVolumes", "Account %d still has volumes; delete and purge them first", req.AccountID)
+		}
+	}
+	delete(s.accounts, req.AccountID)
+	return sdk.RemoveAccountResult{}, nil
+}
+
+func sortedKeys[V any](m map[int64]V) []int64 {
+	return slices.Sorted(maps.Keys(m))
+}
@@ -0,0 +1,167 @@
+package sdktest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"CreateAuthSession":    (*Server).createAuthSession,
+		"CreateIdpAuthSession": (*Server).createIdpAuthSession,
+		"UpdateAuthSession":    (*Server).updateAuthSession,
+		"DeleteAuthSession":    (*Server).deleteAuthSession,
+		"ListAuthSessions":     (*Server).listAuthSessions,
+	})
+}
+
+func newSessionID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// useSession reports whether token names a live session and extends its idle timeout.
+func (s *Server) useSession(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.authSessions[token]
+	if !ok {
+		return false
+	}
+	now := s.now()
+	if final, _ := time.Parse(time.RFC3339, session.FinalTimeout); !now.Before(final) {
+		delete(s.authSessions, token)
+		return false
+	}
+	if last, _ := time.Parse(time.RFC3339, session.LastAccessTimeout); !now.Before(last) {
+		delete(s.authSessions, token)
+		return false
+	}
+	s.touchSession(session, now)
+	return true
+}
+
+func (s *Server) touchSession(session *sdk.AuthSessionInfo, now time.Time) {
+	last := now.Add(s.SessionIdleTimeout)
+	if final, _ := time.Parse(time.RFC3339, session.FinalTimeout); last.After(final) {
+		last = final
+	}
+	session.LastAccessTimeout = last.Format(time.RFC3339)
+}
+
+// sessionDuration parses desiredExpirationDuration ("HH:MM:SS"), capped at SessionFinalTimeout.
+func (s *Server) sessionDuration(desired string) (time.Duration, error) {
+	if desired == "" {
+		return s.SessionFinalTimeout, nil
+	}
+	d, err := parseRetention(desired)
+	if err != nil {
+		return 0, err
+	}
+	return min(d, s.SessionFinalTimeout), nil
+}
+
+func (s *Server) newAuthSession(username string, adminIDs []int64, desired string, method sdk.AuthMethod) (*sdk.AuthSessionInfo, error) {
+	if username == "" {
+		return nil, Errorf("xInvalidParameter", "username is required")
+	}
+	d, err := s.sessionDuration(desired)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	session := &sdk.AuthSessionInfo{
+		ClusterAdminIDs:     adminIDs,
+		Username:            username,
+		SessionID:           newSessionID(),
+		SessionCreationTime: now.Format(time.RFC3339),
+		FinalTimeout:        now.Add(d).Format(time.RFC3339),
+		AccessGroupList:     []string{"administrator"},
+		AuthMethod:          method,
+	}
+	s.touchSession(session, now)
+	s.authSessions[session.SessionID] = session
+	return session, nil
+}
+
+func (s *Server) createAuthSession(params json.RawMessage) (interface{}, error) {
+	var req sdk.CreateAuthSessionRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if len(req.ClusterAdminIDs) == 0 {
+		return nil, Errorf("xInvalidParameter", "clusterAdminIDs must not be empty")
+	}
+	session, err := s.newAuthSession(req.Username, req.ClusterAdminIDs, req.DesiredExpirationDuration, "Cluster")
+	if err != nil {
+		return nil, err
+	}
+	return sdk.CreateAuthSessionResult{Session: *session}, nil
+}
+
+func (s *Server) createIdpAuthSession(params json.RawMessage) (interface{}, error) {
+	var req sdk.CreateIdpAuthSessionRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if len(req.SamlAttributeStatements) == 0 {
+		return nil, Errorf("xInvalidParameter", "samlAttributeStatements must not be empty")
+	}
+	session, err := s.newAuthSession(req.Username, []int64{1}, req.DesiredExpirationDuration, "Idp")
+	if err != nil {
+		return nil, err
+	}
+	return sdk.CreateAuthSessionResult{Session: *session}, nil
+}
+
+func (s *Server) authSession(id string) (*sdk.AuthSessionInfo, error) {
+	session, ok := s.authSessions[id]
+	if !ok {
+		return nil, Errorf("xAuthSessionDoesNotExist", "Auth session %s does not exist.", id)
+	}
+	return session, nil
+}
+
+func (s *Server) updateAuthSession(params json.RawMessage) (interface{}, error) {
+	var req sdk.UpdateAuthSessionRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	session, err := s.authSession(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	s.touchSession(session, s.now())
+	return sdk.UpdateAuthSessionResult{Session: *session}, nil
+}
+
+func (s *Server) deleteAuthSession(params json.RawMessage) (interface{}, error) {
+	var req sdk.DeleteAuthSessionRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	session, err := s.authSession(req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	delete(s.authSessions, req.SessionID)
+	return sdk.DeleteAuthSessionResult{Session: *session}, nil
+}
+
+func (s *Server) listAuthSessions(params json.RawMessage) (interface{}, error) {
+	out := []sdk.AuthSessionInfo{}
+	for _, session := range s.authSessions {
+		out = append(out, *session)
+	}
+	slices.SortFunc(out, func(a, b sdk.AuthSessionInfo) int {
+		return strings.Compare(a.SessionCreationTime+a.SessionID, b.SessionCreationTime+b.SessionID)
+	})
+	return sdk.ListAuthSessionsResult{Sessions: out}, nil
+}
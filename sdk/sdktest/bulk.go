@@ -0,0 +1,310 @@
+package sdktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"StartBulkVolumeRead":  (*Server).startBulkVolumeRead,
+		"StartBulkVolumeWrite": (*Server).startBulkVolumeWrite,
+		"ListBulkVolumeJobs":   (*Server).listBulkVolumeJobs,
+		"GetReport":            (*Server).getReport,
+		"GetVolumeStats":       (*Server).getVolumeStats,
+		"ListServices":         (*Server).listServices,
+	})
+}
+
+// bulkJob is the bulk volume side of an async job.
+type bulkJob struct {
+	id         int64
+	typ        string
+	volumeID   int64
+	snapshotID int64
+	nodeID     int64
+	format     string
+	script     string
+	key        string
+	attributes interface{}
+}
+
+// s3Params are the scriptParameters of the bv_internal.py script, under "write" for
+// a bulk read to S3 and under "read" for a bulk write from S3.
+type s3Params struct {
+	Endpoint        string `json:"endpoint"`
+	Hostname        string `json:"hostname"`
+	Bucket          string `json:"bucket"`
+	Prefix          string `json:"prefix"`
+	Format          string `json:"format"`
+	AccessKeyID     string `json:"awsAccessKeyID"`
+	SecretAccessKey string `json:"awsSecretAccessKey"`
+}
+
+// SetVolumeData sets the contents of a volume, which bulk volume reads copy to S3
+// and snapshots capture.
+func (s *Server) SetVolumeData(volumeID int64, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[volumeID] = bytes.Clone(data)
+}
+
+// VolumeData returns the contents of a volume, as set by SetVolumeData or a bulk
+// volume write.
+func (s *Server) VolumeData(volumeID int64) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return bytes.Clone(s.data[volumeID])
+}
+
+// PrimaryNode returns the node whose slice service is primary for a volume, or 0
+// without nodes. Volumes are spread over the nodes added with AddNode in order.
+func (s *Server) PrimaryNode(volumeID int64) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.primaryNode(volumeID)
+}
+
+func (s *Server) primaryNode(volumeID int64) int64 {
+	if len(s.nodes) == 0 {
+		return 0
+	}
+	return s.nodes[(volumeID-1)%int64(len(s.nodes))].Node.NodeID
+}
+
+// sliceService is the ID of the slice service on a node.
+func sliceService(nodeID int64) int64 { return 1000 + nodeID }
+
+// runningBulk counts unfinished bulk volume jobs for which match returns true.
+func (s *Server) runningBulk(match func(*bulkJob) bool) int64 {
+	var n int64
+	for _, job := range s.asyncJobs {
+		if !job.done && job.bulk != nil && match(job.bulk) {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *Server) startBulk(typ string, volumeID, snapshotID int64, format, script string, params, attrs interface{}) (*asyncJob, error) {
+	v, err := s.volume(volumeID)
+	if err != nil {
+		return nil, err
+	}
+	if snapshotID != 0 {
+		snap, err := s.snapshot(snapshotID)
+		if err != nil {
+			return nil, err
+		}
+		if snap.VolumeID != volumeID {
+			return nil, Errorf("xSnapshotIDDoesNotExist", "Snapshot %d does not belong to volume %d.", snapshotID, volumeID)
+		}
+	}
+	if format != "native" && format != "uncompressed" {
+		return nil, Errorf("xInvalidParameter", "Invalid format %q", format)
+	}
+	node := s.primaryNode(v.VolumeID)
+	if s.runningBulk(func(b *bulkJob) bool { return b.volumeID == v.VolumeID }) >= s.Limits.BulkVolumeJobsPerVolumeMax {
+		return nil, Errorf("xExceededLimit", "Volume %d already has %d bulk volume jobs", volumeID, s.Limits.BulkVolumeJobsPerVolumeMax)
+	}
+	if s.runningBulk(func(b *bulkJob) bool { return b.nodeID == node }) >= s.Limits.BulkVolumeJobsPerNodeMax {
+		return nil, Errorf("xExceededLimit", "Node %d already has %d bulk volume jobs", node, s.Limits.BulkVolumeJobsPerNodeMax)
+	}
+
+	id := s.newID("bulkVolume")
+	b := &bulkJob{
+		id:         id,
+		typ:        typ,
+		volumeID:   v.VolumeID,
+		snapshotID: snapshotID,
+		nodeID:     node,
+		format:     format,
+		script:     script,
+		key:        fmt.Sprintf("%032x", uint64(id)*0x9e3779b97f4a7c15),
+		attributes: emptyAttributes(attrs),
+	}
+	job := s.newAsyncJob("BulkVolume", nil, map[string]interface{}{
+		"bulkVolumeID": id,
+		"volumeID":     v.VolumeID,
+		"key":          b.key,
+		"message":      "Bulk volume job complete.",
+	})
+	job.bulk = b
+	if script == "bv_internal.py" {
+		if err := s.transfer(b, params); err != nil {
+			job.err = Errorf("xBulkVolumeScriptFailure", "%v", err)
+		}
+	}
+	return job, nil
+}
+
+// transfer runs the bv_internal.py script: a read PUTs the volume (or snapshot)
+// contents to http://<hostname>/<bucket>/<prefix>, a write GETs them back.
+func (s *Server) transfer(b *bulkJob, params interface{}) error {
+	direction := "write"
+	if b.typ == "write" {
+		direction = "read"
+	}
+	raw, _ := json.Marshal(params)
+	var wrapped map[string]s3Params
+	if err := json.Unmarshal(raw, &wrapped); err != nil || wrapped[direction].Hostname == "" {
+		return fmt.Errorf("scriptParameters need %q with an S3 hostname", direction)
+	}
+	p := wrapped[direction]
+	url := fmt.Sprintf("http://%s/%s/%s", p.Hostname, p.Bucket, p.Prefix)
+
+	if b.typ == "read" {
+		data := s.data[b.volumeID]
+		if b.snapshotID != 0 {
+			data = s.snapshotData[b.snapshotID]
+		}
+		req, _ := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			return fmt.Errorf("PUT %s: %s", url, resp.Status)
+		}
+		return nil
+	}
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	s.data[b.volumeID] = data
+	return nil
+}
+
+func (s *Server) startBulkVolumeRead(params json.RawMessage) (interface{}, error) {
+	var req sdk.StartBulkVolumeReadRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	job, err := s.startBulk("read", req.VolumeID, req.SnapshotID, req.Format, req.Script, req.ScriptParameters, req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.StartBulkVolumeReadResult{AsyncHandle: job.handle, Key: job.bulk.key, Url: s.bulkURL(job.bulk)}, nil
+}
+
+func (s *Server) startBulkVolumeWrite(params json.RawMessage) (interface{}, error) {
+	var req sdk.StartBulkVolumeWriteRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	job, err := s.startBulk("write", req.VolumeID, 0, req.Format, req.Script, req.ScriptParameters, req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.StartBulkVolumeWriteResult{AsyncHandle: job.handle, Key: job.bulk.key, Url: s.bulkURL(job.bulk)}, nil
+}
+
+func (s *Server) bulkURL(b *bulkJob) string {
+	for _, n := range s.nodes {
+		if n.Node.NodeID == b.nodeID {
+			return fmt.Sprintf("https://%s:8443/", n.Node.Mip)
+		}
+	}
+	return "https://127.0.0.1:8443/"
+}
+
+func (s *Server) listBulkVolumeJobs(params json.RawMessage) (interface{}, error) {
+	jobs := []sdk.BulkVolumeJob{}
+	for _, handle := range sortedKeys(s.asyncJobs) {
+		job := s.asyncJobs[handle]
+		if job.done || job.bulk == nil {
+			continue
+		}
+		b := job.bulk
+		elapsed := s.now().Sub(job.created)
+		remaining := s.AsyncDelay - elapsed
+		jobs = append(jobs, sdk.BulkVolumeJob{
+			BulkVolumeID:    b.id,
+			CreateTime:      job.created.Format(time.RFC3339),
+			ElapsedTime:     int64(elapsed.Seconds()),
+			Format:          b.format,
+			Key:             b.key,
+			PercentComplete: s.percentComplete(job),
+			RemainingTime:   int64(remaining.Seconds()),
+			SrcVolumeID:     b.volumeID,
+			Status:          "active",
+			Script:          b.script,
+			SnapshotID:      b.snapshotID,
+			Type:            b.typ,
+			Attributes:      b.attributes,
+		})
+	}
+	return sdk.ListBulkVolumeJobsResult{BulkVolumeJobs: jobs}, nil
+}
+
+// getReport serves the slices.json report: the slice services and the primary
+// service of each volume.
+func (s *Server) getReport(params json.RawMessage) (interface{}, error) {
+	var req sdk.GetReportRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if req.ReportName != "slices.json" {
+		return nil, Errorf("xInvalidParameter", "Unknown report %q", req.ReportName)
+	}
+	res := sdk.GetReportResult{Services: []sdk.ReportService{}, Slices: []sdk.ReportSlice{}}
+	for _, n := range s.nodes {
+		res.Services = append(res.Services, sdk.ReportService{ServiceID: sliceService(n.Node.NodeID), NodeID: n.Node.NodeID, Status: "healthy"})
+	}
+	if len(s.nodes) > 0 {
+		for _, id := range sortedKeys(s.volumes) {
+			res.Slices = append(res.Slices, sdk.ReportSlice{SliceID: id, VolumeID: id, Primary: sliceService(s.primaryNode(id))})
+		}
+	}
+	return res, nil
+}
+
+func (s *Server) getVolumeStats(params json.RawMessage) (interface{}, error) {
+	var req sdk.GetVolumeStatsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	v, err := s.volume(req.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+	stats := sdk.VolumeStats{
+		AccountID:     v.AccountID,
+		VolumeID:      v.VolumeID,
+		VolumeSize:    v.TotalSize,
+		Timestamp:     s.timestamp(),
+		MetadataHosts: sdk.MetadataHosts{DeadSecondaries: []int64{}, LiveSecondaries: []int64{}},
+	}
+	if len(s.nodes) > 0 {
+		stats.MetadataHosts.Primary = sliceService(s.primaryNode(v.VolumeID))
+	}
+	return sdk.GetVolumeStatsResult{VolumeStats: stats}, nil
+}
+
+func (s *Server) listServices(params json.RawMessage) (interface{}, error) {
+	services := []sdk.DetailedService{}
+	for _, n := range s.nodes {
+		services = append(services, sdk.DetailedService{
+			Service: sdk.Service{ServiceID: sliceService(n.Node.NodeID), ServiceType: "slice", NodeID: n.Node.NodeID},
+			Node:    n.Node,
+			Drives:  []sdk.Drive{},
+		})
+	}
+	return sdk.ListServicesResult{Services: services}, nil
+}
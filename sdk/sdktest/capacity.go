@@ -0,0 +1,96 @@
+package sdktest
+
+import (
+	"encoding/json"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"GetClusterCapacity":         (*Server).getClusterCapacity,
+		"GetClusterFullThreshold":    (*Server).getClusterFullThreshold,
+		"ListClusterCapacityHistory": (*Server).listClusterCapacityHistory,
+	})
+}
+
+// AddCapacitySample records a cluster capacity sample. The latest sample is what
+// GetClusterCapacity returns; all of them are returned by ListClusterCapacityHistory.
+// The timestamp defaults to the server clock.
+func (s *Server) AddCapacitySample(c sdk.ClusterCapacity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c.Timestamp == "" {
+		c.Timestamp = s.timestamp()
+	}
+	s.capacity = append(s.capacity, c)
+}
+
+func (s *Server) currentCapacity() sdk.ClusterCapacity {
+	if len(s.capacity) == 0 {
+		return sdk.ClusterCapacity{Timestamp: s.timestamp()}
+	}
+	return s.capacity[len(s.capacity)-1]
+}
+
+func (s *Server) getClusterCapacity(params json.RawMessage) (interface{}, error) {
+	return sdk.GetClusterCapacityResult{ClusterCapacity: s.currentCapacity()}, nil
+}
+
+func (s *Server) getClusterFullThreshold(params json.RawMessage) (interface{}, error) {
+	res := s.FullThreshold
+	c := s.currentCapacity()
+	res.SumUsedClusterBytes = c.UsedSpace
+	res.SumUsedMetadataClusterBytes = c.UsedMetadataSpace
+	res.BlockFullness = fullnessStage(c.UsedSpace, res.Stage2BlockThresholdBytes, res.Stage3BlockThresholdBytes,
+		res.Stage4BlockThresholdBytes, res.Stage5BlockThresholdBytes)
+	res.MetadataFullness = fullnessStage(c.UsedMetadataSpace, res.Stage2MetadataThresholdBytes, res.Stage3MetadataThresholdBytes,
+		res.Stage4MetadataThresholdBytes, res.Stage5MetadataThresholdBytes)
+	res.Fullness = res.BlockFullness
+	if res.MetadataFullness > res.Fullness {
+		res.Fullness = res.MetadataFullness
+	}
+	return res, nil
+}
+
+func (s *Server) listClusterCapacityHistory(params json.RawMessage) (interface{}, error) {
+	return sdk.ListClusterCapacityHistoryResult{ClusterCapacityHistory: append([]sdk.ClusterCapacity{}, s.capacity...)}, nil
+}
+
+// fullnessStage returns the name of the highest stage whose threshold used has reached.
+// The names sort in stage order, which getClusterFullThreshold relies on.
+func fullnessStage(used int64, stage2, stage3, stage4, stage5 int64) string {
+	switch {
+	case stage5 > 0 && used >= stage5:
+		return "stage5CompletelyConsumed"
+	case stage4 > 0 && used >= stage4:
+		return "stage4Critical"
+	case stage3 > 0 && used >= stage3:
+		return "stage3Low"
+	case stage2 > 0 && used >= stage2:
+		return "stage2Aware"
+	}
+	return "stage1Happy"
+}
+
+// defaultFullThreshold describes a four node cluster with 10 TB of block space and
+// 1 TB of metadata space per node.
+func defaultFullThreshold() sdk.GetClusterFullThresholdResult {
+	const tb = 1000 * 1000 * 1000 * 1000
+	return sdk.GetClusterFullThresholdResult{
+		MaxMetadataOverProvisionFactor: 5,
+		SliceReserveUsedThresholdPct:   5,
+		Stage3BlockThresholdPercent:    3,
+		Stage3MetadataThresholdPercent: 3,
+		SumTotalClusterBytes:           40 * tb,
+		SumTotalMetadataClusterBytes:   4 * tb,
+		Stage2BlockThresholdBytes:      25 * tb,
+		Stage3BlockThresholdBytes:      30 * tb,
+		Stage4BlockThresholdBytes:      35 * tb,
+		Stage5BlockThresholdBytes:      40 * tb,
+		Stage2MetadataThresholdBytes:   25 * tb / 10,
+		Stage3MetadataThresholdBytes:   3 * tb,
+		Stage4MetadataThresholdBytes:   35 * tb / 10,
+		Stage5MetadataThresholdBytes:   4 * tb,
+	}
+}
@@ -0,0 +1,435 @@
+package sdktest
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"CloneVolume":          (*Server).cloneVolume,
+		"CloneMultipleVolumes": (*Server).cloneMultipleVolumes,
+		"CopyVolume":           (*Server).copyVolume,
+		"ListCloneJobs":        (*Server).listCloneJobs,
+		"CancelClone":          (*Server).cancelClone,
+		"CancelGroupClone":     (*Server).cancelGroupClone,
+		"GetAsyncResult":       (*Server).getAsyncResult,
+		"ListAsyncResults":     (*Server).listAsyncResults,
+	})
+}
+
+// cloneMember pairs a clone source with its destination volume.
+type cloneMember struct {
+	src, dst, snapshotID int64
+	// created is set when the destination was created by the clone and must be
+	// removed if the clone is cancelled; CopyVolume overwrites an existing volume.
+	created bool
+}
+
+// asyncJob is a clone, copy or bulk volume job tracked by an async handle.
+type asyncJob struct {
+	handle       int64
+	resultType   string
+	cloneID      int64
+	groupCloneID int64
+	members      []cloneMember
+	created      time.Time
+	updated      time.Time
+	done         bool
+	err          *Error
+	result       map[string]interface{}
+	// bulk is set for StartBulkVolumeRead and StartBulkVolumeWrite jobs.
+	bulk *bulkJob
+}
+
+// advanceAsync completes jobs that have been running for at least AsyncDelay.
+func (s *Server) advanceAsync() {
+	now := s.now()
+	for _, job := range s.asyncJobs {
+		if job.done || now.Sub(job.created) < s.AsyncDelay {
+			continue
+		}
+		job.done = true
+		job.updated = now
+		for _, m := range job.members {
+			if v, ok := s.volumes[m.dst]; ok && v.Status == "init" {
+				v.Status = "active"
+			}
+		}
+	}
+}
+
+func (s *Server) newAsyncJob(resultType string, members []cloneMember, result map[string]interface{}) *asyncJob {
+	now := s.now()
+	job := &asyncJob{
+		handle:     s.newID("asyncHandle"),
+		resultType: resultType,
+		members:    members,
+		created:    now,
+		updated:    now,
+		result:     result,
+	}
+	s.asyncJobs[job.handle] = job
+	return job
+}
+
+// AddAsyncJob adds a running job of resultType, such as "DriveAdd", that completes
+// with result after AsyncDelay like a clone. It returns the job's async handle.
+func (s *Server) AddAsyncJob(resultType string, result map[string]interface{}) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.newAsyncJob(resultType, nil, result).handle
+}
+
+// FailAsyncJob completes a running job with the given Element error.
+func (s *Server) FailAsyncJob(handle int64, name, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if job, ok := s.asyncJobs[handle]; ok && !job.done {
+		job.done = true
+		job.updated = s.now()
+		job.err = Errorf(name, "%s", message)
+	}
+}
+
+// runningClones counts unfinished clone jobs reading from volume id.
+func (s *Server) runningClones(id int64) int64 {
+	var n int64
+	for _, job := range s.asyncJobs {
+		if job.done {
+			continue
+		}
+		for _, m := range job.members {
+			if m.src == id {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// cloneSource validates a clone source volume and optional snapshot.
+func (s *Server) cloneSource(volumeID, snapshotID int64) (*sdk.Volume, error) {
+	src, err := s.volume(volumeID)
+	if err != nil {
+		return nil, err
+	}
+	if snapshotID != 0 {
+		snap, err := s.snapshot(snapshotID)
+		if err != nil {
+			return nil, err
+		}
+		if snap.VolumeID != src.VolumeID {
+			return nil, Errorf("xSnapshotIDDoesNotExist", "Snapshot %d does not belong to volume %d.", snapshotID, volumeID)
+		}
+	}
+	if s.runningClones(src.VolumeID) >= s.Limits.CloneJobsPerVolumeMax {
+		return nil, Errorf("xMaxSimultaneousClonesPerVolumeExceeded", "Volume %d already has %d clones in progress", volumeID, s.Limits.CloneJobsPerVolumeMax)
+	}
+	return src, nil
+}
+
+// cloneParams are the destination settings shared by CloneVolume and CloneMultipleVolumes.
+type cloneParams struct {
+	name       string
+	accountID  int64
+	size       int64
+	access     string
+	attributes interface{}
+	enable512e bool
+}
+
+func (s *Server) validCloneParams(src *sdk.Volume, p *cloneParams) error {
+	if p.accountID == 0 {
+		p.accountID = src.AccountID
+	}
+	if p.size == 0 {
+		p.size = src.TotalSize
+	}
+	if p.access == "" {
+		p.access = string(src.Access)
+	}
+	if err := s.validVolumeName(p.name); err != nil {
+		return err
+	}
+	if _, err := s.account(p.accountID); err != nil {
+		return err
+	}
+	if err := s.validVolumeSize(p.size); err != nil {
+		return err
+	}
+	return s.checkVolumeLimits(p.accountID)
+}
+
+// newClone creates the destination volume, which stays in "init" until the job completes.
+func (s *Server) newClone(src *sdk.Volume, p cloneParams) *sdk.Volume {
+	v := s.newVolume(p.name, p.accountID, p.size, src.Enable512e || p.enable512e, p.attributes)
+	v.Status = "init"
+	v.Access = sdk.VolumeAccess(p.access)
+	v.Qos = src.Qos
+	return v
+}
+
+func (s *Server) cloneVolume(params json.RawMessage) (interface{}, error) {
+	var req sdk.CloneVolumeRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	src, err := s.cloneSource(req.VolumeID, req.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	p := cloneParams{name: req.Name, accountID: req.NewAccountID, size: req.NewSize, access: string(req.Access), attributes: req.Attributes, enable512e: req.Enable512e}
+	if err := s.validCloneParams(src, &p); err != nil {
+		return nil, err
+	}
+	v := s.newClone(src, p)
+	v.EnableSnapMirrorReplication = req.EnableSnapMirrorReplication
+	cloneID := s.newID("clone")
+	job := s.newAsyncJob("Clone", []cloneMember{{src: src.VolumeID, dst: v.VolumeID, snapshotID: req.SnapshotID, created: true}}, map[string]interface{}{
+		"cloneID":  cloneID,
+		"volumeID": v.VolumeID,
+		"message":  "Clone complete.",
+	})
+	job.cloneID = cloneID
+	return sdk.CloneVolumeResult{
+		Volume:      s.volumeView(v),
+		CloneID:     cloneID,
+		VolumeID:    v.VolumeID,
+		SnapshotID:  req.SnapshotID,
+		AsyncHandle: job.handle,
+	}, nil
+}
+
+func (s *Server) cloneMultipleVolumes(params json.RawMessage) (interface{}, error) {
+	var req sdk.CloneMultipleVolumesRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if len(req.Volumes) == 0 {
+		return nil, Errorf("xInvalidParameter", "No volumes to clone")
+	}
+	var groupSnapshot *sdk.GroupSnapshot
+	if req.GroupSnapshotID != 0 {
+		g, ok := s.groupSnapshots[req.GroupSnapshotID]
+		if !ok {
+			return nil, Errorf("xGroupSnapshotIDDoesNotExist", "Group snapshot %d does not exist.", req.GroupSnapshotID)
+		}
+		view := s.groupSnapshotView(g)
+		groupSnapshot = &view
+	}
+
+	sources := make([]*sdk.Volume, len(req.Volumes))
+	snapshots := make([]int64, len(req.Volumes))
+	clones := make([]cloneParams, len(req.Volumes))
+	for i, vp := range req.Volumes {
+		if groupSnapshot != nil {
+			for _, m := range groupSnapshot.Members {
+				if m.VolumeID == vp.VolumeID {
+					snapshots[i] = m.SnapshotID
+				}
+			}
+			if snapshots[i] == 0 {
+				return nil, Errorf("xInvalidParameter", "Volume %d is not a member of group snapshot %d", vp.VolumeID, req.GroupSnapshotID)
+			}
+		}
+		src, err := s.cloneSource(vp.VolumeID, snapshots[i])
+		if err != nil {
+			return nil, err
+		}
+		p := cloneParams{name: vp.Name, accountID: vp.NewAccountID, size: vp.NewSize, access: vp.Access, attributes: vp.Attributes}
+		if p.name == "" {
+			p.name = src.Name + "-clone"
+		}
+		if p.accountID == 0 {
+			p.accountID = req.NewAccountID
+		}
+		if p.access == "" {
+			p.access = req.Access
+		}
+		if err := s.validCloneParams(src, &p); err != nil {
+			return nil, err
+		}
+		sources[i], clones[i] = src, p
+	}
+
+	groupCloneID := s.newID("groupClone")
+	res := sdk.CloneMultipleVolumesResult{GroupCloneID: groupCloneID}
+	var members []cloneMember
+	for i, src := range sources {
+		v := s.newClone(src, clones[i])
+		members = append(members, cloneMember{src: src.VolumeID, dst: v.VolumeID, snapshotID: snapshots[i], created: true})
+		res.Members = append(res.Members, sdk.GroupCloneVolumeMember{VolumeID: v.VolumeID, SrcVolumeID: src.VolumeID})
+	}
+	job := s.newAsyncJob("Clone", members, map[string]interface{}{
+		"groupCloneID": groupCloneID,
+		"members":      res.Members,
+		"message":      "Clone complete.",
+	})
+	job.groupCloneID = groupCloneID
+	res.AsyncHandle = job.handle
+	return res, nil
+}
+
+func (s *Server) copyVolume(params json.RawMessage) (interface{}, error) {
+	var req sdk.CopyVolumeRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	src, err := s.cloneSource(req.VolumeID, req.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := s.volume(req.DstVolumeID)
+	if err != nil {
+		return nil, err
+	}
+	if dst.TotalSize < src.TotalSize {
+		return nil, Errorf("xInvalidParameter", "Volume %d is smaller than volume %d", dst.VolumeID, src.VolumeID)
+	}
+	cloneID := s.newID("clone")
+	job := s.newAsyncJob("Clone", []cloneMember{{src: src.VolumeID, dst: dst.VolumeID, snapshotID: req.SnapshotID}}, map[string]interface{}{
+		"cloneID":     cloneID,
+		"volumeID":    src.VolumeID,
+		"dstVolumeID": dst.VolumeID,
+		"message":     "Copy complete.",
+	})
+	job.cloneID = cloneID
+	return sdk.CopyVolumeResult{CloneID: cloneID, AsyncHandle: job.handle}, nil
+}
+
+// listCloneJobs returns the running clone and copy operations.
+func (s *Server) listCloneJobs(params json.RawMessage) (interface{}, error) {
+	jobs := []sdk.CloneJob{}
+	for _, handle := range sortedKeys(s.asyncJobs) {
+		job := s.asyncJobs[handle]
+		if job.done || job.resultType != "Clone" {
+			continue
+		}
+		for _, m := range job.members {
+			jobs = append(jobs, sdk.CloneJob{
+				CloneID:         job.cloneID,
+				GroupCloneID:    job.groupCloneID,
+				AsyncHandle:     job.handle,
+				SrcVolumeID:     m.src,
+				DstVolumeID:     m.dst,
+				SnapshotID:      m.snapshotID,
+				CreateTime:      job.created.Format(time.RFC3339),
+				ElapsedTime:     int64(s.now().Sub(job.created).Seconds()),
+				PercentComplete: s.percentComplete(job),
+				Stage:           "data",
+			})
+		}
+	}
+	return sdk.ListCloneJobsResult{CloneJobs: jobs}, nil
+}
+
+func (s *Server) percentComplete(job *asyncJob) int64 {
+	if job.done || s.AsyncDelay <= 0 {
+		return 100
+	}
+	return int64(100 * s.now().Sub(job.created) / s.AsyncDelay)
+}
+
+// cancel fails a running job and removes the volumes it was creating.
+func (s *Server) cancel(job *asyncJob) {
+	job.done = true
+	job.updated = s.now()
+	job.err = Errorf("xCloneCanceled", "The clone was canceled.")
+	for _, m := range job.members {
+		if m.created {
+			delete(s.volumes, m.dst)
+		}
+	}
+}
+
+func (s *Server) cancelClone(params json.RawMessage) (interface{}, error) {
+	var req sdk.CancelCloneRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	for _, job := range s.asyncJobs {
+		if job.cloneID == req.CloneID && job.cloneID != 0 && !job.done {
+			s.cancel(job)
+			return sdk.CancelCloneResult{}, nil
+		}
+	}
+	return nil, Errorf("xCloneIDDoesNotExist", "No clone in progress with cloneID %d.", req.CloneID)
+}
+
+func (s *Server) cancelGroupClone(params json.RawMessage) (interface{}, error) {
+	var req sdk.CancelGroupCloneRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	for _, job := range s.asyncJobs {
+		if job.groupCloneID == req.GroupCloneID && job.groupCloneID != 0 && !job.done {
+			s.cancel(job)
+			return sdk.CancelGroupCloneResult{}, nil
+		}
+	}
+	return nil, Errorf("xGroupCloneIDDoesNotExist", "No group clone in progress with groupCloneID %d.", req.GroupCloneID)
+}
+
+// asyncView renders a job the way GetAsyncResult does.
+func (s *Server) asyncView(job *asyncJob) map[string]interface{} {
+	out := map[string]interface{}{
+		"createTime":     job.created.Format(time.RFC3339),
+		"lastUpdateTime": job.updated.Format(time.RFC3339),
+		"resultType":     job.resultType,
+	}
+	switch {
+	case !job.done:
+		out["status"] = "running"
+		out["details"] = map[string]interface{}{"percentComplete": s.percentComplete(job)}
+	case job.err != nil:
+		out["status"] = "complete"
+		out["error"] = map[string]interface{}{"name": job.err.Name, "message": job.err.Message}
+	default:
+		out["status"] = "complete"
+		out["result"] = job.result
+	}
+	return out
+}
+
+func (s *Server) getAsyncResult(params json.RawMessage) (interface{}, error) {
+	var req sdk.GetAsyncResultRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	job, ok := s.asyncJobs[req.AsyncHandle]
+	if !ok {
+		return nil, Errorf("xUnknownAsyncHandle", "Unknown async handle %d.", req.AsyncHandle)
+	}
+	if job.done && !req.KeepResult {
+		delete(s.asyncJobs, job.handle)
+	}
+	return s.asyncView(job), nil
+}
+
+func (s *Server) listAsyncResults(params json.RawMessage) (interface{}, error) {
+	var req sdk.ListAsyncResultsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	out := []sdk.AsyncHandle{}
+	for _, handle := range sortedKeys(s.asyncJobs) {
+		job := s.asyncJobs[handle]
+		if len(req.AsyncResultTypes) > 0 && !slices.Contains(req.AsyncResultTypes, job.resultType) {
+			continue
+		}
+		out = append(out, sdk.AsyncHandle{
+			AsyncResultID:  job.handle,
+			Completed:      job.done,
+			CreateTime:     job.created.Format(time.RFC3339),
+			LastUpdateTime: job.updated.Format(time.RFC3339),
+			ResultType:     job.resultType,
+			Success:        job.done && job.err == nil,
+			Data:           s.asyncView(job),
+		})
+	}
+	return sdk.ListAsyncResultsResult{AsyncHandles: out}, nil
+}
@@ -0,0 +1,118 @@
+package sdktest
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"GetAPI":                (*Server).getAPI,
+		"GetClusterInfo":        (*Server).getClusterInfo,
+		"GetClusterVersionInfo": (*Server).getClusterVersionInfo,
+		"GetLimits":             (*Server).getLimits,
+		"ListISCSISessions":     (*Server).listISCSISessions,
+	})
+}
+
+// apiVersions are the JSON-RPC endpoint versions an Element 12.5 cluster serves.
+var apiVersions = []string{
+	"1.0", "2.0", "3.0", "4.0", "5.0", "6.0", "7.0", "7.1", "7.2", "7.3", "7.4",
+	"8.0", "8.1", "8.2", "8.3", "8.4", "8.5", "8.6", "8.7", "9.0", "9.1", "9.2",
+	"9.3", "9.4", "9.5", "9.6", "10.0", "10.1", "10.2", "10.3", "10.4", "10.5",
+	"10.6", "10.7", "11.0", "11.1", "11.3", "11.5", "11.7", "11.8", "12.0",
+	"12.2", "12.3", "12.5", "12.7", "12.8", "12.9",
+}
+
+// supportedVersions returns the endpoint versions up to and including s.Version.
+func (s *Server) supportedVersions() []string {
+	var out []string
+	for _, v := range apiVersions {
+		out = append(out, v)
+		if v == s.Version {
+			break
+		}
+	}
+	return out
+}
+
+func (s *Server) getAPI(params json.RawMessage) (interface{}, error) {
+	return map[string]interface{}{
+		"currentVersion":    s.Version,
+		"supportedVersions": s.supportedVersions(),
+	}, nil
+}
+
+func (s *Server) getClusterInfo(params json.RawMessage) (interface{}, error) {
+	return sdk.GetClusterInfoResult{ClusterInfo: s.ClusterInfo}, nil
+}
+
+func (s *Server) getClusterVersionInfo(params json.RawMessage) (interface{}, error) {
+	full := s.Version + ".0.897"
+	if strings.Count(s.Version, ".") > 1 {
+		full = s.Version
+	}
+	var nodes []sdk.ClusterVersionInfo
+	for i := range s.ClusterInfo.Ensemble {
+		nodes = append(nodes, sdk.ClusterVersionInfo{NodeID: int64(i + 1), NodeVersion: full, NodeInternalRevision: "BuildType=Release Element=" + s.Version})
+	}
+	return sdk.GetClusterVersionInfoResult{
+		ClusterAPIVersion:  s.Version,
+		ClusterVersion:     full,
+		ClusterVersionInfo: nodes,
+		SoftwareVersionInfo: sdk.SoftwareVersionInfo{
+			CurrentVersion: full,
+			PackageName:    "solidfire-element-" + s.Version,
+			StartTime:      s.timestamp(),
+		},
+	}, nil
+}
+
+func (s *Server) getLimits(params json.RawMessage) (interface{}, error) {
+	return s.Limits, nil
+}
+
+func (s *Server) listISCSISessions(params json.RawMessage) (interface{}, error) {
+	return sdk.ListISCSISessionsResult{Sessions: append([]sdk.ISCSISession{}, s.sessions...)}, nil
+}
+
+// AddISCSISession simulates an initiator logged in to volumeID and returns the session ID.
+func (s *Server) AddISCSISession(volumeID int64, initiatorName string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := s.newID("session")
+	session := sdk.ISCSISession{
+		SessionID:     id,
+		VolumeID:      volumeID,
+		InitiatorName: initiatorName,
+		InitiatorIP:   "10.10.10.100:" + strconv.FormatInt(40000+id, 10),
+		TargetIP:      s.ClusterInfo.Svip + ":3260",
+		NodeID:        1,
+		ServiceID:     1,
+		CreateTime:    s.timestamp(),
+	}
+	if v, ok := s.volumes[volumeID]; ok {
+		session.TargetName = v.Iqn
+		session.AccountID = v.AccountID
+		if a, ok := s.accounts[v.AccountID]; ok {
+			session.AccountName = a.Username
+		}
+	}
+	s.sessions = append(s.sessions, session)
+	return id
+}
+
+// RemoveISCSISession ends a session created with AddISCSISession.
+func (s *Server) RemoveISCSISession(sessionID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, sess := range s.sessions {
+		if sess.SessionID == sessionID {
+			s.sessions = append(s.sessions[:i], s.sessions[i+1:]...)
+			return
+		}
+	}
+}
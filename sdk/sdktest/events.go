@@ -0,0 +1,61 @@
+package sdktest
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"ListEvents": (*Server).listEvents,
+	})
+}
+
+// AddEvent appends an event to the cluster event log and returns its EventID.
+// The report and publish times default to the server clock.
+func (s *Server) AddEvent(event sdk.EventInfo) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	event.EventID = s.newID("event")
+	if event.TimeOfReport == "" {
+		event.TimeOfReport = s.timestamp()
+	}
+	if event.TimeOfPublish == "" {
+		event.TimeOfPublish = event.TimeOfReport
+	}
+	if event.DriveID == 0 && len(event.DriveIDs) > 0 {
+		event.DriveID = event.DriveIDs[0]
+	}
+	s.events = append(s.events, event)
+	return event.EventID
+}
+
+func (s *Server) listEvents(params json.RawMessage) (interface{}, error) {
+	var req sdk.ListEventsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	events := []sdk.EventInfo{}
+	for _, e := range s.events {
+		switch {
+		case req.StartEventID != 0 && e.EventID < req.StartEventID,
+			req.EndEventID != 0 && e.EventID > req.EndEventID,
+			req.EventType != "" && e.EventInfoType != req.EventType,
+			req.NodeID != 0 && e.NodeID != req.NodeID,
+			req.ServiceID != 0 && e.ServiceID != req.ServiceID,
+			req.DriveID != 0 && !slices.Contains(e.DriveIDs, req.DriveID) && e.DriveID != req.DriveID:
+			continue
+		}
+		events = append(events, e)
+		if req.MaxEvents > 0 && int64(len(events)) == req.MaxEvents {
+			break
+		}
+	}
+	queue := req.EventQueueType
+	if queue == "" {
+		queue = "standard"
+	}
+	return sdk.ListEventsResult{EventQueueType: queue, Events: events}, nil
+}
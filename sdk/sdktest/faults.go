@@ -0,0 +1,93 @@
+package sdktest
+
+import (
+	"encoding/json"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"ListClusterFaults":  (*Server).listClusterFaults,
+		"ClearClusterFaults": (*Server).clearClusterFaults,
+	})
+}
+
+// AddClusterFault raises a cluster fault and returns its ClusterFaultID. The date
+// defaults to the server clock.
+func (s *Server) AddClusterFault(fault sdk.ClusterFaultInfo) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fault.ClusterFaultID = s.newID("fault")
+	fault.Resolved = false
+	fault.ResolvedDate = ""
+	if fault.Date == "" {
+		fault.Date = s.timestamp()
+	}
+	if fault.DriveID == 0 && len(fault.DriveIDs) > 0 {
+		fault.DriveID = fault.DriveIDs[0]
+	}
+	s.faults = append(s.faults, fault)
+	return fault.ClusterFaultID
+}
+
+// ResolveClusterFault marks a fault raised with AddClusterFault as resolved.
+func (s *Server) ResolveClusterFault(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.faults {
+		if s.faults[i].ClusterFaultID == id && !s.faults[i].Resolved {
+			s.faults[i].Resolved = true
+			s.faults[i].ResolvedDate = s.timestamp()
+		}
+	}
+}
+
+func matchesFaultTypes(f sdk.ClusterFaultInfo, faultTypes string) bool {
+	switch faultTypes {
+	case "current":
+		return !f.Resolved
+	case "resolved":
+		return f.Resolved
+	}
+	return true
+}
+
+func (s *Server) listClusterFaults(params json.RawMessage) (interface{}, error) {
+	var req sdk.ListClusterFaultsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	switch req.FaultTypes {
+	case "", "all", "current", "resolved":
+	default:
+		return nil, Errorf("xInvalidParameter", "Invalid faultTypes %q", req.FaultTypes)
+	}
+	faults := []sdk.ClusterFaultInfo{}
+	for _, f := range s.faults {
+		if !matchesFaultTypes(f, req.FaultTypes) || (f.Severity == "bestPractice" && !req.BestPractices) {
+			continue
+		}
+		faults = append(faults, f)
+	}
+	return sdk.ListClusterFaultsResult{Faults: faults}, nil
+}
+
+func (s *Server) clearClusterFaults(params json.RawMessage) (interface{}, error) {
+	var req sdk.ClearClusterFaultsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	faultTypes := req.FaultTypes
+	if faultTypes == "" {
+		faultTypes = "resolved"
+	}
+	kept := s.faults[:0]
+	for _, f := range s.faults {
+		if !matchesFaultTypes(f, faultTypes) {
+			kept = append(kept, f)
+		}
+	}
+	s.faults = kept
+	return sdk.ClearClusterFaultsResult{}, nil
+}
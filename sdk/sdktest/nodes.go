@@ -0,0 +1,191 @@
+package sdktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"ListAllNodes": (*Server).listAllNodes,
+	})
+}
+
+// nodeHandler serves a per-node API method. It runs with the server lock held.
+type nodeHandler func(n *NodeServer, params json.RawMessage) (interface{}, error)
+
+var nodeBuiltins = map[string]nodeHandler{
+	"GetAPI":           func(n *NodeServer, params json.RawMessage) (interface{}, error) { return n.cluster.getAPI(params) },
+	"GetConfig":        (*NodeServer).getConfig,
+	"GetClusterConfig": (*NodeServer).getClusterConfig,
+	"GetNetworkConfig": (*NodeServer).getNetworkConfig,
+	"ListTests":        (*NodeServer).listTests,
+	"TestPing":         (*NodeServer).testPing,
+}
+
+// NodeServer simulates the per-node API of one active node. The cluster lists it
+// in ListAllNodes; its own TLS listener stands in for https://<MIP>:442.
+type NodeServer struct {
+	*httptest.Server
+
+	// Node is returned by ListAllNodes.
+	Node sdk.Node
+	// Network is returned by GetNetworkConfig and GetConfig.
+	Network sdk.Network
+
+	cluster  *Server
+	injected map[string]*injectedError
+}
+
+// AddNode adds an active storage node named name and starts its node API. The node
+// is closed with the server.
+func (s *Server) AddNode(name string) *NodeServer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := s.newID("node")
+	mip := fmt.Sprintf("10.117.0.%d", id)
+	sip := fmt.Sprintf("10.10.10.%d", 100+id)
+	n := &NodeServer{
+		Node: sdk.Node{
+			NodeID:          id,
+			Name:            name,
+			Role:            "Storage",
+			SoftwareVersion: s.Version,
+			Mip:             mip,
+			Mipi:            "Bond1G",
+			Cip:             sip,
+			Cipi:            "Bond10G",
+			Sip:             sip,
+			Sipi:            "Bond10G",
+			Uuid:            fmt.Sprintf("4c4c4544-0000-1000-8000-%012d", id),
+			ChassisName:     name,
+			Attributes:      map[string]interface{}{},
+		},
+		Network: sdk.Network{
+			Bond1G:  sdk.NetworkConfig{Address: mip, Netmask: "255.255.255.0", Gateway: "10.117.0.254", Method: "static", Mtu: "1500"},
+			Bond10G: sdk.NetworkConfig{Address: sip, Netmask: "255.255.255.0", Method: "static", Mtu: "9000"},
+		},
+		cluster:  s,
+		injected: map[string]*injectedError{},
+	}
+	n.Server = httptest.NewTLSServer(http.HandlerFunc(n.serveHTTP))
+	s.nodes = append(s.nodes, n)
+	return n
+}
+
+// NodeAddress returns the host:port of the node API for a node listed by
+// ListAllNodes, for use as sdk.FanOutOptions.Address.
+func (s *Server) NodeAddress(node sdk.Node) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, n := range s.nodes {
+		if n.Node.NodeID == node.NodeID {
+			return n.Host()
+		}
+	}
+	return node.Mip
+}
+
+// Close shuts down the node APIs and the cluster.
+func (s *Server) Close() {
+	s.mu.Lock()
+	nodes := s.nodes
+	s.mu.Unlock()
+	for _, n := range nodes {
+		n.Close()
+	}
+	s.Server.Close()
+}
+
+// Host returns the host:port to pass to NodeClient.Connect.
+func (n *NodeServer) Host() string {
+	return strings.TrimPrefix(n.URL, "https://")
+}
+
+// InjectError makes the next times calls to method on this node fail with the
+// given Element error.
+func (n *NodeServer) InjectError(method, name, message string, times int) {
+	n.cluster.mu.Lock()
+	defer n.cluster.mu.Unlock()
+	n.injected[method] = &injectedError{err: Errorf(name, "%s", message), times: times}
+}
+
+func (n *NodeServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	n.cluster.serveRPC(w, r, n.dispatch)
+}
+
+func (n *NodeServer) dispatch(version, method string, params json.RawMessage) (interface{}, error) {
+	s := n.cluster
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, Call{Method: method, Params: params, APIVersion: version, NodeID: n.Node.NodeID})
+	if inj := n.injected[method]; inj != nil && inj.times > 0 {
+		inj.times--
+		return nil, inj.err
+	}
+	h, ok := nodeBuiltins[method]
+	if !ok {
+		return nil, Errorf("xUnknownAPIMethod", "Unknown method %s", method)
+	}
+	return h(n, params)
+}
+
+func (s *Server) listAllNodes(params json.RawMessage) (interface{}, error) {
+	nodes := []sdk.Node{}
+	for _, n := range s.nodes {
+		nodes = append(nodes, n.Node)
+	}
+	return sdk.ListAllNodesResult{Nodes: nodes, PendingNodes: []sdk.PendingNode{}}, nil
+}
+
+func (n *NodeServer) clusterConfig() sdk.ClusterConfig {
+	return sdk.ClusterConfig{
+		Cipi:     n.Node.Cipi,
+		Cluster:  n.cluster.ClusterInfo.Name,
+		Ensemble: n.cluster.ClusterInfo.Ensemble,
+		Mipi:     n.Node.Mipi,
+		Name:     n.Node.Name,
+		NodeID:   n.Node.NodeID,
+		Role:     n.Node.Role,
+		Sipi:     n.Node.Sipi,
+		State:    "Active",
+		Version:  n.Node.SoftwareVersion,
+	}
+}
+
+func (n *NodeServer) getConfig(params json.RawMessage) (interface{}, error) {
+	return sdk.GetConfigResult{Config: sdk.Config{Cluster: n.clusterConfig(), Network: n.Network}}, nil
+}
+
+func (n *NodeServer) getClusterConfig(params json.RawMessage) (interface{}, error) {
+	return sdk.GetClusterConfigResult{Cluster: n.clusterConfig()}, nil
+}
+
+func (n *NodeServer) getNetworkConfig(params json.RawMessage) (interface{}, error) {
+	return sdk.GetNetworkConfigResult{Network: n.Network}, nil
+}
+
+func (n *NodeServer) listTests(params json.RawMessage) (interface{}, error) {
+	return sdk.ListTestsResult{Tests: "TestConnectEnsemble, TestConnectMvip, TestConnectSvip, TestDrives, TestPing"}, nil
+}
+
+func (n *NodeServer) testPing(params json.RawMessage) (interface{}, error) {
+	var req sdk.TestPingRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	hosts := req.Hosts
+	if hosts == "" {
+		hosts = strings.Join(n.cluster.ClusterInfo.Ensemble, ",")
+	}
+	details := map[string]interface{}{}
+	for _, h := range strings.Split(hosts, ",") {
+		details[strings.TrimSpace(h)] = map[string]interface{}{"individualResult": "Passed", "successful": true}
+	}
+	return sdk.TestPingResult{Result: "Passed", Duration: "00:00:01.000000", Details: details}, nil
+}
@@ -0,0 +1,250 @@
+package sdktest
+
+import (
+	"encoding/json"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"CreateQoSPolicy": (*Server).createQoSPolicy,
+		"ListQoSPolicies": (*Server).listQoSPolicies,
+		"GetQoSPolicy":    (*Server).getQoSPolicy,
+		"ModifyQoSPolicy": (*Server).modifyQoSPolicy,
+		"DeleteQoSPolicy": (*Server).deleteQoSPolicy,
+		"CreateSchedule":  (*Server).createSchedule,
+		"ListSchedules":   (*Server).listSchedules,
+		"GetSchedule":     (*Server).getSchedule,
+		"ModifySchedule":  (*Server).modifySchedule,
+	})
+}
+
+func (s *Server) qosPolicy(id int64) (*sdk.QoSPolicy, error) {
+	p, ok := s.qosPolicies[id]
+	if !ok {
+		return nil, Errorf("xQoSPolicyDoesNotExist", "QoS policy %d does not exist.", id)
+	}
+	return p, nil
+}
+
+// associatePolicy moves v to policy and applies the policy's QoS settings.
+func (s *Server) associatePolicy(v *sdk.Volume, policy *sdk.QoSPolicy) {
+	s.dissociatePolicy(v)
+	v.QosPolicyID = policy.QosPolicyID
+	v.Qos = policy.Qos
+	policy.VolumeIDs = append(policy.VolumeIDs, v.VolumeID)
+}
+
+// dissociatePolicy detaches v from its policy; the volume keeps its current QoS.
+func (s *Server) dissociatePolicy(v *sdk.Volume) {
+	if p, ok := s.qosPolicies[v.QosPolicyID]; ok {
+		removeID(&p.VolumeIDs, v.VolumeID)
+	}
+	v.QosPolicyID = 0
+}
+
+func (s *Server) createQoSPolicy(params json.RawMessage) (interface{}, error) {
+	var req sdk.CreateQoSPolicyRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, Errorf("xInvalidParameter", "A QoS policy needs a name")
+	}
+	if int64(len(s.qosPolicies)) >= s.Limits.QosPolicyCountMax {
+		return nil, Errorf("xMaxQoSPoliciesExceeded", "The maximum number of QoS policies has been reached")
+	}
+	qos, err := s.applyQoS(defaultQoS, &req.Qos)
+	if err != nil {
+		return nil, err
+	}
+	p := &sdk.QoSPolicy{QosPolicyID: s.newID("qosPolicy"), Name: req.Name, VolumeIDs: []int64{}, Qos: qos}
+	s.qosPolicies[p.QosPolicyID] = p
+	return sdk.CreateQoSPolicyResult{QosPolicy: *p}, nil
+}
+
+func (s *Server) listQoSPolicies(params json.RawMessage) (interface{}, error) {
+	out := []sdk.QoSPolicy{}
+	for _, id := range sortedKeys(s.qosPolicies) {
+		out = append(out, *s.qosPolicies[id])
+	}
+	return sdk.ListQoSPoliciesResult{QosPolicies: out}, nil
+}
+
+func (s *Server) getQoSPolicy(params json.RawMessage) (interface{}, error) {
+	var req sdk.GetQoSPolicyRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	p, err := s.qosPolicy(req.QosPolicyID)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.GetQoSPolicyResult{QosPolicy: *p}, nil
+}
+
+func (s *Server) modifyQoSPolicy(params json.RawMessage) (interface{}, error) {
+	var req sdk.ModifyQoSPolicyRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	p, err := s.qosPolicy(req.QosPolicyID)
+	if err != nil {
+		return nil, err
+	}
+	qos, err := s.applyQoS(p.Qos, &req.Qos)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != "" {
+		p.Name = req.Name
+	}
+	p.Qos = qos
+	for _, id := range p.VolumeIDs {
+		s.volumes[id].Qos = qos
+	}
+	return sdk.ModifyQoSPolicyResult{QosPolicy: *p}, nil
+}
+
+func (s *Server) deleteQoSPolicy(params json.RawMessage) (interface{}, error) {
+	var req sdk.DeleteQoSPolicyRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	p, err := s.qosPolicy(req.QosPolicyID)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range p.VolumeIDs {
+		s.volumes[id].QosPolicyID = 0
+	}
+	delete(s.qosPolicies, p.QosPolicyID)
+	return sdk.DeleteQoSPolicyResult{}, nil
+}
+
+func (s *Server) schedule(id int64) (*sdk.Schedule, error) {
+	sched, ok := s.schedules[id]
+	if !ok {
+		return nil, Errorf("xScheduleDoesNotExist", "Schedule %d does not exist.", id)
+	}
+	return sched, nil
+}
+
+// validScheduleInfo checks that the volumes a schedule snapshots exist.
+func (s *Server) validScheduleInfo(info sdk.ScheduleInfo) error {
+	ids := info.Volumes
+	if info.VolumeID != 0 {
+		ids = append([]int64{info.VolumeID}, ids...)
+	}
+	for _, id := range ids {
+		if _, err := s.volume(id); err != nil {
+			return err
+		}
+	}
+	if info.Retention != "" {
+		if _, err := parseRetention(info.Retention); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) createSchedule(params json.RawMessage) (interface{}, error) {
+	var req sdk.CreateScheduleRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if req.ScheduleName == "" || int64(len(req.ScheduleName)) > s.Limits.ScheduleNameLengthMax {
+		return nil, Errorf("xInvalidParameter", "Schedule name must be 1 to %d characters", s.Limits.ScheduleNameLengthMax)
+	}
+	if err := s.validScheduleInfo(req.ScheduleInfo); err != nil {
+		return nil, err
+	}
+	sched := &sdk.Schedule{
+		ScheduleID:      s.newID("schedule"),
+		ScheduleName:    req.ScheduleName,
+		Monthdays:       req.Monthdays,
+		Weekdays:        req.Weekdays,
+		Hours:           req.Hours,
+		Minutes:         req.Minutes,
+		ScheduleType:    req.ScheduleType,
+		Attributes:      emptyAttributes(req.Attributes),
+		ScheduleInfo:    req.ScheduleInfo,
+		Paused:          req.Paused,
+		Recurring:       req.Recurring,
+		RunNextInterval: req.RunNextInterval,
+		StartingDate:    req.StartingDate,
+		SnapMirrorLabel: req.SnapMirrorLabel,
+	}
+	if sched.ScheduleType == "" {
+		sched.ScheduleType = "Snapshot"
+	}
+	s.schedules[sched.ScheduleID] = sched
+	return sdk.CreateScheduleResult{ScheduleID: sched.ScheduleID, Schedule: *sched}, nil
+}
+
+func (s *Server) listSchedules(params json.RawMessage) (interface{}, error) {
+	out := []sdk.Schedule{}
+	for _, id := range sortedKeys(s.schedules) {
+		out = append(out, *s.schedules[id])
+	}
+	return sdk.ListSchedulesResult{Schedules: out}, nil
+}
+
+func (s *Server) getSchedule(params json.RawMessage) (interface{}, error) {
+	var req sdk.GetScheduleRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	sched, err := s.schedule(req.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.GetScheduleResult{Schedule: *sched}, nil
+}
+
+// modifySchedule replaces the schedule definition. Element has no DeleteSchedule;
+// setting toBeDeleted removes the schedule instead.
+func (s *Server) modifySchedule(params json.RawMessage) (interface{}, error) {
+	var req sdk.ModifyScheduleRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	sched, err := s.schedule(req.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if req.ToBeDeleted {
+		delete(s.schedules, sched.ScheduleID)
+		sched.ToBeDeleted = true
+		return sdk.ModifyScheduleResult{Schedule: *sched}, nil
+	}
+	if err := s.validScheduleInfo(req.ScheduleInfo); err != nil {
+		return nil, err
+	}
+	if req.ScheduleName != "" {
+		sched.ScheduleName = req.ScheduleName
+	}
+	if req.ScheduleType != "" {
+		sched.ScheduleType = req.ScheduleType
+	}
+	if req.Attributes != nil {
+		sched.Attributes = req.Attributes
+	}
+	sched.Monthdays = req.Monthdays
+	sched.Weekdays = req.Weekdays
+	sched.Hours = req.Hours
+	sched.Minutes = req.Minutes
+	sched.ScheduleInfo = req.ScheduleInfo
+	sched.Paused = req.Paused
+	sched.Recurring = req.Recurring
+	sched.RunNextInterval = req.RunNextInterval
+	if req.StartingDate != "" {
+		sched.StartingDate = req.StartingDate
+	}
+	if req.SnapMirrorLabel != "" {
+		sched.SnapMirrorLabel = req.SnapMirrorLabel
+	}
+	return sdk.ModifyScheduleResult{Schedule: *sched}, nil
+}
@@ -0,0 +1,408 @@
+// Package sdktest provides an in-memory SolidFire (Element) JSON-RPC server for
+// tests. It keeps state for accounts, volumes, snapshots, group snapshots, clones,
+// volume access groups, initiators, QoS policies, schedules, cluster faults, capacity
+// samples and the event log, and answers with the same error names a real cluster
+// returns, so SFClient and the methods package can be exercised end to end without
+// hardware.
+package sdktest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+// Error is a JSON-RPC error returned by a handler. Name is the Element error name.
+type Error struct {
+	Code    int32
+	Name    string
+	Message string
+}
+
+func (e *Error) Error() string { return e.Name + ": " + e.Message }
+
+// Errorf returns an Element error with code 500, the code the cluster uses for API errors.
+func Errorf(name, format string, args ...interface{}) *Error {
+	return &Error{Code: 500, Name: name, Message: fmt.Sprintf(format, args...)}
+}
+
+// HandlerFunc answers a single JSON-RPC method. It returns the value for "result"
+// or an *Error for "error".
+type HandlerFunc func(params json.RawMessage) (interface{}, error)
+
+// builtinHandler runs with the server lock held.
+type builtinHandler func(s *Server, params json.RawMessage) (interface{}, error)
+
+var builtins = map[string]builtinHandler{}
+
+// register adds built-in handlers; each resource file registers its own methods.
+func register(handlers map[string]builtinHandler) {
+	for method, h := range handlers {
+		builtins[method] = h
+	}
+}
+
+// Call records a request received by the server.
+type Call struct {
+	Method string
+	Params json.RawMessage
+	// APIVersion is the endpoint version from the request path, such as "12.5".
+	APIVersion string
+	// NodeID is the node whose per-node API received the call, or 0 for the
+	// cluster API.
+	NodeID int64
+}
+
+type injectedError struct {
+	err   *Error
+	times int
+}
+
+// Server is a fake Element cluster served over TLS by httptest.
+type Server struct {
+	*httptest.Server
+
+	// Username and Password, when set, are required as HTTP Basic credentials.
+	Username string
+	Password string
+	// Version is the cluster version reported by GetAPI and GetClusterVersionInfo.
+	Version string
+	// AsyncDelay is how long clones and other async jobs stay "running".
+	AsyncDelay time.Duration
+	// Limits is returned by GetLimits and enforced where the simulator checks limits.
+	Limits sdk.GetLimitsResult
+	// ClusterInfo is returned by GetClusterInfo.
+	ClusterInfo sdk.ClusterInfo
+	// FullThreshold holds the thresholds returned by GetClusterFullThreshold. The
+	// used bytes and fullness stages are filled in from the latest capacity sample.
+	FullThreshold sdk.GetClusterFullThresholdResult
+	// SessionIdleTimeout and SessionFinalTimeout bound auth sessions created through
+	// CreateAuthSession and CreateIdpAuthSession.
+	SessionIdleTimeout  time.Duration
+	SessionFinalTimeout time.Duration
+
+	mu       sync.Mutex
+	now      func() time.Time
+	nextID   map[string]int64
+	custom   map[string]HandlerFunc
+	injected map[string]*injectedError
+	calls    []Call
+
+	accounts       map[int64]*sdk.Account
+	volumes        map[int64]*sdk.Volume
+	snapshots      map[int64]*sdk.Snapshot
+	groupSnapshots map[int64]*sdk.GroupSnapshot
+	vags           map[int64]*sdk.VolumeAccessGroup
+	luns           map[int64]map[int64]int64
+	initiators     map[int64]*sdk.Initiator
+	qosPolicies    map[int64]*sdk.QoSPolicy
+	schedules      map[int64]*sdk.Schedule
+	sessions       []sdk.ISCSISession
+	asyncJobs      map[int64]*asyncJob
+	data           map[int64][]byte
+	snapshotData   map[int64][]byte
+	authSessions   map[string]*sdk.AuthSessionInfo
+	events         []sdk.EventInfo
+	faults         []sdk.ClusterFaultInfo
+	capacity       []sdk.ClusterCapacity
+	nodes          []*NodeServer
+}
+
+// NewServer starts a simulator with an empty cluster. Call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		Version:       "12.5",
+		Limits:        defaultLimits(),
+		FullThreshold: defaultFullThreshold(),
+		now:           func() time.Time { return time.Now().UTC() },
+		nextID:        map[string]int64{},
+		custom:        map[string]HandlerFunc{},
+		injected:      map[string]*injectedError{},
+
+		accounts:       map[int64]*sdk.Account{},
+		volumes:        map[int64]*sdk.Volume{},
+		snapshots:      map[int64]*sdk.Snapshot{},
+		groupSnapshots: map[int64]*sdk.GroupSnapshot{},
+		vags:           map[int64]*sdk.VolumeAccessGroup{},
+		luns:           map[int64]map[int64]int64{},
+		initiators:     map[int64]*sdk.Initiator{},
+		qosPolicies:    map[int64]*sdk.QoSPolicy{},
+		schedules:      map[int64]*sdk.Schedule{},
+		asyncJobs:      map[int64]*asyncJob{},
+		data:           map[int64][]byte{},
+		snapshotData:   map[int64][]byte{},
+		authSessions:   map[string]*sdk.AuthSessionInfo{},
+
+		SessionIdleTimeout:  30 * time.Minute,
+		SessionFinalTimeout: 12 * time.Hour,
+	}
+	s.ClusterInfo = sdk.ClusterInfo{
+		Name:                       "sdktest",
+		Mvip:                       "127.0.0.1",
+		MvipNodeID:                 1,
+		Svip:                       "10.10.10.10",
+		SvipNodeID:                 1,
+		RepCount:                   2,
+		Ensemble:                   []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+		EncryptionAtRestState:      "disabled",
+		UniqueID:                   "sdkt",
+		Uuid:                       "6bde2e1a-2fb4-4a6c-9c3e-6e2f3c5d2b10",
+		DefaultProtectionScheme:    "doubleHelix",
+		EnabledProtectionSchemes:   []sdk.ProtectionScheme{"doubleHelix"},
+		SupportedProtectionSchemes: []sdk.ProtectionScheme{"doubleHelix"},
+		Attributes:                 map[string]interface{}{},
+	}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Host returns the host:port to pass to SFClient.Connect.
+func (s *Server) Host() string {
+	return strings.TrimPrefix(s.URL, "https://")
+}
+
+// ClientOptions returns the options an SFClient needs to trust the simulator.
+func (s *Server) ClientOptions() []sdk.ClientOption {
+	return []sdk.ClientOption{sdk.WithHTTPClient(s.Server.Client())}
+}
+
+// NewClient returns an SFClient connected to the simulator with the configured credentials.
+func (s *Server) NewClient(opts ...sdk.ClientOption) (*sdk.SFClient, error) {
+	sf, err := sdk.NewSFClient(append(s.ClientOptions(), opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	if sdkErr := sf.Connect(context.Background(), s.Host(), s.Version, s.Username, s.Password); sdkErr != nil {
+		return nil, sdkErr
+	}
+	return sf, nil
+}
+
+// Handle installs fn for method, replacing any built-in handler. Custom handlers
+// run without the server lock, so they may call other Server methods.
+func (s *Server) Handle(method string, fn HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.custom[method] = fn
+}
+
+// InjectError makes the next times calls to method fail with the given Element error.
+func (s *Server) InjectError(method, name, message string, times int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.injected[method] = &injectedError{err: Errorf(name, "%s", message), times: times}
+}
+
+// Calls returns the requests received so far, in order.
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Call(nil), s.calls...)
+}
+
+// CallCount returns how many times method was called.
+func (s *Server) CallCount(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, c := range s.calls {
+		if c.Method == method {
+			n++
+		}
+	}
+	return n
+}
+
+// SetClock replaces the server's time source, for deterministic timestamps and async jobs.
+func (s *Server) SetClock(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+type rpcRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type rpcError struct {
+	Code    int32  `json:"code"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+type rpcResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result interface{}     `json:"result,omitempty"`
+	Error  *rpcError       `json:"error,omitempty"`
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.serveRPC(w, r, s.dispatch)
+}
+
+// serveRPC decodes a JSON-RPC request for the cluster or a node API and writes the
+// result of dispatch.
+func (s *Server) serveRPC(w http.ResponseWriter, r *http.Request, dispatch func(version, method string, params json.RawMessage) (interface{}, error)) {
+	if r.Method != http.MethodPost || !strings.HasPrefix(r.URL.Path, "/json-rpc/") {
+		http.NotFound(w, r)
+		return
+	}
+	if !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="SolidFire"`)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req rpcRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		writeJSON(w, rpcResponse{Error: &rpcError{Code: 500, Name: "xJSONParseError", Message: err.Error()}})
+		return
+	}
+	version := strings.TrimPrefix(r.URL.Path, "/json-rpc/")
+	var result interface{}
+	var callErr error
+	if slices.Contains(s.supportedVersions(), version) {
+		result, callErr = dispatch(version, req.Method, req.Params)
+	} else {
+		callErr = Errorf("xUnknownAPIVersion", "Unknown API version %s", version)
+	}
+	resp := rpcResponse{ID: req.ID, Result: result}
+	if callErr != nil {
+		e, ok := callErr.(*Error)
+		if !ok {
+			e = Errorf("xUnknown", "%v", callErr)
+		}
+		resp = rpcResponse{ID: req.ID, Error: &rpcError{Code: e.Code, Name: e.Name, Message: e.Message}}
+	} else if result == nil {
+		resp.Result = struct{}{}
+	}
+	writeJSON(w, resp)
+}
+
+// authorized accepts the configured Basic credentials or a live auth session token
+// sent as "Authorization: Bearer <sessionID>".
+func (s *Server) authorized(r *http.Request) bool {
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return s.useSession(token)
+	}
+	if s.Username == "" && s.Password == "" {
+		return true
+	}
+	user, pass, ok := r.BasicAuth()
+	return ok && user == s.Username && pass == s.Password
+}
+
+func (s *Server) dispatch(version, method string, params json.RawMessage) (interface{}, error) {
+	s.mu.Lock()
+	s.calls = append(s.calls, Call{Method: method, Params: params, APIVersion: version})
+	if inj := s.injected[method]; inj != nil && inj.times > 0 {
+		inj.times--
+		s.mu.Unlock()
+		return nil, inj.err
+	}
+	if fn, ok := s.custom[method]; ok {
+		s.mu.Unlock()
+		return fn(params)
+	}
+	defer s.mu.Unlock()
+	h, ok := builtins[method]
+	if !ok {
+		return nil, Errorf("xUnknownAPIMethod", "Unknown method %s", method)
+	}
+	s.advanceAsync()
+	return h(s, params)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// decode unmarshals params into req, mapping failures to xInvalidParameter.
+func decode(params json.RawMessage, req interface{}) error {
+	if len(params) == 0 || string(params) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(params, req); err != nil {
+		return Errorf("xInvalidParameter", "Invalid parameters: %v", err)
+	}
+	return nil
+}
+
+func (s *Server) newID(kind string) int64 {
+	s.nextID[kind]++
+	return s.nextID[kind]
+}
+
+func (s *Server) timestamp() string {
+	return s.now().Format(time.RFC3339)
+}
+
+func emptyAttributes(attrs interface{}) interface{} {
+	if attrs == nil {
+		return map[string]interface{}{}
+	}
+	return attrs
+}
+
+func defaultLimits() sdk.GetLimitsResult {
+	return sdk.GetLimitsResult{
+		AccountCountMax:                        5000,
+		AccountNameLengthMax:                   64,
+		AccountNameLengthMin:                   1,
+		BulkVolumeJobsPerNodeMax:               8,
+		BulkVolumeJobsPerVolumeMax:             2,
+		CloneJobsPerVolumeMax:                  2,
+		ClusterPairsCountMax:                   4,
+		InitiatorNameLengthMax:                 224,
+		InitiatorCountMax:                      10000,
+		InitiatorsPerVolumeAccessGroupCountMax: 128,
+		IscsiSessionsFromFibreChannelNodesMax:  4096,
+		QosPolicyCountMax:                      500,
+		SecretLengthMax:                        16,
+		ScheduleNameLengthMax:                  244,
+		SecretLengthMin:                        12,
+		SnapshotNameLengthMax:                  255,
+		SnapshotsPerVolumeMax:                  32,
+		VolumeAccessGroupCountMax:              1000,
+		VolumeAccessGroupLunMax:                16383,
+		VolumeAccessGroupNameLengthMax:         64,
+		VolumeAccessGroupNameLengthMin:         1,
+		VolumeAccessGroupsPerInitiatorCountMax: 1,
+		VolumeAccessGroupsPerVolumeCountMax:    64,
+		InitiatorAliasLengthMax:                224,
+		VolumeBurstIOPSMax:                     200000,
+		VolumeBurstIOPSMin:                     100,
+		VolumeCountMax:                         4000,
+		VolumeMaxIOPSMax:                       200000,
+		VolumeMaxIOPSMin:                       100,
+		VolumeMinIOPSMax:                       15000,
+		VolumeMinIOPSMin:                       50,
+		VolumeNameLengthMax:                    64,
+		VolumeNameLengthMin:                    1,
+		VolumeSizeMax:                          17592186044416,
+		VolumeSizeMin:                          1000000000,
+		VolumesPerAccountCountMax:              2000,
+		VolumesPerGroupSnapshotMax:             32,
+		VolumesPerVolumeAccessGroupCountMax:    2000,
+		ClusterAdminAccountMax:                 5000,
+		FibreChannelVolumeAccessMax:            16384,
+		VirtualVolumesPerAccountCountMax:       10000,
+		VirtualVolumeCountMax:                  8000,
+	}
+}
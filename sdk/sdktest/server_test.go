@@ -0,0 +1,213 @@
+package sdktest
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func newTestClient(t *testing.T, srv *Server, opts ...sdk.ClientOption) *sdk.SFClient {
+	t.Helper()
+	sf, err := srv.NewClient(opts...)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return sf
+}
+
+func addAccount(t *testing.T, sf *sdk.SFClient, name string) int64 {
+	t.Helper()
+	res, err := sf.AddAccount(context.Background(), &sdk.AddAccountRequest{Username: name})
+	if err != nil {
+		t.Fatalf("AddAccount: %v", err)
+	}
+	return res.AccountID
+}
+
+func createVolume(t *testing.T, sf *sdk.SFClient, accountID int64, name string) sdk.Volume {
+	t.Helper()
+	res, err := sf.CreateVolume(context.Background(), &sdk.CreateVolumeRequest{Name: name, AccountID: accountID, TotalSize: 1 << 30})
+	if err != nil {
+		t.Fatalf("CreateVolume: %v", err)
+	}
+	return res.Volume
+}
+
+func TestBasicAuth(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.Username, srv.Password = "admin", "secret"
+
+	sf, err := sdk.NewSFClient(srv.ClientOptions()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdkErr := sf.Connect(context.Background(), srv.Host(), srv.Version, "admin", "wrong")
+	if !sdk.IsPermissionDenied(sdkErr) {
+		t.Fatalf("expected permission denied, got %v", sdkErr)
+	}
+	newTestClient(t, srv)
+}
+
+func TestVolumeLifecycle(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	sf := newTestClient(t, srv)
+	ctx := context.Background()
+
+	accountID := addAccount(t, sf, "tenant")
+	if _, err := sf.AddAccount(ctx, &sdk.AddAccountRequest{Username: "tenant"}); !sdk.IsAlreadyExists(err) {
+		t.Fatalf("expected duplicate account error, got %v", err)
+	}
+	vol := createVolume(t, sf, accountID, "vol1")
+	if vol.TotalSize != 1<<30 || vol.Status != "active" || vol.AccountID != accountID {
+		t.Fatalf("unexpected volume %+v", vol)
+	}
+
+	if _, err := sf.DeleteVolume(ctx, &sdk.DeleteVolumeRequest{VolumeID: vol.VolumeID}); err != nil {
+		t.Fatalf("DeleteVolume: %v", err)
+	}
+	deleted, err := sf.ListDeletedVolumes(ctx, &sdk.ListDeletedVolumesRequest{})
+	if err != nil || len(deleted.Volumes) != 1 {
+		t.Fatalf("ListDeletedVolumes: %v %+v", err, deleted)
+	}
+	_, err = sf.DeleteVolume(ctx, &sdk.DeleteVolumeRequest{VolumeID: vol.VolumeID})
+	if !sdk.IsNotFound(err) || err.Name != "xVolumeIDDoesNotExist" {
+		t.Fatalf("expected xVolumeIDDoesNotExist, got %v", err)
+	}
+}
+
+func TestSnapshotRollback(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	sf := newTestClient(t, srv)
+	ctx := context.Background()
+
+	vol := createVolume(t, sf, addAccount(t, sf, "tenant"), "vol1")
+	snap, err := sf.CreateSnapshot(ctx, &sdk.CreateSnapshotRequest{VolumeID: vol.VolumeID, Name: "before", Retention: "24:00:00"})
+	if err != nil {
+		t.Fatalf("CreateSnapshot: %v", err)
+	}
+	if snap.Snapshot.ExpirationTime == "" {
+		t.Fatal("retention did not set an expiration time")
+	}
+	rb, err := sf.RollbackToSnapshot(ctx, &sdk.RollbackToSnapshotRequest{VolumeID: vol.VolumeID, SnapshotID: snap.SnapshotID, SaveCurrentState: true, Name: "safety"})
+	if err != nil {
+		t.Fatalf("RollbackToSnapshot: %v", err)
+	}
+	if rb.SnapshotID == 0 || rb.Snapshot.Name != "safety" {
+		t.Fatalf("expected a safety snapshot, got %+v", rb)
+	}
+	list, err := sf.ListSnapshots(ctx, &sdk.ListSnapshotsRequest{VolumeID: vol.VolumeID})
+	if err != nil || len(list.Snapshots) != 2 {
+		t.Fatalf("ListSnapshots: %v %+v", err, list)
+	}
+}
+
+func TestCloneCompletesAfterDelay(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv.SetClock(func() time.Time { return now })
+	srv.AsyncDelay = time.Minute
+	sf := newTestClient(t, srv)
+	ctx := context.Background()
+
+	vol := createVolume(t, sf, addAccount(t, sf, "tenant"), "src")
+	clone, err := sf.CloneVolume(ctx, &sdk.CloneVolumeRequest{VolumeID: vol.VolumeID, Name: "dst"})
+	if err != nil {
+		t.Fatalf("CloneVolume: %v", err)
+	}
+	if clone.Volume.Status != "init" {
+		t.Fatalf("clone status %q, want init", clone.Volume.Status)
+	}
+	var percent int64 = -1
+	res, err := sf.GetAsyncResult(ctx, &sdk.GetAsyncResultRequest{AsyncHandle: clone.AsyncHandle, KeepResult: true})
+	if err != nil || res.Status != "running" || res.ResultType != "Clone" {
+		t.Fatalf("GetAsyncResult: %v %+v", err, res)
+	}
+	if ok, err := res.Details.Get("percentComplete", &percent); !ok || err != nil || percent != 0 {
+		t.Fatalf("details %s: %v", res.Details.Raw(), err)
+	}
+
+	now = now.Add(time.Minute)
+	res, err = sf.GetAsyncResult(ctx, &sdk.GetAsyncResultRequest{AsyncHandle: clone.AsyncHandle})
+	if err != nil || res.Status != "complete" || res.Error != nil {
+		t.Fatalf("GetAsyncResult: %v %+v", err, res)
+	}
+	if out, err := sdk.ResultAs[sdk.CloneAsyncResult](&res.Result); err != nil || out.VolumeID != clone.VolumeID || out.CloneID != clone.CloneID {
+		t.Fatalf("clone result %s: %v", res.Result.Raw(), err)
+	}
+	vols, _ := sf.ListVolumes(ctx, &sdk.ListVolumesRequest{VolumeIDs: []int64{clone.VolumeID}})
+	if len(vols.Volumes) != 1 || vols.Volumes[0].Status != "active" {
+		t.Fatalf("clone not active: %+v", vols.Volumes)
+	}
+	if _, err := sf.GetAsyncResult(ctx, &sdk.GetAsyncResultRequest{AsyncHandle: clone.AsyncHandle}); !sdk.IsNotFound(err) {
+		t.Fatalf("handle should be gone after a read without keepResult, got %v", err)
+	}
+}
+
+func TestAccessGroupLUNs(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	sf := newTestClient(t, srv)
+	ctx := context.Background()
+
+	accountID := addAccount(t, sf, "tenant")
+	v1 := createVolume(t, sf, accountID, "vol1")
+	v2 := createVolume(t, sf, accountID, "vol2")
+	vag, err := sf.CreateVolumeAccessGroup(ctx, &sdk.CreateVolumeAccessGroupRequest{
+		Name:       "host1",
+		Initiators: []string{"iqn.1993-08.org.debian:01:host1"},
+		Volumes:    []int64{v1.VolumeID, v2.VolumeID},
+	})
+	if err != nil {
+		t.Fatalf("CreateVolumeAccessGroup: %v", err)
+	}
+	if len(vag.VolumeAccessGroup.InitiatorIDs) != 1 {
+		t.Fatalf("initiator object was not created: %+v", vag.VolumeAccessGroup)
+	}
+	luns, err := sf.GetVolumeAccessGroupLunAssignments(ctx, &sdk.GetVolumeAccessGroupLunAssignmentsRequest{VolumeAccessGroupID: vag.VolumeAccessGroupID})
+	if err != nil {
+		t.Fatalf("GetVolumeAccessGroupLunAssignments: %v", err)
+	}
+	want := []sdk.LunAssignment{{VolumeID: v1.VolumeID, Lun: 0}, {VolumeID: v2.VolumeID, Lun: 1}}
+	got := luns.VolumeAccessGroupLunAssignments.LunAssignments
+	if len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("LUNs %+v, want %+v", got, want)
+	}
+	if _, err := sf.CreateVolumeAccessGroup(ctx, &sdk.CreateVolumeAccessGroupRequest{Name: "host2", Initiators: []string{"iqn.1993-08.org.debian:01:host1"}}); err == nil {
+		t.Fatal("an initiator may only be in one volume access group")
+	}
+}
+
+func TestInjectedErrorsAndRetries(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	policy := &sdk.RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	sf := newTestClient(t, srv, sdk.WithRetryPolicy(policy))
+
+	srv.InjectError("ListVolumes", "xClusterBusy", "busy", 2)
+	if _, err := sf.ListVolumes(context.Background(), &sdk.ListVolumesRequest{}); err != nil {
+		t.Fatalf("ListVolumes: %v", err)
+	}
+	if n := srv.CallCount("ListVolumes"); n != 3 {
+		t.Fatalf("ListVolumes called %d times, want 3", n)
+	}
+}
+
+func TestCustomHandler(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.Handle("GetClusterInfo", func(params json.RawMessage) (interface{}, error) {
+		return nil, Errorf("xNotPrimary", "try again")
+	})
+	sf := newTestClient(t, srv)
+	_, err := sf.GetClusterInfo(context.Background())
+	if err == nil || err.Name != "xNotPrimary" {
+		t.Fatalf("expected custom handler error, got %v", err)
+	}
+}
@@ -0,0 +1,312 @@
+package sdktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"CreateSnapshot":      (*Server).createSnapshot,
+		"ListSnapshots":       (*Server).listSnapshots,
+		"ModifySnapshot":      (*Server).modifySnapshot,
+		"DeleteSnapshot":      (*Server).deleteSnapshot,
+		"RollbackToSnapshot":  (*Server).rollbackToSnapshot,
+		"CreateGroupSnapshot": (*Server).createGroupSnapshot,
+		"ListGroupSnapshots":  (*Server).listGroupSnapshots,
+		"DeleteGroupSnapshot": (*Server).deleteGroupSnapshot,
+	})
+}
+
+// parseRetention parses the "HH:MM:SS" retention format; hours may exceed 24.
+func parseRetention(retention string) (time.Duration, error) {
+	parts := strings.Split(retention, ":")
+	if len(parts) != 3 {
+		return 0, Errorf("xInvalidParameter", "Invalid retention %q, expected HH:MM:SS", retention)
+	}
+	var d time.Duration
+	for i, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil || n < 0 {
+			return 0, Errorf("xInvalidParameter", "Invalid retention %q, expected HH:MM:SS", retention)
+		}
+		d += time.Duration(n) * unit
+	}
+	return d, nil
+}
+
+func (s *Server) snapshot(id int64) (*sdk.Snapshot, error) {
+	snap, ok := s.snapshots[id]
+	if !ok {
+		return nil, Errorf("xSnapshotIDDoesNotExist", "Snapshot %d does not exist.", id)
+	}
+	return snap, nil
+}
+
+// newSnapshot validates and stores a snapshot of v.
+func (s *Server) newSnapshot(v *sdk.Volume, name, retention string, remote bool, attrs interface{}) (*sdk.Snapshot, error) {
+	var count int64
+	for _, snap := range s.snapshots {
+		if snap.VolumeID == v.VolumeID {
+			count++
+		}
+	}
+	if count >= s.Limits.SnapshotsPerVolumeMax {
+		return nil, Errorf("xMaxSnapshotsPerVolumeExceeded", "Volume %d already has %d snapshots", v.VolumeID, count)
+	}
+	if int64(len(name)) > s.Limits.SnapshotNameLengthMax {
+		return nil, Errorf("xInvalidParameter", "Snapshot name is too long")
+	}
+	now := s.now()
+	if name == "" {
+		name = now.Format(time.RFC3339)
+	}
+	id := s.newID("snapshot")
+	snap := &sdk.Snapshot{
+		SnapshotID:              id,
+		VolumeID:                v.VolumeID,
+		Name:                    name,
+		Checksum:                fmt.Sprintf("0x%016x", uint64(id)*0x9e3779b97f4a7c15),
+		EnableRemoteReplication: remote,
+		ExpirationReason:        "None",
+		Status:                  "done",
+		SnapshotUUID:            fmt.Sprintf("%08x-0000-4000-9000-%012x", id, v.VolumeID),
+		InstanceSnapshotUUID:    fmt.Sprintf("%08x-0000-4000-a000-%012x", id, v.VolumeID),
+		TotalSize:               v.TotalSize,
+		CreateTime:              now.Format(time.RFC3339),
+		InstanceCreateTime:      now.Format(time.RFC3339),
+		VolumeName:              v.Name,
+		Attributes:              emptyAttributes(attrs),
+	}
+	if retention != "" {
+		d, err := parseRetention(retention)
+		if err != nil {
+			return nil, err
+		}
+		snap.ExpirationTime = now.Add(d).Format(time.RFC3339)
+		snap.ExpirationReason = "Api"
+	}
+	s.snapshots[id] = snap
+	if data, ok := s.data[v.VolumeID]; ok {
+		s.snapshotData[id] = bytes.Clone(data)
+	}
+	return snap, nil
+}
+
+func (s *Server) createSnapshot(params json.RawMessage) (interface{}, error) {
+	var req sdk.CreateSnapshotRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	v, err := s.volume(req.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := s.newSnapshot(v, req.Name, req.Retention, req.EnableRemoteReplication, req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	snap.SnapMirrorLabel = req.SnapMirrorLabel
+	return sdk.CreateSnapshotResult{Snapshot: *snap, SnapshotID: snap.SnapshotID, Checksum: snap.Checksum}, nil
+}
+
+func (s *Server) listSnapshots(params json.RawMessage) (interface{}, error) {
+	var req sdk.ListSnapshotsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if req.VolumeID != 0 {
+		if _, err := s.volume(req.VolumeID); err != nil {
+			return nil, err
+		}
+	}
+	out := []sdk.Snapshot{}
+	for _, id := range sortedKeys(s.snapshots) {
+		snap := s.snapshots[id]
+		if (req.VolumeID == 0 || snap.VolumeID == req.VolumeID) && (req.SnapshotID == 0 || id == req.SnapshotID) {
+			out = append(out, *snap)
+		}
+	}
+	return sdk.ListSnapshotsResult{Snapshots: out}, nil
+}
+
+func (s *Server) modifySnapshot(params json.RawMessage) (interface{}, error) {
+	var req sdk.ModifySnapshotRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	snap, err := s.snapshot(req.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	switch req.ExpirationTime {
+	case "":
+	case "null":
+		snap.ExpirationTime = ""
+		snap.ExpirationReason = "None"
+	default:
+		if _, err := time.Parse(time.RFC3339, req.ExpirationTime); err != nil {
+			return nil, Errorf("xInvalidParameter", "Invalid expirationTime %q", req.ExpirationTime)
+		}
+		snap.ExpirationTime = req.ExpirationTime
+		snap.ExpirationReason = "Api"
+	}
+	if req.EnableRemoteReplication != nil {
+		snap.EnableRemoteReplication = *req.EnableRemoteReplication
+	}
+	if req.SnapMirrorLabel != "" {
+		snap.SnapMirrorLabel = req.SnapMirrorLabel
+	}
+	return sdk.ModifySnapshotResult{Snapshot: *snap}, nil
+}
+
+func (s *Server) deleteSnapshot(params json.RawMessage) (interface{}, error) {
+	var req sdk.DeleteSnapshotRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if _, err := s.snapshot(req.SnapshotID); err != nil {
+		return nil, err
+	}
+	delete(s.snapshots, req.SnapshotID)
+	return sdk.DeleteSnapshotResult{}, nil
+}
+
+func (s *Server) rollbackToSnapshot(params json.RawMessage) (interface{}, error) {
+	var req sdk.RollbackToSnapshotRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	v, err := s.volume(req.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := s.snapshot(req.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	if snap.VolumeID != v.VolumeID {
+		return nil, Errorf("xSnapshotIDDoesNotExist", "Snapshot %d does not belong to volume %d.", snap.SnapshotID, v.VolumeID)
+	}
+	var res sdk.RollbackToSnapshotResult
+	if req.SaveCurrentState {
+		saved, err := s.newSnapshot(v, req.Name, "", false, req.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		res = sdk.RollbackToSnapshotResult{Snapshot: *saved, SnapshotID: saved.SnapshotID, Checksum: saved.Checksum}
+	}
+	v.TotalSize = snap.TotalSize
+	return res, nil
+}
+
+// groupSnapshotView refreshes the members of g from the stored snapshots.
+func (s *Server) groupSnapshotView(g *sdk.GroupSnapshot) sdk.GroupSnapshot {
+	out := *g
+	out.Members = []sdk.Snapshot{}
+	for _, id := range sortedKeys(s.snapshots) {
+		if s.snapshots[id].GroupID == g.GroupSnapshotID {
+			out.Members = append(out.Members, *s.snapshots[id])
+		}
+	}
+	return out
+}
+
+func (s *Server) createGroupSnapshot(params json.RawMessage) (interface{}, error) {
+	var req sdk.CreateGroupSnapshotRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if len(req.Volumes) == 0 || int64(len(req.Volumes)) > s.Limits.VolumesPerGroupSnapshotMax {
+		return nil, Errorf("xInvalidParameter", "A group snapshot needs 1 to %d volumes", s.Limits.VolumesPerGroupSnapshotMax)
+	}
+	vols := make([]*sdk.Volume, 0, len(req.Volumes))
+	for _, id := range req.Volumes {
+		v, err := s.volume(id)
+		if err != nil {
+			return nil, err
+		}
+		vols = append(vols, v)
+	}
+	id := s.newID("groupSnapshot")
+	g := &sdk.GroupSnapshot{
+		GroupSnapshotID:         id,
+		GroupSnapshotUUID:       fmt.Sprintf("%08x-0000-4000-b000-%012x", id, id),
+		Name:                    req.Name,
+		CreateTime:              s.timestamp(),
+		Status:                  "done",
+		EnableRemoteReplication: req.EnableRemoteReplication,
+		Attributes:              emptyAttributes(req.Attributes),
+	}
+	if g.Name == "" {
+		g.Name = g.CreateTime
+	}
+	res := sdk.CreateGroupSnapshotResult{GroupSnapshotID: id}
+	for _, v := range vols {
+		snap, err := s.newSnapshot(v, g.Name, req.Retention, req.EnableRemoteReplication, req.Attributes)
+		if err != nil {
+			for _, m := range res.Members {
+				delete(s.snapshots, m.SnapshotID)
+			}
+			return nil, err
+		}
+		snap.GroupID = id
+		snap.GroupSnapshotUUID = g.GroupSnapshotUUID
+		snap.SnapMirrorLabel = req.SnapMirrorLabel
+		res.Members = append(res.Members, sdk.GroupSnapshotMembers{VolumeID: v.VolumeID, SnapshotID: snap.SnapshotID, Checksum: snap.Checksum})
+	}
+	s.groupSnapshots[id] = g
+	res.GroupSnapshot = s.groupSnapshotView(g)
+	return res, nil
+}
+
+func (s *Server) listGroupSnapshots(params json.RawMessage) (interface{}, error) {
+	var req sdk.ListGroupSnapshotsRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	out := []sdk.GroupSnapshot{}
+	for _, id := range sortedKeys(s.groupSnapshots) {
+		if req.GroupSnapshotID != 0 && id != req.GroupSnapshotID {
+			continue
+		}
+		view := s.groupSnapshotView(s.groupSnapshots[id])
+		if len(req.Volumes) > 0 && !slices.ContainsFunc(view.Members, func(m sdk.Snapshot) bool {
+			return slices.Contains(req.Volumes, m.VolumeID)
+		}) {
+			continue
+		}
+		out = append(out, view)
+	}
+	return sdk.ListGroupSnapshotsResult{GroupSnapshots: out}, nil
+}
+
+func (s *Server) deleteGroupSnapshot(params json.RawMessage) (interface{}, error) {
+	var req sdk.DeleteGroupSnapshotRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if _, ok := s.groupSnapshots[req.GroupSnapshotID]; !ok {
+		return nil, Errorf("xGroupSnapshotIDDoesNotExist", "Group snapshot %d does not exist.", req.GroupSnapshotID)
+	}
+	for id, snap := range s.snapshots {
+		if snap.GroupID != req.GroupSnapshotID {
+			continue
+		}
+		if req.SaveMembers {
+			snap.GroupID = 0
+			snap.GroupSnapshotUUID = ""
+		} else {
+			delete(s.snapshots, id)
+		}
+	}
+	delete(s.groupSnapshots, req.GroupSnapshotID)
+	return sdk.DeleteGroupSnapshotResult{}, nil
+}
@@ -0,0 +1,401 @@
+package sdktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+)
+
+func init() {
+	register(map[string]builtinHandler{
+		"CreateVolume":          (*Server).createVolume,
+		"ModifyVolume":          (*Server).modifyVolume,
+		"DeleteVolume":          (*Server).deleteVolume,
+		"RestoreDeletedVolume":  (*Server).restoreDeletedVolume,
+		"PurgeDeletedVolume":    (*Server).purgeDeletedVolume,
+		"ListVolumes":           (*Server).listVolumes,
+		"ListActiveVolumes":     (*Server).listActiveVolumes,
+		"ListVolumesForAccount": (*Server).listVolumesForAccount,
+		"ListDeletedVolumes":    (*Server).listDeletedVolumes,
+	})
+}
+
+const (
+	mebibyte = 1 << 20
+	// purgeDelay is how long Element keeps a deleted volume before purging it.
+	purgeDelay = 8 * time.Hour
+)
+
+var defaultQoS = sdk.VolumeQOS{MinIOPS: 50, MaxIOPS: 15000, BurstIOPS: 15000, BurstTime: 60}
+
+// volumeView returns a copy of v with its access group membership filled in.
+func (s *Server) volumeView(v *sdk.Volume) sdk.Volume {
+	out := *v
+	out.VolumeAccessGroups = []int64{}
+	for _, id := range sortedKeys(s.vags) {
+		for _, member := range s.vags[id].Volumes {
+			if member == v.VolumeID {
+				out.VolumeAccessGroups = append(out.VolumeAccessGroups, id)
+			}
+		}
+	}
+	if out.VolumePairs == nil {
+		out.VolumePairs = []sdk.VolumePair{}
+	}
+	return out
+}
+
+// volume returns the volume with id, failing for unknown and deleted volumes.
+func (s *Server) volume(id int64) (*sdk.Volume, error) {
+	v, ok := s.volumes[id]
+	if !ok || v.Status == "deleted" {
+		return nil, Errorf("xVolumeIDDoesNotExist", "Volume %d does not exist.", id)
+	}
+	return v, nil
+}
+
+func (s *Server) validVolumeName(name string) error {
+	if n := int64(len(name)); n < s.Limits.VolumeNameLengthMin || n > s.Limits.VolumeNameLengthMax {
+		return Errorf("xInvalidParameter", "Volume name must be %d to %d characters", s.Limits.VolumeNameLengthMin, s.Limits.VolumeNameLengthMax)
+	}
+	return nil
+}
+
+func (s *Server) validVolumeSize(size int64) error {
+	if size < s.Limits.VolumeSizeMin || size > s.Limits.VolumeSizeMax {
+		return Errorf("xInvalidParameter", "Volume size %d is outside the range %d to %d", size, s.Limits.VolumeSizeMin, s.Limits.VolumeSizeMax)
+	}
+	return nil
+}
+
+// applyQoS merges requested QoS values into the current settings and validates them.
+func (s *Server) applyQoS(current sdk.VolumeQOS, q *sdk.QoS) (sdk.VolumeQOS, error) {
+	if q == nil {
+		return current, nil
+	}
+	if q.MinIOPS != 0 {
+		current.MinIOPS = q.MinIOPS
+	}
+	if q.MaxIOPS != 0 {
+		current.MaxIOPS = q.MaxIOPS
+	}
+	if q.BurstIOPS != 0 {
+		current.BurstIOPS = q.BurstIOPS
+	}
+	if q.BurstTime != 0 {
+		current.BurstTime = q.BurstTime
+	}
+	switch {
+	case current.MinIOPS < s.Limits.VolumeMinIOPSMin || current.MinIOPS > s.Limits.VolumeMinIOPSMax:
+		return current, Errorf("xInvalidParameter", "minIOPS %d is out of range", current.MinIOPS)
+	case current.MaxIOPS < s.Limits.VolumeMaxIOPSMin || current.MaxIOPS > s.Limits.VolumeMaxIOPSMax:
+		return current, Errorf("xInvalidParameter", "maxIOPS %d is out of range", current.MaxIOPS)
+	case current.BurstIOPS < s.Limits.VolumeBurstIOPSMin || current.BurstIOPS > s.Limits.VolumeBurstIOPSMax:
+		return current, Errorf("xInvalidParameter", "burstIOPS %d is out of range", current.BurstIOPS)
+	case current.MinIOPS > current.MaxIOPS || current.MaxIOPS > current.BurstIOPS:
+		return current, Errorf("xInvalidParameter", "QoS values must satisfy minIOPS <= maxIOPS <= burstIOPS")
+	}
+	return current, nil
+}
+
+// newVolume creates and stores a volume; shared by CreateVolume and the clone methods.
+func (s *Server) newVolume(name string, accountID, size int64, enable512e bool, attrs interface{}) *sdk.Volume {
+	id := s.newID("volume")
+	size = (size + mebibyte - 1) / mebibyte * mebibyte
+	v := &sdk.Volume{
+		VolumeID:                id,
+		Name:                    name,
+		AccountID:               accountID,
+		CreateTime:              s.timestamp(),
+		VolumeUUID:              fmt.Sprintf("%08x-0000-4000-8000-%012x", id, id),
+		Status:                  "active",
+		Access:                  "readWrite",
+		Enable512e:              enable512e,
+		Iqn:                     fmt.Sprintf("iqn.2010-01.com.solidfire:%s.%s.%d", s.ClusterInfo.UniqueID, strings.ToLower(name), id),
+		ScsiEUIDeviceID:         fmt.Sprintf("%s%08xf47acc0100000000", s.uniqueIDHex(), id),
+		ScsiNAADeviceID:         fmt.Sprintf("6f47acc100000000%s%08x", s.uniqueIDHex(), id),
+		Qos:                     defaultQoS,
+		SliceCount:              1,
+		TotalSize:               size,
+		BlockSize:               4096,
+		Attributes:              emptyAttributes(attrs),
+		CurrentProtectionScheme: s.ClusterInfo.DefaultProtectionScheme,
+	}
+	s.volumes[id] = v
+	return v
+}
+
+func (s *Server) uniqueIDHex() string {
+	return fmt.Sprintf("%08x", []byte(s.ClusterInfo.UniqueID + "\x00\x00\x00\x00")[:4])
+}
+
+func (s *Server) accountVolumeCount(accountID int64) int64 {
+	var n int64
+	for _, v := range s.volumes {
+		if v.AccountID == accountID {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *Server) checkVolumeLimits(accountID int64) error {
+	if int64(len(s.volumes)) >= s.Limits.VolumeCountMax {
+		return Errorf("xMaxVolumesExceeded", "The maximum number of volumes has been reached")
+	}
+	if s.accountVolumeCount(accountID) >= s.Limits.VolumesPerAccountCountMax {
+		return Errorf("xMaxVolumesPerAccountExceeded", "Account %d has reached the maximum number of volumes", accountID)
+	}
+	return nil
+}
+
+func (s *Server) createVolume(params json.RawMessage) (interface{}, error) {
+	var req sdk.CreateVolumeRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validVolumeName(req.Name); err != nil {
+		return nil, err
+	}
+	if _, err := s.account(req.AccountID); err != nil {
+		return nil, err
+	}
+	if err := s.validVolumeSize(req.TotalSize); err != nil {
+		return nil, err
+	}
+	if err := s.checkVolumeLimits(req.AccountID); err != nil {
+		return nil, err
+	}
+	qos, err := s.applyQoS(defaultQoS, req.Qos)
+	if err != nil {
+		return nil, err
+	}
+	var policy *sdk.QoSPolicy
+	if req.QosPolicyID != 0 {
+		if policy, err = s.qosPolicy(req.QosPolicyID); err != nil {
+			return nil, err
+		}
+	}
+
+	v := s.newVolume(req.Name, req.AccountID, req.TotalSize, req.Enable512e, req.Attributes)
+	v.Qos = qos
+	v.EnableSnapMirrorReplication = req.EnableSnapMirrorReplication
+	if req.Access != "" {
+		v.Access = sdk.VolumeAccess(req.Access)
+	}
+	if policy != nil {
+		s.associatePolicy(v, policy)
+	}
+	return sdk.CreateVolumeResult{VolumeID: v.VolumeID, Volume: s.volumeView(v)}, nil
+}
+
+func (s *Server) modifyVolume(params json.RawMessage) (interface{}, error) {
+	var req sdk.ModifyVolumeRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	v, err := s.volume(req.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+	if req.AccountID != 0 && req.AccountID != v.AccountID {
+		if _, err := s.account(req.AccountID); err != nil {
+			return nil, err
+		}
+	}
+	if req.TotalSize != 0 {
+		if req.TotalSize < v.TotalSize {
+			return nil, Errorf("xVolumeShrinkProhibited", "Volume %d cannot be shrunk", v.VolumeID)
+		}
+		if err := s.validVolumeSize(req.TotalSize); err != nil {
+			return nil, err
+		}
+	}
+	qos, err := s.applyQoS(v.Qos, req.Qos)
+	if err != nil {
+		return nil, err
+	}
+	var policy *sdk.QoSPolicy
+	if req.QosPolicyID != 0 {
+		if policy, err = s.qosPolicy(req.QosPolicyID); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.AccountID != 0 {
+		v.AccountID = req.AccountID
+	}
+	if req.Access != "" {
+		v.Access = sdk.VolumeAccess(req.Access)
+	}
+	if req.TotalSize != 0 {
+		v.TotalSize = (req.TotalSize + mebibyte - 1) / mebibyte * mebibyte
+	}
+	if req.Attributes != nil {
+		v.Attributes = req.Attributes
+	}
+	if req.SetCreateTime && req.CreateTime != "" {
+		v.CreateTime = req.CreateTime
+	}
+	switch {
+	case policy != nil:
+		s.associatePolicy(v, policy)
+	case req.Qos != nil:
+		s.dissociatePolicy(v)
+		v.Qos = qos
+	}
+	return sdk.ModifyVolumeResult{Volume: s.volumeView(v)}, nil
+}
+
+func (s *Server) deleteVolume(params json.RawMessage) (interface{}, error) {
+	var req sdk.DeleteVolumeRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	v, err := s.volume(req.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	v.Status = "deleted"
+	v.DeleteTime = now.Format(time.RFC3339)
+	v.PurgeTime = now.Add(purgeDelay).Format(time.RFC3339)
+	for _, vag := range s.vags {
+		if removeID(&vag.Volumes, v.VolumeID) {
+			vag.DeletedVolumes = append(vag.DeletedVolumes, v.VolumeID)
+		}
+	}
+	return sdk.DeleteVolumeResult{Volume: s.volumeView(v)}, nil
+}
+
+func (s *Server) deletedVolume(id int64) (*sdk.Volume, error) {
+	v, ok := s.volumes[id]
+	if !ok {
+		return nil, Errorf("xVolumeIDDoesNotExist", "Volume %d does not exist.", id)
+	}
+	if v.Status != "deleted" {
+		return nil, Errorf("xVolumeNotDeleted", "Volume %d is not deleted.", id)
+	}
+	return v, nil
+}
+
+func (s *Server) restoreDeletedVolume(params json.RawMessage) (interface{}, error) {
+	var req sdk.RestoreDeletedVolumeRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	v, err := s.deletedVolume(req.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+	v.Status = "active"
+	v.DeleteTime = ""
+	v.PurgeTime = ""
+	for _, vag := range s.vags {
+		if removeID(&vag.DeletedVolumes, v.VolumeID) {
+			vag.Volumes = append(vag.Volumes, v.VolumeID)
+		}
+	}
+	return sdk.RestoreDeletedVolumeResult{}, nil
+}
+
+func (s *Server) purgeDeletedVolume(params json.RawMessage) (interface{}, error) {
+	var req sdk.PurgeDeletedVolumeRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	v, err := s.deletedVolume(req.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+	s.dissociatePolicy(v)
+	for _, vag := range s.vags {
+		removeID(&vag.DeletedVolumes, v.VolumeID)
+		delete(s.luns[vag.VolumeAccessGroupID], v.VolumeID)
+	}
+	for id, snap := range s.snapshots {
+		if snap.VolumeID == v.VolumeID {
+			delete(s.snapshots, id)
+		}
+	}
+	delete(s.volumes, v.VolumeID)
+	return sdk.PurgeDeletedVolumeResult{}, nil
+}
+
+// pageVolumes returns up to limit volumes with ID >= start that match keep, in ID order.
+func (s *Server) pageVolumes(start, limit int64, keep func(*sdk.Volume) bool) []sdk.Volume {
+	out := []sdk.Volume{}
+	for _, id := range sortedKeys(s.volumes) {
+		if id < start || !keep(s.volumes[id]) {
+			continue
+		}
+		if limit > 0 && int64(len(out)) >= limit {
+			break
+		}
+		out = append(out, s.volumeView(s.volumes[id]))
+	}
+	return out
+}
+
+func (s *Server) listVolumes(params json.RawMessage) (interface{}, error) {
+	var req sdk.ListVolumesRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	accounts := map[int64]bool{}
+	for _, id := range req.Accounts {
+		accounts[id] = true
+	}
+	ids := map[int64]bool{}
+	for _, id := range req.VolumeIDs {
+		ids[id] = true
+	}
+	vols := s.pageVolumes(req.StartVolumeID, req.Limit, func(v *sdk.Volume) bool {
+		return (req.VolumeStatus == "" || v.Status == req.VolumeStatus) &&
+			(len(accounts) == 0 || accounts[v.AccountID]) &&
+			(len(ids) == 0 || ids[v.VolumeID]) &&
+			(req.VolumeName == "" || v.Name == req.VolumeName) &&
+			(!req.IsPaired || len(v.VolumePairs) > 0)
+	})
+	return sdk.ListVolumesResult{Volumes: vols}, nil
+}
+
+func (s *Server) listActiveVolumes(params json.RawMessage) (interface{}, error) {
+	var req sdk.ListActiveVolumesRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	vols := s.pageVolumes(req.StartVolumeID, req.Limit, func(v *sdk.Volume) bool { return v.Status != "deleted" })
+	return sdk.ListActiveVolumesResult{Volumes: vols}, nil
+}
+
+func (s *Server) listVolumesForAccount(params json.RawMessage) (interface{}, error) {
+	var req sdk.ListVolumesForAccountRequest
+	if err := decode(params, &req); err != nil {
+		return nil, err
+	}
+	if _, err := s.account(req.AccountID); err != nil {
+		return nil, err
+	}
+	vols := s.pageVolumes(req.StartVolumeID, req.Limit, func(v *sdk.Volume) bool { return v.AccountID == req.AccountID })
+	return sdk.ListVolumesForAccountResult{Volumes: vols}, nil
+}
+
+func (s *Server) listDeletedVolumes(params json.RawMessage) (interface{}, error) {
+	vols := s.pageVolumes(0, 0, func(v *sdk.Volume) bool { return v.Status == "deleted" })
+	return sdk.ListDeletedVolumesResult{Volumes: vols}, nil
+}
+
+// removeID deletes id from *ids and reports whether it was present.
+func removeID(ids *[]int64, id int64) bool {
+	for i, v := range *ids {
+		if v == id {
+			*ids = append((*ids)[:i], (*ids)[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package sdk_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/scaleoutsean/solidfire-go/sdk"
+	"github.com/scaleoutsean/solidfire-go/sdk/sdktest"
+)
+
+// newSimulator starts an in-memory cluster and returns it with a client connected to it.
+func newSimulator(t *testing.T) (*sdktest.Server, *sdk.SFClient) {
+	t.Helper()
+	srv := sdktest.NewServer()
+	t.Cleanup(srv.Close)
+	sf, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, sf
+}
+
+// createVolumes adds an account with a 1GiB volume for each name and returns the
+// account ID and the volume IDs in the order of names.
+func createVolumes(t *testing.T, sf *sdk.SFClient, names ...string) (int64, []int64) {
+	t.Helper()
+	ctx := context.Background()
+	acct, err := sf.AddAccount(ctx, &sdk.AddAccountRequest{Username: "tenant"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for _, name := range names {
+		v, err := sf.CreateVolume(ctx, &sdk.CreateVolumeRequest{Name: name, AccountID: acct.AccountID, TotalSize: 1 << 30})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, v.VolumeID)
+	}
+	return acct.AccountID, ids
+}
+
+// waitFor polls cond until it holds, failing the test after two seconds.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
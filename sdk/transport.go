@@ -0,0 +1,344 @@
+package sdk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ClientOption configures an SFClient built with NewSFClient.
+type ClientOption func(*SFClient) error
+
+// transportConfig holds the HTTP/TLS settings used to build the client's transport.
+// The zero value verifies the MVIP certificate against the system roots.
+type transportConfig struct {
+	caPEM               [][]byte
+	spkiPins            [][]byte
+	certPins            [][]byte
+	clientCerts         []tls.Certificate
+	insecureSkipVerify  bool
+	timeout             time.Duration
+	dialTimeout         time.Duration
+	keepAlive           time.Duration
+	tlsHandshakeTimeout time.Duration
+	idleConnTimeout     time.Duration
+	maxIdleConns        int
+	maxIdleConnsPerHost int
+	proxy               func(*http.Request) (*url.URL, error)
+}
+
+// NewSFClient returns an SFClient that owns its own transport, so TLS settings and
+// pooled keep-alive connections are not shared with other HTTP users in the process.
+// Call Connect on the result to set the endpoint and credentials.
+func NewSFClient(opts ...ClientOption) (*SFClient, error) {
+	sfClient := &SFClient{}
+	for _, opt := range opts {
+		if err := opt(sfClient); err != nil {
+			return nil, err
+		}
+	}
+	if sfClient.httpClient == nil {
+		httpClient, err := sfClient.transport.newHTTPClient()
+		if err != nil {
+			return nil, err
+		}
+		sfClient.httpClient = httpClient
+	}
+	if sfClient.cassette != nil {
+		sfClient.httpClient = sfClient.cassette.wrap(sfClient.httpClient)
+	}
+	return sfClient, nil
+}
+
+// WithCACertFile trusts the PEM encoded CA bundle in path when verifying the MVIP certificate.
+func WithCACertFile(path string) ClientOption {
+	return func(sfClient *SFClient) error {
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading CA bundle %s: %w", path, err)
+		}
+		sfClient.transport.caPEM = append(sfClient.transport.caPEM, pem)
+		return nil
+	}
+}
+
+// WithCACertPEM trusts the given PEM encoded CA certificates when verifying the MVIP certificate.
+func WithCACertPEM(pem []byte) ClientOption {
+	return func(sfClient *SFClient) error {
+		sfClient.transport.caPEM = append(sfClient.transport.caPEM, pem)
+		return nil
+	}
+}
+
+// WithPinnedSPKI pins the SHA-256 digest of the server's SubjectPublicKeyInfo.
+// Pins are accepted as "sha256/<base64>" (see SPKIFingerprint) or as hex.
+// When no CA bundle is configured the pin replaces chain verification, which is
+// what most clusters running the factory self-signed certificate need.
+func WithPinnedSPKI(pins ...string) ClientOption {
+	return func(sfClient *SFClient) error {
+		for _, pin := range pins {
+			digest, err := decodePin(pin)
+			if err != nil {
+				return err
+			}
+			sfClient.transport.spkiPins = append(sfClient.transport.spkiPins, digest)
+		}
+		return nil
+	}
+}
+
+// WithPinnedCertificate pins the SHA-256 fingerprint of the server's leaf certificate.
+// Fingerprints are hex, with or without colons, as printed by "openssl x509 -fingerprint -sha256".
+func WithPinnedCertificate(fingerprints ...string) ClientOption {
+	return func(sfClient *SFClient) error {
+		for _, fp := range fingerprints {
+			digest, err := decodePin(fp)
+			if err != nil {
+				return err
+			}
+			sfClient.transport.certPins = append(sfClient.transport.certPins, digest)
+		}
+		return nil
+	}
+}
+
+// WithClientCertificate presents cert to the cluster during the TLS handshake.
+func WithClientCertificate(cert tls.Certificate) ClientOption {
+	return func(sfClient *SFClient) error {
+		sfClient.transport.clientCerts = append(sfClient.transport.clientCerts, cert)
+		return nil
+	}
+}
+
+// WithClientCertificateFiles loads a PEM encoded client certificate and key pair.
+func WithClientCertificateFiles(certFile, keyFile string) ClientOption {
+	return func(sfClient *SFClient) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return fmt.Errorf("loading client certificate: %w", err)
+		}
+		sfClient.transport.clientCerts = append(sfClient.transport.clientCerts, cert)
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify disables verification of the MVIP certificate chain and host name.
+// Pins configured with WithPinnedSPKI or WithPinnedCertificate are still enforced.
+func WithInsecureSkipVerify() ClientOption {
+	return func(sfClient *SFClient) error {
+		sfClient.transport.insecureSkipVerify = true
+		return nil
+	}
+}
+
+// WithTimeout limits the total time of a single HTTP exchange, including reading the body.
+// Zero, the default, relies on the caller's context alone.
+func WithTimeout(d time.Duration) ClientOption {
+	return func(sfClient *SFClient) error {
+		sfClient.transport.timeout = d
+		return nil
+	}
+}
+
+// WithDialTimeout limits how long establishing a TCP connection may take.
+func WithDialTimeout(d time.Duration) ClientOption {
+	return func(sfClient *SFClient) error {
+		sfClient.transport.dialTimeout = d
+		return nil
+	}
+}
+
+// WithTLSHandshakeTimeout limits how long the TLS handshake may take.
+func WithTLSHandshakeTimeout(d time.Duration) ClientOption {
+	return func(sfClient *SFClient) error {
+		sfClient.transport.tlsHandshakeTimeout = d
+		return nil
+	}
+}
+
+// WithKeepAlive sets the TCP keep-alive period and how long idle pooled connections are kept.
+func WithKeepAlive(keepAlive, idleConnTimeout time.Duration) ClientOption {
+	return func(sfClient *SFClient) error {
+		sfClient.transport.keepAlive = keepAlive
+		sfClient.transport.idleConnTimeout = idleConnTimeout
+		return nil
+	}
+}
+
+// WithMaxIdleConns sizes the keep-alive pool, in total and per host.
+func WithMaxIdleConns(total, perHost int) ClientOption {
+	return func(sfClient *SFClient) error {
+		sfClient.transport.maxIdleConns = total
+		sfClient.transport.maxIdleConnsPerHost = perHost
+		return nil
+	}
+}
+
+// WithProxy sends requests through the given proxy URL. By default the
+// HTTPS_PROXY and NO_PROXY environment variables are honored.
+func WithProxy(proxyURL string) ClientOption {
+	return func(sfClient *SFClient) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("parsing proxy URL: %w", err)
+		}
+		sfClient.transport.proxy = http.ProxyURL(u)
+		return nil
+	}
+}
+
+// WithHTTPClient uses httpClient as is, ignoring all other transport options.
+// This is mostly useful for tests and for callers that already manage a tuned client.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(sfClient *SFClient) error {
+		if httpClient == nil {
+			return errors.New("nil http.Client")
+		}
+		sfClient.httpClient = httpClient
+		return nil
+	}
+}
+
+// SPKIFingerprint returns the "sha256/<base64>" pin of cert's public key, suitable for WithPinnedSPKI.
+func SPKIFingerprint(cert *x509.Certificate) string {
+	digest := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return "sha256/" + base64.StdEncoding.EncodeToString(digest[:])
+}
+
+func decodePin(pin string) ([]byte, error) {
+	var digest []byte
+	var err error
+	if b64, ok := strings.CutPrefix(pin, "sha256/"); ok {
+		digest, err = base64.StdEncoding.DecodeString(b64)
+	} else {
+		digest, err = hex.DecodeString(strings.ReplaceAll(pin, ":", ""))
+	}
+	if err != nil || len(digest) != sha256.Size {
+		return nil, fmt.Errorf("invalid SHA-256 pin %q", pin)
+	}
+	return digest, nil
+}
+
+func (tc *transportConfig) tlsConfig() (*tls.Config, error) {
+	conf := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: tc.clientCerts,
+	}
+	if len(tc.caPEM) > 0 {
+		pool := x509.NewCertPool()
+		for _, pem := range tc.caPEM {
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, errors.New("no certificates found in CA bundle")
+			}
+		}
+		conf.RootCAs = pool
+	}
+
+	pinned := len(tc.spkiPins) > 0 || len(tc.certPins) > 0
+	switch {
+	case tc.insecureSkipVerify:
+		log.Warn("TLS certificate verification is disabled for this SFClient")
+		conf.InsecureSkipVerify = true
+	case pinned && conf.RootCAs == nil:
+		// The pin is the trust anchor; VerifyConnection below enforces it.
+		conf.InsecureSkipVerify = true
+	}
+	if pinned {
+		conf.VerifyConnection = tc.verifyPins
+	}
+	return conf, nil
+}
+
+func (tc *transportConfig) verifyPins(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+	leaf := cs.PeerCertificates[0]
+	spki := sha256.Sum256(leaf.RawSubjectPublicKeyInfo)
+	for _, pin := range tc.spkiPins {
+		if bytes.Equal(pin, spki[:]) {
+			return nil
+		}
+	}
+	fingerprint := sha256.Sum256(leaf.Raw)
+	for _, pin := range tc.certPins {
+		if bytes.Equal(pin, fingerprint[:]) {
+			return nil
+		}
+	}
+	return &pinMismatchError{serverName: cs.ServerName, fingerprint: SPKIFingerprint(leaf)}
+}
+
+// pinMismatchError reports a server certificate that matched none of the configured pins.
+type pinMismatchError struct {
+	serverName  string
+	fingerprint string
+}
+
+func (e *pinMismatchError) Error() string {
+	return fmt.Sprintf("certificate for %s (%s) does not match any pinned key", e.serverName, e.fingerprint)
+}
+
+func (tc *transportConfig) newHTTPClient() (*http.Client, error) {
+	tlsConf, err := tc.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{
+		Timeout:   durationOr(tc.dialTimeout, 30*time.Second),
+		KeepAlive: durationOr(tc.keepAlive, 30*time.Second),
+	}
+	proxy := tc.proxy
+	if proxy == nil {
+		proxy = http.ProxyFromEnvironment
+	}
+	transport := &http.Transport{
+		Proxy:               proxy,
+		DialContext:         dialer.DialContext,
+		TLSClientConfig:     tlsConf,
+		TLSHandshakeTimeout: durationOr(tc.tlsHandshakeTimeout, 10*time.Second),
+		IdleConnTimeout:     durationOr(tc.idleConnTimeout, 90*time.Second),
+		MaxIdleConns:        intOr(tc.maxIdleConns, 100),
+		MaxIdleConnsPerHost: intOr(tc.maxIdleConnsPerHost, 10),
+		ForceAttemptHTTP2:   true,
+	}
+	return &http.Client{Transport: transport, Timeout: tc.timeout}, nil
+}
+
+// client returns the HTTP client used for API calls. A zero value SFClient
+// lazily gets a verifying client with default settings on first use.
+func (sfClient *SFClient) client() (*http.Client, error) {
+	sfClient.initOnce.Do(func() {
+		if sfClient.httpClient == nil {
+			sfClient.httpClient, sfClient.initErr = sfClient.transport.newHTTPClient()
+		}
+	})
+	return sfClient.httpClient, sfClient.initErr
+}
+
+func durationOr(d, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return def
+}
+
+func intOr(n, def int) int {
+	if n > 0 {
+		return n
+	}
+	return def
+}
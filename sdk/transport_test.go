@@ -0,0 +1,69 @@
+package sdk
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTLSServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":1,"result":{"currentVersion":"12.5"}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func connectTo(t *testing.T, srv *httptest.Server, opts ...ClientOption) *SdkError {
+	t.Helper()
+	sf, err := NewSFClient(opts...)
+	if err != nil {
+		t.Fatalf("NewSFClient: %v", err)
+	}
+	host := strings.TrimPrefix(srv.URL, "https://")
+	return sf.Connect(context.Background(), host, "12.5", "admin", "admin")
+}
+
+func TestTransportVerifiesByDefault(t *testing.T) {
+	srv := newTLSServer(t)
+	if err := connectTo(t, srv); err == nil {
+		t.Fatal("expected verification failure against an untrusted certificate")
+	}
+}
+
+func TestTransportCABundle(t *testing.T) {
+	srv := newTLSServer(t)
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := connectTo(t, srv, WithCACertPEM(caPEM)); err != nil {
+		t.Fatalf("Connect with CA bundle: %v", err)
+	}
+}
+
+func TestTransportPinning(t *testing.T) {
+	srv := newTLSServer(t)
+	if err := connectTo(t, srv, WithPinnedSPKI(SPKIFingerprint(srv.Certificate()))); err != nil {
+		t.Fatalf("Connect with matching pin: %v", err)
+	}
+	wrong := "sha256/" + strings.Repeat("A", 43) + "="
+	if err := connectTo(t, srv, WithPinnedSPKI(wrong)); err == nil {
+		t.Fatal("expected pin mismatch")
+	}
+	// Pins are still enforced when chain verification is disabled.
+	if err := connectTo(t, srv, WithInsecureSkipVerify(), WithPinnedSPKI(wrong)); err == nil {
+		t.Fatal("expected pin mismatch with InsecureSkipVerify")
+	}
+}
+
+func TestTransportDoesNotTouchDefaultTransport(t *testing.T) {
+	srv := newTLSServer(t)
+	if err := connectTo(t, srv, WithInsecureSkipVerify()); err != nil {
+		t.Fatalf("Connect with InsecureSkipVerify: %v", err)
+	}
+	if tc := http.DefaultTransport.(*http.Transport).TLSClientConfig; tc != nil && tc.InsecureSkipVerify {
+		t.Fatal("http.DefaultTransport was modified")
+	}
+}
@@ -1,27 +1,26 @@
 package sdk
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/exec"
 	"strings"
 	"time"
+
+	"github.com/scaleoutsean/solidfire-go/iscsi"
 )
 
+// GetInitiatorIqns returns the initiator IQNs in /etc/iscsi/initiatorname.iscsi.
+//
+// Deprecated: use iscsi.Host.InitiatorNames.
 func GetInitiatorIqns() ([]string, error) {
 	log.Println("Begin utils.GetInitiatorIqns")
-	var iqns []string
-	out, err := exec.Command("sudo", "cat", "/etc/iscsi/initiatorname.iscsi").CombinedOutput()
+	iqns, err := iscsi.NewHost().InitiatorNames()
 	if err != nil {
 		log.Printf("Error encountered gathering initiator names: %v\n", err)
 		return nil, err
 	}
-	lines := strings.Split(string(out), "\n")
-	for _, l := range lines {
-		if strings.Contains(l, "InitiatorName=") {
-			iqns = append(iqns, strings.Split(l, "=")[1])
-		}
-	}
 	return iqns, nil
 }
 
@@ -41,17 +40,18 @@ func WaitForPathToExist(fileName string, numTries int) bool {
 	return false
 }
 
+// GetDeviceFileFromIscsiPath returns the device node a /dev/disk/by-path link
+// points at.
+//
+// Deprecated: use iscsi.Host.ResolveDevicePath, or iscsi.Host.WaitForDevice to
+// find the device from sysfs without relying on udev links.
 func GetDeviceFileFromIscsiPath(iscsiPath string) (string, error) {
 	log.Printf("Being utils.getDeviceFileFromIscsiPath: %v\n", iscsiPath)
-	out, err := exec.Command("sudo", "ls", "-la", iscsiPath).CombinedOutput()
+	devFile, err := iscsi.NewHost().ResolveDevicePath(iscsiPath)
 	if err != nil {
 		return "", err
 	}
-	d := strings.Split(string(out), "../../")
-	log.Printf("Found d: %v\n", d)
-	devFile := "/dev/" + d[1]
 	log.Printf("using base of: %v\n", devFile)
-	devFile = strings.TrimSpace(devFile)
 	return devFile, nil
 }
 
@@ -106,36 +106,15 @@ func iscsiadmCmd(args []string) error {
 	return err
 }
 
+// LoginWithChap logs in to tiqn at portal (port 3260) through iface with CHAP.
+//
+// Deprecated: use iscsi.Host.LoginCHAP, which takes a context and keeps the
+// password off the iscsiadm command line.
 func LoginWithChap(tiqn, portal, username, password, iface string) error {
 	log.Printf("Begin utils.LoginWithChap: iqn: %s, portal: %s, username: %s, password=xxxx, iface: %s", tiqn, portal, username, iface)
-	args := []string{"-m", "node", "-T", tiqn, "-p", portal + ":3260"}
-	createArgs := append(args, []string{"--interface", iface, "--op", "new"}...)
-
-	if _, err := exec.Command("iscsiadm", createArgs...).CombinedOutput(); err != nil {
-		log.Printf("Error running iscsiadm node create: %v\n", err)
-		return err
-	}
-
-	authMethodArgs := append(args, []string{"--op=update", "--name", "node.session.auth.authmethod", "--value=CHAP"}...)
-	if out, err := exec.Command("iscsiadm", authMethodArgs...).CombinedOutput(); err != nil {
-		log.Printf("Error running iscsiadm set authmethod: %v\n", err)
-		log.Printf("iscsiadm set authmethod output: %v\n", out)
-		return err
-	}
-
-	authUserArgs := append(args, []string{"--op=update", "--name", "node.session.auth.username", "--value=" + username}...)
-	if _, err := exec.Command("iscsiadm", authUserArgs...).CombinedOutput(); err != nil {
-		log.Printf("Error running iscsiadm set authuser: %v\n", err)
-		return err
-	}
-	authPasswordArgs := append(args, []string{"--op=update", "--name", "node.session.auth.password", "--value=" + password}...)
-	if _, err := exec.Command("iscsiadm", authPasswordArgs...).CombinedOutput(); err != nil {
-		log.Printf("Error running iscsiadm set authpassword: %v\n", err)
-		return err
-	}
-	loginArgs := append(args, []string{"--login"}...)
-	if _, err := exec.Command("iscsiadm", loginArgs...).CombinedOutput(); err != nil {
-		log.Printf("Error running iscsiadm login: %v\n", err)
+	t := iscsi.Target{Portal: portal, IQN: tiqn}
+	if err := iscsi.NewHost().LoginCHAP(context.Background(), t, iface, iscsi.CHAP{Username: username, Password: password}); err != nil {
+		log.Printf("Error logging in to %s: %v\n", tiqn, err)
 		return err
 	}
 	return nil